@@ -51,14 +51,21 @@ import (
 	"github.com/taibuivan/yomira/internal/core/group"
 	"github.com/taibuivan/yomira/internal/core/language"
 	"github.com/taibuivan/yomira/internal/core/tag"
+	"github.com/taibuivan/yomira/internal/library"
 	"github.com/taibuivan/yomira/internal/platform/config"
 	"github.com/taibuivan/yomira/internal/platform/constants"
 	"github.com/taibuivan/yomira/internal/platform/migration"
 	pgstore "github.com/taibuivan/yomira/internal/platform/postgres"
 	redisstore "github.com/taibuivan/yomira/internal/platform/redis"
 	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/social/comment"
+	"github.com/taibuivan/yomira/internal/social/feed"
+	"github.com/taibuivan/yomira/internal/social/notification"
+	"github.com/taibuivan/yomira/internal/system/batch"
+	"github.com/taibuivan/yomira/internal/system/mail"
 	"github.com/taibuivan/yomira/internal/users/account"
 	"github.com/taibuivan/yomira/internal/users/auth"
+	"github.com/taibuivan/yomira/internal/users/auth/oauth"
 )
 
 func main() {
@@ -134,10 +141,39 @@ func run() error {
 	}
 
 	// # 6. Platform Services
-	jwtSvc, err := sec.NewTokenService(cfg.JWTPrivKeyPath, cfg.JWTPubKeyPath, constants.AuthIssuer)
+	jwtSvc, err := sec.NewTokenService(sec.KeyConfig{
+		PrivateKeyPath:     cfg.JWTPrivKeyPath,
+		PublicKeyPath:      cfg.JWTPubKeyPath,
+		AdditionalKeySpecs: cfg.JWTAdditionalKeys,
+		Overlap:            cfg.JWTKeyOverlap,
+	}, constants.AuthIssuer)
 	if err != nil {
 		return fmt.Errorf("initialize jwt service: %w", err)
 	}
+	log.Info("jwt_signing_key_loaded", slog.String("kid", jwtSvc.SigningKeyID()), slog.Int("published_keys", len(jwtSvc.JWKS().Keys)))
+
+	secretBox, err := sec.NewSecretBox(cfg.SessionSecret)
+	if err != nil {
+		return fmt.Errorf("initialize secret box: %w", err)
+	}
+
+	mailRenderer, err := mail.NewRenderer()
+	if err != nil {
+		return fmt.Errorf("initialize mail templates: %w", err)
+	}
+
+	mailSender, err := mail.NewSenderFromConfig(cfg, log)
+	if err != nil {
+		return fmt.Errorf("initialize mail sender: %w", err)
+	}
+
+	mailOutboxRepo := mail.NewOutboxRepository(pool)
+	mailSvc := mail.NewService(mailOutboxRepo, mailRenderer, mailSender, secretBox, cfg.MailFrom, cfg.MailReplyTo, log)
+	log.Info("mail_provider_configured", slog.String("provider", cfg.MailProvider))
+
+	notificationEvents := notification.NewEventRepository(rdb)
+	notificationSvc := notification.NewService(notification.NewNotificationRepository(pool), notification.NewUnreadCounterRepository(rdb), notificationEvents, log)
+	notificationHub := notification.NewHub(notificationEvents, log)
 
 	// # 7. Health Wiring
 	liveness, readiness := api.NewHealthHandlers(api.HealthDependencies{
@@ -154,10 +190,25 @@ func run() error {
 	sessionRepo := auth.NewSessionRepository(pool)
 	resetRepo := auth.NewResetTokenRepository(rdb)
 	verifyRepo := auth.NewVerificationTokenRepository(rdb)
+	throttleRepo := auth.NewLoginThrottleRepository(rdb)
+	mfaRepo := auth.NewMFARepository(pool)
+	challengeRepo := auth.NewMFAChallengeRepository(rdb)
+	oauthLinkRepo := auth.NewOAuthLinkRepository(pool)
+	oauthStateRepo := auth.NewOAuthStateRepository(rdb)
+	personalTokenRepo := auth.NewPersonalTokenRepository(pool)
+	suspensionRepo := auth.NewSuspensionRepository(rdb)
+	accessGuard := auth.NewAccessGuard(auth.NewRevocationRepository(rdb), auth.RevocationCacheTTL)
 
 	// # 9. Auth Service & Handler
-	authSvc := auth.NewService(userRepo, sessionRepo, resetRepo, verifyRepo, jwtSvc, log)
-	authHdl := auth.NewHandler(authSvc)
+	authSvc := auth.NewService(
+		userRepo, sessionRepo, resetRepo, verifyRepo, throttleRepo, mfaRepo, challengeRepo,
+		secretBox, jwtSvc, accessGuard, sec.UserRole(cfg.MFARequiredRole), mailSvc, cfg.AppURL, log,
+	)
+	oauthProviders := oauth.NewRegistryFromConfig(cfg)
+	oauthSvc := auth.NewOAuthService(authSvc, oauthProviders, oauthLinkRepo, oauthStateRepo, cfg.AppURL, log)
+	personalTokenSvc := auth.NewPersonalTokenService(personalTokenRepo, log)
+	authHdl := auth.NewHandler(authSvc, oauthSvc, personalTokenSvc)
+	log.Info("oauth_providers_configured", slog.Any("providers", oauthProviders.Names()))
 
 	// # 10. Comic & Chapter Services
 	comicRepo := comic.NewComicRepository(pool)
@@ -181,38 +232,89 @@ func run() error {
 	tagSvc := tag.NewService(tag.NewPostgresRepository(pool), log)
 	tagHdl := tag.NewHandler(tagSvc)
 
-	groupSvc := group.NewService(group.NewPostgresRepository(pool), log)
+	groupSvc := group.NewService(group.NewPostgresRepository(pool), notificationSvc, log)
 	groupHdl := group.NewHandler(groupSvc)
 
 	// # 12. Account Management
 	accRepo := account.NewAccountRepository(pool)
 	prefRepo := account.NewPreferencesRepository(pool)
 	accSessRepo := account.NewSessionRepository(pool)
-	accountSvc := account.NewService(accRepo, prefRepo, accSessRepo, log)
+	emailChangeRepo := account.NewEmailChangeRepository(pool)
+	adminRepo := account.NewAdminRepository(pool)
+	followRepo := account.NewFollowRepository(pool)
+	exportRepo := account.NewExportRepository(pool)
+	deletionRepo := account.NewDeletionRepository(pool)
+	accountSvc := account.NewService(accRepo, prefRepo, accSessRepo, emailChangeRepo, adminRepo, followRepo, exportRepo, deletionRepo, suspensionRepo, accessGuard, mailSvc, cfg.AppURL, log)
 	accountHdl := account.NewHandler(accountSvc)
 
-	// # 13. API Assembly
+	// # 13. Library
+	entryRepo := library.NewEntryRepository(pool)
+	listRepo := library.NewListRepository(pool)
+	progressRepo := library.NewProgressRepository(pool)
+	historyRepo := library.NewHistoryRepository(pool)
+	librarySvc := library.NewService(entryRepo, listRepo, progressRepo, historyRepo, log)
+	libraryHdl := library.NewHandler(librarySvc)
+	releaseJob := library.NewReleaseJob(library.NewReleaseRepository(pool), log)
+
+	// # 14. Social
+	commentFilter := comment.NewFilter(cfg.CommentBannedWords, cfg.CommentAllowedHosts)
+	commentSvc := comment.NewService(comment.NewCommentRepository(pool), comment.NewRateLimitRepository(rdb), commentFilter, notificationSvc, log)
+	commentHdl := comment.NewHandler(commentSvc)
+	notificationHdl := notification.NewHandler(notificationSvc, notificationHub)
+	fanoutJob := notification.NewFanoutJob(notificationSvc, log)
+	feedHdl := feed.NewHandler(feed.NewService(feed.NewFeedRepository(pool)))
+
+	// # 15. Batch Jobs
+	batchSvc := batch.NewService(batch.NewRunRepository(pool), batch.NewScheduleRepository(pool), batch.NewLockRepository(rdb), log)
+	sessionCleanupJob := auth.NewSessionCleanupJob(sessionRepo, log)
+	outboxCleanupJob := mail.NewOutboxCleanupJob(mailOutboxRepo, log)
+	exportJob := account.NewExportJob(accountSvc, log)
+	purgeJob := account.NewPurgeJob(accountSvc, log)
+	ratingJob := comic.NewRatingJob(comicSvc, log)
+	for _, job := range []batch.Job{
+		releaseJob.Definition(), sessionCleanupJob.Definition(), outboxCleanupJob.Definition(),
+		exportJob.Definition(), purgeJob.Definition(), ratingJob.Definition(),
+		fanoutJob.Definition(),
+	} {
+		if err := batchSvc.Register(job); err != nil {
+			return fmt.Errorf("register batch jobs: %w", err)
+		}
+	}
+	batchHdl := batch.NewHandler(batchSvc)
+
+	// # 16. API Assembly
 	handlers := api.Handlers{
-		Liveness:  liveness,
-		Readiness: readiness,
-		Auth:      authHdl,
-		Comic:     comicHdl,
-		Chapter:   chapterHdl,
-		Author:    authorHdl,
-		Artist:    artistHdl,
-		Language:  languageHdl,
-		Tag:       tagHdl,
-		Group:     groupHdl,
-		Account:   accountHdl,
+		Liveness:     liveness,
+		Readiness:    readiness,
+		JWKS:         api.NewJWKSHandler(jwtSvc),
+		Auth:         authHdl,
+		Comic:        comicHdl,
+		Chapter:      chapterHdl,
+		Author:       authorHdl,
+		Artist:       artistHdl,
+		Language:     languageHdl,
+		Tag:          tagHdl,
+		Group:        groupHdl,
+		Account:      accountHdl,
+		Library:      libraryHdl,
+		Comment:      commentHdl,
+		Notification: notificationHdl,
+		Feed:         feedHdl,
+		Batch:        batchHdl,
 	}
 
 	// Create a background context for the whole application lifecycle
 	appCtx, appCancel := context.WithCancel(context.Background())
 	defer appCancel()
 
-	server := api.NewServer(appCtx, cfg, log, jwtSvc, handlers)
+	server := api.NewServer(appCtx, cfg, log, jwtSvc, personalTokenSvc, accessGuard, handlers)
 
-	// # 13. Lifecycle Handling
+	// Background workers stop with appCtx
+	go batchSvc.Start(appCtx)
+	go mailSvc.Start(appCtx)
+	go notificationHub.Run(appCtx) // Closing the hub ends open streams ahead of server.Shutdown
+
+	// # 17. Lifecycle Handling
 	shutdownErr := make(chan error, 1)
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
@@ -241,6 +343,10 @@ func run() error {
 		return fmt.Errorf("server_shutdown_failed: %w", err)
 	}
 
+	// In-flight batch runs and mail deliveries record their outcome before exit
+	batchSvc.Wait()
+	mailSvc.Wait()
+
 	log.Info("graceful_shutdown_complete")
 	return nil
 }
@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+)
+
+// JWKSMaxAge is how long verifiers may cache the key set; keep it well below JWT_KEY_OVERLAP.
+const JWKSMaxAge = 5 * time.Minute
+
+// KeySetProvider exposes the public keys that verify access tokens.
+type KeySetProvider interface {
+	JWKS() sec.JWKSet
+}
+
+// NewJWKSHandler constructs the GET /.well-known/jwks.json handler.
+//
+// The document follows RFC 7517 as is, without the success envelope, so
+// standard JWT libraries in other services can consume it directly.
+func NewJWKSHandler(keys KeySetProvider) http.HandlerFunc {
+	cacheControl := fmt.Sprintf("public, max-age=%d", int(JWKSMaxAge.Seconds()))
+
+	return func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Cache-Control", cacheControl)
+		respond.JSON(writer, http.StatusOK, keys.JWKS())
+	}
+}
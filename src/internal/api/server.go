@@ -29,9 +29,14 @@ import (
 	"github.com/taibuivan/yomira/internal/core/group"
 	"github.com/taibuivan/yomira/internal/core/language"
 	"github.com/taibuivan/yomira/internal/core/tag"
+	"github.com/taibuivan/yomira/internal/library"
 	"github.com/taibuivan/yomira/internal/platform/config"
 	"github.com/taibuivan/yomira/internal/platform/constants"
 	"github.com/taibuivan/yomira/internal/platform/middleware"
+	"github.com/taibuivan/yomira/internal/social/comment"
+	"github.com/taibuivan/yomira/internal/social/feed"
+	"github.com/taibuivan/yomira/internal/social/notification"
+	"github.com/taibuivan/yomira/internal/system/batch"
 	"github.com/taibuivan/yomira/internal/users/account"
 	"github.com/taibuivan/yomira/internal/users/auth"
 )
@@ -61,6 +66,9 @@ type Handlers struct {
 	// Readiness is the /ready handler — returns 200 when all deps are healthy.
 	Readiness http.HandlerFunc
 
+	// JWKS publishes the access token verification keys at /.well-known/jwks.json.
+	JWKS http.HandlerFunc
+
 	// Auth handles authentication routes (login, register).
 	Auth *auth.Handler
 
@@ -80,23 +88,47 @@ type Handlers struct {
 
 	// Account handles user profile management and preferences.
 	Account *account.Handler
+
+	// Library handles the reader's shelf and reading activity under /me.
+	Library *library.Handler
+
+	// Comment handles the discussion threads under comics and chapters.
+	Comment *comment.Handler
+
+	// Notification handles the in-app notification center under /me/notifications.
+	Notification *notification.Handler
+
+	// Feed handles the personal activity feed under /me/feed.
+	Feed *feed.Handler
+
+	// Batch exposes the admin console for background jobs.
+	Batch *batch.Handler
 }
 
 // # Server Initialization
 
 // NewServer constructs the chi router with the full middleware chain and
 // registers all route groups.
-func NewServer(ctx context.Context, cfg *config.Config, log *slog.Logger, verifier middleware.TokenVerifier, h Handlers) *Server {
+func NewServer(
+	ctx context.Context,
+	cfg *config.Config,
+	log *slog.Logger,
+	verifier middleware.TokenVerifier,
+	personalTokens middleware.PersonalTokenVerifier,
+	access middleware.AccessChecker,
+	h Handlers,
+) *Server {
 	rte := chi.NewRouter()
 
 	// # Middleware Chain
 	// Global middleware applied in order of execution.
 	rte.Use(middleware.RequestID())
+	rte.Use(middleware.ClientIP(cfg.TrustedProxyPrefixes()))
 	rte.Use(middleware.StructuredLogger(log))
-	rte.Use(chimw.Timeout(constants.GlobalRequestTimeout))
+	rte.Use(middleware.Timeout(constants.GlobalRequestTimeout, notification.StreamPath))
 	rte.Use(middleware.RateLimit(ctx))
 	rte.Use(middleware.PanicRecovery(log))
-	rte.Use(middleware.Authenticate(verifier))
+	rte.Use(middleware.Authenticate(verifier, personalTokens, access))
 	rte.Use(middleware.CORS(cfg))
 	rte.Use(chimw.CleanPath)
 
@@ -104,6 +136,7 @@ func NewServer(ctx context.Context, cfg *config.Config, log *slog.Logger, verifi
 	// Unauthenticated health probes for container orchestration.
 	rte.Get("/health", h.Liveness)
 	rte.Get("/ready", h.Readiness)
+	rte.Get("/.well-known/jwks.json", h.JWKS)
 
 	// # Application API
 	// Domain-specific route groups mounted under versioned prefix.
@@ -116,6 +149,22 @@ func NewServer(ctx context.Context, cfg *config.Config, log *slog.Logger, verifi
 
 		api.Mount("/groups", h.Group.Routes())
 		api.Mount("/", h.Account.Routes()) // Mounting at root for /me and /users/{id}
+
+		// Library registers its /me/library... routes directly on the API router
+		h.Library.RegisterRoutes(api)
+
+		// Comment spans /comics/../comments, /chapters/../comments and /comments/..
+		h.Comment.RegisterRoutes(api)
+
+		// Notification registers its /me/notifications... routes directly on the API router
+		h.Notification.RegisterRoutes(api)
+
+		// Feed registers its /me/feed route directly on the API router
+		h.Feed.RegisterRoutes(api)
+
+		// Batch registers the admin-only /admin/batch... routes
+		h.Batch.RegisterRoutes(api)
+
 		api.Route("/authors", h.Author.RegisterRoutes)
 		api.Route("/artists", h.Artist.RegisterRoutes)
 		api.Route("/languages", h.Language.RegisterRoutes)
@@ -28,6 +28,7 @@ func (handler *Handler) RegisterRoutes(router chi.Router) {
 	// Admin/Mod Only
 	router.Group(func(adminRoute chi.Router) {
 		adminRoute.Use(middleware.RequireRole(sec.RoleModerator))
+		adminRoute.Use(middleware.RequireScope(sec.ScopeComicsWrite))
 
 		adminRoute.Post("/", handler.createAuthor)
 		adminRoute.Patch("/{id}", handler.updateAuthor)
@@ -55,12 +55,14 @@ func (handler *Handler) RegisterRoutes(api chi.Router) {
 	// Admin protected endpoints
 	api.Group(func(admin chi.Router) {
 		admin.Use(middleware.RequireRole(sec.RoleAdmin))
+		admin.Use(middleware.RequireScope(sec.ScopeChaptersWrite))
 		admin.Post("/comics/{comicID}/chapters", handler.CreateChapter)
 	})
 
 	// User interactions (Require authentication)
 	api.Group(func(user chi.Router) {
 		user.Use(middleware.RequireAuth)
+		user.Use(middleware.RequireScope(sec.ScopeLibraryWrite))
 		user.Post("/chapters/{id}/read", handler.MarkAsRead)
 	})
 }
@@ -74,7 +74,8 @@ CreateChapter initialises a new chapter entry.
 
 Description: Ensures the chapter is linked to a valid comic,
 applies basic sanity checks on chapter numbering, and persists
-the metadata.
+the metadata. Followers are notified asynchronously: the library's
+release job flags their shelves once PublishedAt has passed.
 
 Parameters:
   - context: context.Context
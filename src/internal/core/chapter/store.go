@@ -115,7 +115,8 @@ type ChapterRepository interface {
 	IncrementViewCount(context context.Context, id string, delta int64) error
 
 	/*
-		MarkAsRead records that a user has completed a chapter.
+		MarkAsRead records that a user has completed a chapter and advances the
+		user's shelf entry for the comic when this chapter is further along.
 
 		Parameters:
 		  - context: context.Context
@@ -123,7 +124,7 @@ type ChapterRepository interface {
 		  - userID: string (Actor)
 
 		Returns:
-		  - error: Record failure
+		  - error: ErrNotFound if the chapter is missing, or record failure
 	*/
 	MarkAsRead(context context.Context, chapterID, userID string) error
 }
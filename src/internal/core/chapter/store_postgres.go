@@ -22,6 +22,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/taibuivan/yomira/internal/platform/apperr"
 	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/internal/platform/database/shelf"
 )
 
 // # PostgreSQL Repositories
@@ -454,7 +455,9 @@ MarkAsRead records that a user has completed a chapter.
 
 Description: Uses an 'ON CONFLICT DO NOTHING' clause to guarantee
 idempotency. This prevents duplicate reading entries if a user
-refreshes the page.
+refreshes the page. In the same transaction, the user's shelf entry for
+the owning comic (if any) is synced through [shelf.SyncPosition],
+so it only advances when this chapter is further than the last one read.
 
 Parameters:
   - context: context.Context
@@ -462,10 +465,28 @@ Parameters:
   - userID: string (UUID)
 
 Returns:
-  - error: Record failures
+  - error: apperr.NotFound if the chapter does not exist, or record failures
 */
 func (repository *chapterRepository) MarkAsRead(context context.Context, chapterID, userID string) error {
 
+	// Transaction boundary for read record + shelf marker
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Owning comic resolution
+	var comicID string
+	comicQuery := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 AND %s IS NULL`,
+		schema.CoreChapter.ComicID, schema.CoreChapter.Table, schema.CoreChapter.ID, schema.CoreChapter.DeletedAt)
+	if err := transaction.QueryRow(context, comicQuery, chapterID).Scan(&comicID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperr.NotFound("chapter")
+		}
+		return fmt.Errorf("postgres: failed to resolve chapter comic: %w", err)
+	}
+
 	// Idempotent insertion strategy
 	query := fmt.Sprintf(`
 		INSERT INTO %s (%s, %s)
@@ -473,11 +494,18 @@ func (repository *chapterRepository) MarkAsRead(context context.Context, chapter
 		ON CONFLICT DO NOTHING
 	`, schema.CoreUserRead.Table, schema.CoreUserRead.UserID, schema.CoreUserRead.ChapterID)
 
-	// Atomic Execute
-	_, err := repository.pool.Exec(context, query, userID, chapterID)
-
-	if err != nil {
+	if _, err := transaction.Exec(context, query, userID, chapterID); err != nil {
 		return fmt.Errorf("postgres: failed to mark as read: %w", err)
 	}
+
+	// Shelf marker update, following the shared position rule
+	if err := shelf.SyncPosition(context, transaction, userID, comicID); err != nil {
+		return err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres: failed to commit read record: %w", err)
+	}
+
 	return nil
 }
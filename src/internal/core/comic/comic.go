@@ -12,12 +12,16 @@ Core Responsibility:
   - Catalogue: Defines statuses (Ongoing, Completed) and demographics (Shounen, Seinen).
   - Discovery: Manages tags (Genres/Themes) and titles (Alternative names/Translations).
   - Analytics: Tracks metrics like view counts and followers for ranking.
+  - Ratings: Collects 1-10 reader scores and ranks comics by their Bayesian average.
 
 This package acts as the source of truth for all content-related data models.
 */
 package comic
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 // # Domain Enums
 
@@ -171,6 +175,29 @@ type Art struct {
 	CreatedAt  time.Time `json:"created_at"`
 }
 
+// Rating summarises the reader scores of a comic as seen by one viewer.
+type Rating struct {
+	RatingAvg      float64 `json:"rating_avg"`
+	RatingBayesian float64 `json:"rating_bayesian"` // Refreshed by the [RatingJob]
+	RatingCount    int     `json:"rating_count"`
+	Score          *int    `json:"score"` // Viewer's own score; nil when not rated or anonymous
+}
+
+// RatingStats holds the raw score totals of a comic.
+type RatingStats struct {
+	ComicID string
+	Count   int
+	Sum     int64
+}
+
+// RatingScores holds the recalculated rating columns of a comic.
+type RatingScores struct {
+	ComicID  string
+	Avg      float64
+	Bayesian float64
+	Count    int
+}
+
 // # Search & Filtering
 
 // Filter holds the parameters for a filtered comic list query.
@@ -190,6 +217,64 @@ type Filter struct {
 	SortDir           string          `json:"sort_dir,omitempty"` // "asc" or "desc"
 }
 
+// # Ratings
+
+const (
+	// MinRatingScore is the lowest score a reader can give a comic.
+	MinRatingScore = 1
+
+	// MaxRatingScore is the highest score a reader can give a comic.
+	MaxRatingScore = 10
+
+	// RatingPriorVotes is the number of votes at the global mean that every
+	// Bayesian average starts from, so a handful of scores cannot top the ranking.
+	RatingPriorVotes = 100
+
+	// RatingJobKey identifies the Bayesian recalculation in the batch registry.
+	RatingJobKey = "comics.ratings_recalc"
+
+	// RatingRecalcCron is the default schedule of the recalculation job.
+	RatingRecalcCron = "*/15 * * * *"
+
+	// RatingRecalcTimeout bounds a single recalculation run.
+	RatingRecalcTimeout = 10 * time.Minute
+
+	// RatingRecalcBatchSize caps the number of comics rewritten per statement.
+	RatingRecalcBatchSize = 500
+)
+
+/*
+RecalculateRating derives the rating columns of a comic from its raw scores.
+
+Description: The Bayesian average blends the scores with [RatingPriorVotes]
+votes at the global mean: (mean * prior + sum) / (prior + count). Unrated
+comics get zero for both averages so they rank below every rated one.
+Results are rounded to two decimals, the precision of the columns.
+
+Parameters:
+  - stats: RatingStats
+  - mean: float64 (Average score across every comic)
+
+Returns:
+  - RatingScores: Values for the comic columns
+*/
+func RecalculateRating(stats RatingStats, mean float64) RatingScores {
+	scores := RatingScores{ComicID: stats.ComicID, Count: stats.Count}
+	if stats.Count == 0 {
+		return scores
+	}
+
+	sum := float64(stats.Sum)
+	scores.Avg = roundRating(sum / float64(stats.Count))
+	scores.Bayesian = roundRating((mean*RatingPriorVotes + sum) / float64(RatingPriorVotes+stats.Count))
+	return scores
+}
+
+// roundRating rounds an average to two decimals.
+func roundRating(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
 // # Field Identifiers
 
 // Global field names for validation and dynamic query mapping.
@@ -210,6 +295,7 @@ const (
 	FieldTagIDs          = "tag_ids"
 	FieldAuthorIDs       = "author_ids"
 	FieldArtistIDs       = "artist_ids"
+	FieldScore           = "score"
 )
 
 // Field identifiers for the [Chapter] domain.
@@ -0,0 +1,40 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comic_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taibuivan/yomira/internal/core/comic"
+)
+
+/*
+TestRecalculateRating pulls thinly rated comics towards the global mean and
+leaves unrated comics at zero.
+*/
+func TestRecalculateRating(t *testing.T) {
+	const mean = 7.0
+
+	unrated := comic.RecalculateRating(comic.RatingStats{ComicID: "c0"}, mean)
+	assert.Equal(t, comic.RatingScores{ComicID: "c0"}, unrated)
+
+	// A single perfect score barely moves the prior
+	single := comic.RecalculateRating(comic.RatingStats{ComicID: "c1", Count: 1, Sum: 10}, mean)
+	assert.Equal(t, 10.0, single.Avg)
+	assert.Equal(t, 7.03, single.Bayesian)
+	assert.Equal(t, 1, single.Count)
+
+	// Many votes dominate the prior
+	popular := comic.RecalculateRating(comic.RatingStats{ComicID: "c2", Count: 900, Sum: 8100}, mean)
+	assert.Equal(t, 9.0, popular.Avg)
+	assert.Equal(t, 8.8, popular.Bayesian)
+	assert.Greater(t, popular.Bayesian, single.Bayesian)
+
+	// Averages are rounded to the column precision
+	rounded := comic.RecalculateRating(comic.RatingStats{ComicID: "c3", Count: 3, Sum: 25}, mean)
+	assert.Equal(t, 8.33, rounded.Avg)
+	assert.Equal(t, 7.04, rounded.Bayesian)
+}
@@ -48,6 +48,7 @@ func NewHandler(service *Service) *Handler {
 // # Routing Strategy
 //
 //   - Discovery (Public): Accessible by all visitors for browsing.
+//   - Ratings (Authenticated): Readers score comics from 1 to 10.
 //   - Management (Restricted): Requires [RoleAdmin] for state-mutating operations.
 func (handler *Handler) Routes() chi.Router {
 	router := chi.NewRouter()
@@ -58,9 +59,15 @@ func (handler *Handler) Routes() chi.Router {
 	router.Get("/{id}/titles", handler.listTitles)
 	router.Get("/{id}/relations", handler.listRelations)
 
+	// ## Reader Ratings
+	router.Get("/{id}/rating", handler.getRating)
+	router.With(middleware.RequireScope(sec.ScopeSocialWrite)).Put("/{id}/rating", handler.rateComic)
+	router.With(middleware.RequireScope(sec.ScopeSocialWrite)).Delete("/{id}/rating", handler.unrateComic)
+
 	// ## Content Management (Admin Protected)
 	router.Group(func(admin chi.Router) {
 		admin.Use(middleware.RequireRole(sec.RoleAdmin))
+		admin.Use(middleware.RequireScope(sec.ScopeComicsWrite))
 
 		admin.Post("/", handler.createComic)
 		admin.Patch("/{id}", handler.updateComic)
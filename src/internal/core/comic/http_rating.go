@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comic
+
+import (
+	"net/http"
+
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+)
+
+// # Rating Endpoints
+
+// ratingRequest defines the payload for rating a comic.
+type ratingRequest struct {
+	Score int `json:"score"`
+}
+
+/*
+GET /api/v1/comics/{id}/rating.
+
+Description: Returns the rating aggregates of a comic. Authenticated callers
+also get their own score.
+
+Request:
+  - id: string (UUID)
+
+Response:
+  - 200: Rating: Success
+  - 404: 404: ErrNotFound: Comic not found
+*/
+func (handler *Handler) getRating(writer http.ResponseWriter, request *http.Request) {
+	var userID string
+	if claims := requestutil.Claims(request); claims != nil {
+		userID = claims.UserID
+	}
+
+	rating, err := handler.service.GetRating(request.Context(), requestutil.ID(request, "id"), userID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, rating)
+}
+
+/*
+PUT /api/v1/comics/{id}/rating.
+
+Description: Submits or replaces the caller's score for a comic.
+
+Request:
+  - id: string (UUID)
+  - body: { score: int (1-10) }
+
+Response:
+  - 200: Rating: Aggregates after the change
+  - 400: 400: ErrInvalidJSON/Validation: Score out of range
+  - 401: 401: ErrUnauthorized: Authentication required
+  - 404: 404: ErrNotFound: Comic not found
+*/
+func (handler *Handler) rateComic(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input ratingRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	rating, err := handler.service.RateComic(request.Context(), requestutil.ID(request, "id"), userID, input.Score)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, rating)
+}
+
+/*
+DELETE /api/v1/comics/{id}/rating.
+
+Description: Removes the caller's score for a comic.
+
+Request:
+  - id: string (UUID)
+
+Response:
+  - 204: No Content: Success
+  - 401: 401: ErrUnauthorized: Authentication required
+  - 404: 404: ErrNotFound: Comic or rating not found
+*/
+func (handler *Handler) unrateComic(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.service.UnrateComic(request.Context(), requestutil.ID(request, "id"), userID); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comic
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/taibuivan/yomira/internal/system/batch"
+)
+
+// # Background Jobs
+
+/*
+RatingJob recalculates the Bayesian rating of every comic.
+
+Description: Rating writes only move the running average and count, because
+the Bayesian average depends on the mean of every score in the catalogue.
+This job recomputes that mean and rewrites the ranking column used by
+sort=rating.
+*/
+type RatingJob struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+// NewRatingJob constructs a new [RatingJob].
+func NewRatingJob(service *Service, logger *slog.Logger) *RatingJob {
+	return &RatingJob{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Definition describes the job for the batch scheduler.
+func (job *RatingJob) Definition() batch.Job {
+	return batch.Job{
+		Key:         RatingJobKey,
+		Description: "Recalculate comic Bayesian ratings against the global mean",
+		Cron:        RatingRecalcCron,
+		Timeout:     RatingRecalcTimeout,
+		Handler: func(context context.Context, _ batch.Execution) (batch.Result, error) {
+			updated, mean, err := job.service.RecalculateRatings(context)
+			if updated > 0 {
+				job.logger.Info("comic_ratings_recalculated",
+					slog.Int("comics_updated", updated),
+					slog.Float64("global_mean", mean),
+				)
+			}
+
+			return batch.Result{
+				RowsAffected: int64(updated),
+				Meta:         map[string]any{"comics_updated": updated, "global_mean": mean},
+			}, err
+		},
+	}
+}
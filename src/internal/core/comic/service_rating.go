@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comic
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/taibuivan/yomira/internal/platform/validate"
+)
+
+// # Rating Operations
+
+/*
+GetRating returns the rating summary of a comic.
+
+Parameters:
+  - context: context.Context
+  - comicID: string (UUID)
+  - userID: string (Viewer; empty for anonymous callers)
+
+Returns:
+  - *Rating: Aggregates and the viewer's own score
+  - error: ErrNotFound if the comic is missing
+*/
+func (service *Service) GetRating(context context.Context, comicID, userID string) (*Rating, error) {
+	return service.comicRepo.FindRating(context, comicID, userID)
+}
+
+/*
+RateComic records or replaces the score a user gives a comic.
+
+Description: The running average and count change at once; the Bayesian
+average used by sort=rating follows on the next [RatingJob] run.
+
+Parameters:
+  - context: context.Context
+  - comicID: string (UUID)
+  - userID: string (UUID)
+  - score: int (Between MinRatingScore and MaxRatingScore)
+
+Returns:
+  - *Rating: Aggregates after the change
+  - error: Validation errors or ErrNotFound if the comic is missing
+*/
+func (service *Service) RateComic(context context.Context, comicID, userID string, score int) (*Rating, error) {
+	validator := &validate.Validator{}
+	validator.Range(FieldScore, score, MinRatingScore, MaxRatingScore)
+	if err := validator.Err(); err != nil {
+		return nil, err
+	}
+
+	rating, err := service.comicRepo.UpsertRating(context, comicID, userID, score)
+	if err != nil {
+		return nil, err
+	}
+
+	service.logger.Info("comic_rated",
+		slog.String("comic_id", comicID),
+		slog.String("user_id", userID),
+		slog.Int("score", score),
+	)
+
+	return rating, nil
+}
+
+/*
+UnrateComic removes the score a user gave a comic.
+
+Parameters:
+  - context: context.Context
+  - comicID: string (UUID)
+  - userID: string (UUID)
+
+Returns:
+  - error: ErrNotFound if the user has not rated the comic
+*/
+func (service *Service) UnrateComic(context context.Context, comicID, userID string) error {
+	if err := service.comicRepo.DeleteRating(context, comicID, userID); err != nil {
+		return err
+	}
+
+	service.logger.Info("comic_unrated", slog.String("comic_id", comicID), slog.String("user_id", userID))
+
+	return nil
+}
+
+/*
+RecalculateRatings rewrites the rating columns of every live comic.
+
+Description: The global mean is computed once, then comics are walked in
+ID order in batches of [RatingRecalcBatchSize]. Exact averages and counts
+are rewritten too, which clears any drift left by the running updates.
+
+Parameters:
+  - context: context.Context
+
+Returns:
+  - int: Comics whose columns changed, including batches before a failure
+  - float64: Global mean the Bayesian averages were computed against
+  - error: Repository level errors or context cancellation
+*/
+func (service *Service) RecalculateRatings(context context.Context) (int, float64, error) {
+	mean, err := service.comicRepo.RatingMean(context)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	updated, afterID := 0, ""
+	for {
+		stats, err := service.comicRepo.ListRatingStats(context, afterID, RatingRecalcBatchSize)
+		if err != nil {
+			return updated, mean, err
+		}
+		if len(stats) == 0 {
+			return updated, mean, nil
+		}
+
+		scores := make([]RatingScores, len(stats))
+		for i, entry := range stats {
+			scores[i] = RecalculateRating(entry, mean)
+		}
+
+		affected, err := service.comicRepo.UpdateRatingScores(context, scores)
+		if err != nil {
+			return updated, mean, err
+		}
+		updated += affected
+
+		if len(stats) < RatingRecalcBatchSize {
+			return updated, mean, nil
+		}
+		afterID = stats[len(stats)-1].ComicID
+	}
+}
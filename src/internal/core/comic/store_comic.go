@@ -11,6 +11,7 @@ import "context"
 type ComicRepository interface {
 	ComicRelationRepository
 	ComicAssetRepository
+	ComicRatingRepository
 
 	/*
 		List returns a filtered, paginated slice of comics and the total count.
@@ -0,0 +1,331 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # Rating Management
+
+/*
+FindRating returns the rating summary of a comic.
+
+Description: The viewer's score is resolved with a LEFT JOIN, so anonymous
+callers (empty userID) simply get a nil score.
+*/
+func (repository *comicRepository) FindRating(context context.Context, comicID, userID string) (*Rating, error) {
+	query := fmt.Sprintf(`
+		SELECT c.%[1]s, c.%[2]s, c.%[3]s, r.%[4]s
+		FROM %[5]s c
+		LEFT JOIN %[6]s r ON r.%[7]s = c.%[8]s AND r.%[9]s = $2
+		WHERE c.%[8]s = $1 AND c.%[10]s IS NULL
+	`,
+		schema.CoreComic.RatingAvg,       // 1
+		schema.CoreComic.RatingBayesian,  // 2
+		schema.CoreComic.RatingCount,     // 3
+		schema.SocialComicRating.Score,   // 4
+		schema.CoreComic.Table,           // 5
+		schema.SocialComicRating.Table,   // 6
+		schema.SocialComicRating.ComicID, // 7
+		schema.CoreComic.ID,              // 8
+		schema.SocialComicRating.UserID,  // 9
+		schema.CoreComic.DeletedAt,       // 10
+	)
+
+	var rating Rating
+	err := repository.pool.QueryRow(context, query, comicID, userID).Scan(
+		&rating.RatingAvg, &rating.RatingBayesian, &rating.RatingCount, &rating.Score,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("comic")
+		}
+		return nil, fmt.Errorf("postgres: failed to find rating: %w", err)
+	}
+
+	return &rating, nil
+}
+
+/*
+UpsertRating stores the score of a user and adjusts the comic counters.
+
+Description: The comic row is locked first so concurrent ratings of the
+same comic apply their deltas one after another. A changed score moves the
+running average without touching the count.
+*/
+func (repository *comicRepository) UpsertRating(context context.Context, comicID, userID string, score int) (*Rating, error) {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Serialise counter updates per comic
+	lockQuery := fmt.Sprintf(`SELECT 1 FROM %s WHERE %s = $1 AND %s IS NULL FOR UPDATE`,
+		schema.CoreComic.Table, schema.CoreComic.ID, schema.CoreComic.DeletedAt)
+
+	var locked int
+	if err := transaction.QueryRow(context, lockQuery, comicID).Scan(&locked); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("comic")
+		}
+		return nil, fmt.Errorf("postgres: failed to lock comic: %w", err)
+	}
+
+	// Previous score, if any, decides the deltas
+	previousQuery := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 AND %s = $2`,
+		schema.SocialComicRating.Score, schema.SocialComicRating.Table,
+		schema.SocialComicRating.ComicID, schema.SocialComicRating.UserID)
+
+	countDelta, scoreDelta := 1, score
+	var previous int
+	err = transaction.QueryRow(context, previousQuery, comicID, userID).Scan(&previous)
+	switch {
+	case err == nil:
+		countDelta, scoreDelta = 0, score-previous
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, fmt.Errorf("postgres: failed to find previous rating: %w", err)
+	}
+
+	upsertQuery := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s, %[6]s, %[7]s)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		ON CONFLICT (%[3]s, %[4]s) DO UPDATE
+		SET %[5]s = EXCLUDED.%[5]s, %[7]s = NOW()
+	`,
+		schema.SocialComicRating.Table,     // 1
+		schema.SocialComicRating.ID,        // 2
+		schema.SocialComicRating.UserID,    // 3
+		schema.SocialComicRating.ComicID,   // 4
+		schema.SocialComicRating.Score,     // 5
+		schema.SocialComicRating.CreatedAt, // 6
+		schema.SocialComicRating.UpdatedAt, // 7
+	)
+
+	if _, err := transaction.Exec(context, upsertQuery, uuid.New(), userID, comicID, score); err != nil {
+		return nil, fmt.Errorf("postgres: failed to upsert rating: %w", err)
+	}
+
+	rating, err := adjustRatingCounters(context, transaction, comicID, countDelta, scoreDelta)
+	if err != nil {
+		return nil, err
+	}
+	rating.Score = &score
+
+	if err := transaction.Commit(context); err != nil {
+		return nil, fmt.Errorf("postgres: failed to commit rating transaction: %w", err)
+	}
+
+	return rating, nil
+}
+
+/*
+DeleteRating removes the score of a user and adjusts the comic counters.
+
+Description: Deleted comics keep accepting removals so users can still take
+back a score; only the counters of that comic are touched.
+*/
+func (repository *comicRepository) DeleteRating(context context.Context, comicID, userID string) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Serialise counter updates per comic
+	lockQuery := fmt.Sprintf(`SELECT 1 FROM %s WHERE %s = $1 FOR UPDATE`, schema.CoreComic.Table, schema.CoreComic.ID)
+
+	var locked int
+	if err := transaction.QueryRow(context, lockQuery, comicID).Scan(&locked); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperr.NotFound("comic")
+		}
+		return fmt.Errorf("postgres: failed to lock comic: %w", err)
+	}
+
+	deleteQuery := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1 AND %s = $2 RETURNING %s`,
+		schema.SocialComicRating.Table,
+		schema.SocialComicRating.ComicID, schema.SocialComicRating.UserID,
+		schema.SocialComicRating.Score)
+
+	var score int
+	if err := transaction.QueryRow(context, deleteQuery, comicID, userID).Scan(&score); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperr.NotFound("rating")
+		}
+		return fmt.Errorf("postgres: failed to delete rating: %w", err)
+	}
+
+	if _, err := adjustRatingCounters(context, transaction, comicID, -1, -score); err != nil {
+		return err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres: failed to commit rating transaction: %w", err)
+	}
+
+	return nil
+}
+
+/*
+adjustRatingCounters applies a rating change to the running average and count.
+
+Description: The previous total is rebuilt from average * count, so rounding
+drifts slightly over time; the [RatingJob] rewrites exact values.
+
+Parameters:
+  - context: context.Context
+  - transaction: pgx.Tx (Holding the comic row lock)
+  - comicID: string
+  - countDelta: int (+1 new score, 0 changed score, -1 removed score)
+  - scoreDelta: int (Change of the score total)
+
+Returns:
+  - *Rating: Aggregates after the change, without a viewer score
+  - error: Execution failures
+*/
+func adjustRatingCounters(context context.Context, transaction pgx.Tx, comicID string, countDelta, scoreDelta int) (*Rating, error) {
+	query := fmt.Sprintf(`
+		UPDATE %[1]s
+		SET %[2]s = CASE WHEN %[3]s + $2 <= 0 THEN 0 ELSE (%[2]s * %[3]s + $3) / (%[3]s + $2) END,
+			%[3]s = GREATEST(%[3]s + $2, 0)
+		WHERE %[4]s = $1
+		RETURNING %[2]s, %[5]s, %[3]s
+	`,
+		schema.CoreComic.Table,          // 1
+		schema.CoreComic.RatingAvg,      // 2
+		schema.CoreComic.RatingCount,    // 3
+		schema.CoreComic.ID,             // 4
+		schema.CoreComic.RatingBayesian, // 5
+	)
+
+	var rating Rating
+	err := transaction.QueryRow(context, query, comicID, countDelta, scoreDelta).Scan(
+		&rating.RatingAvg, &rating.RatingBayesian, &rating.RatingCount,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to adjust rating counters: %w", err)
+	}
+
+	return &rating, nil
+}
+
+// # Rating Recalculation
+
+// RatingMean returns the average score across every live comic.
+func (repository *comicRepository) RatingMean(context context.Context) (float64, error) {
+	query := fmt.Sprintf(`
+		SELECT COALESCE(AVG(r.%[1]s), 0)::float8
+		FROM %[2]s r
+		JOIN %[3]s c ON c.%[4]s = r.%[5]s
+		WHERE c.%[6]s IS NULL
+	`,
+		schema.SocialComicRating.Score,   // 1
+		schema.SocialComicRating.Table,   // 2
+		schema.CoreComic.Table,           // 3
+		schema.CoreComic.ID,              // 4
+		schema.SocialComicRating.ComicID, // 5
+		schema.CoreComic.DeletedAt,       // 6
+	)
+
+	var mean float64
+	if err := repository.pool.QueryRow(context, query).Scan(&mean); err != nil {
+		return 0, fmt.Errorf("postgres: failed to compute rating mean: %w", err)
+	}
+
+	return mean, nil
+}
+
+/*
+ListRatingStats returns the raw score totals of comics in ID order.
+
+Description: The page of comic IDs is picked first so the aggregation only
+reads the ratings of that page.
+*/
+func (repository *comicRepository) ListRatingStats(context context.Context, afterID string, limit int) ([]RatingStats, error) {
+	query := fmt.Sprintf(`
+		SELECT c.%[1]s, COUNT(r.%[2]s), COALESCE(SUM(r.%[2]s), 0)
+		FROM (
+			SELECT %[1]s FROM %[3]s
+			WHERE %[4]s IS NULL AND %[1]s > $1
+			ORDER BY %[1]s
+			LIMIT $2
+		) c
+		LEFT JOIN %[5]s r ON r.%[6]s = c.%[1]s
+		GROUP BY c.%[1]s
+		ORDER BY c.%[1]s
+	`,
+		schema.CoreComic.ID,              // 1
+		schema.SocialComicRating.Score,   // 2
+		schema.CoreComic.Table,           // 3
+		schema.CoreComic.DeletedAt,       // 4
+		schema.SocialComicRating.Table,   // 5
+		schema.SocialComicRating.ComicID, // 6
+	)
+
+	rows, err := repository.pool.Query(context, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list rating stats: %w", err)
+	}
+
+	stats, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (RatingStats, error) {
+		var entry RatingStats
+		err := row.Scan(&entry.ComicID, &entry.Count, &entry.Sum)
+		return entry, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to scan rating stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+/*
+UpdateRatingScores writes recalculated rating columns.
+
+Description: The batch is sent as parallel arrays and rows that already hold
+the same values are skipped, so steady comics cost no writes.
+*/
+func (repository *comicRepository) UpdateRatingScores(context context.Context, scores []RatingScores) (int, error) {
+	if len(scores) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, len(scores))
+	averages := make([]float64, len(scores))
+	bayesians := make([]float64, len(scores))
+	counts := make([]int, len(scores))
+	for i, score := range scores {
+		ids[i], averages[i], bayesians[i], counts[i] = score.ComicID, score.Avg, score.Bayesian, score.Count
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE %[1]s c
+		SET %[2]s = s.avg, %[3]s = s.bayesian, %[4]s = s.count
+		FROM unnest($1::text[], $2::float8[], $3::float8[], $4::int[]) AS s(id, avg, bayesian, count)
+		WHERE c.%[5]s = s.id
+		  AND (c.%[2]s, c.%[3]s, c.%[4]s) IS DISTINCT FROM (s.avg, s.bayesian, s.count)
+	`,
+		schema.CoreComic.Table,          // 1
+		schema.CoreComic.RatingAvg,      // 2
+		schema.CoreComic.RatingBayesian, // 3
+		schema.CoreComic.RatingCount,    // 4
+		schema.CoreComic.ID,             // 5
+	)
+
+	tag, err := repository.pool.Exec(context, query, ids, averages, bayesians, counts)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to update rating scores: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
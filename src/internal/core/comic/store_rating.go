@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comic
+
+import "context"
+
+// ComicRatingRepository defines the data access contract for reader ratings.
+type ComicRatingRepository interface {
+	// # Ratings
+
+	/*
+		FindRating returns the rating summary of a comic.
+
+		Parameters:
+		  - context: context.Context
+		  - comicID: string (UUID)
+		  - userID: string (Viewer; empty for anonymous callers)
+
+		Returns:
+		  - *Rating: Aggregates and the viewer's own score
+		  - error: apperr.NotFound if the comic is missing or deleted
+	*/
+	FindRating(context context.Context, comicID, userID string) (*Rating, error)
+
+	/*
+		UpsertRating stores the score of a user and adjusts the comic counters.
+
+		Description: The running average and count are updated in the same
+		transaction; the Bayesian average is left to the [RatingJob].
+
+		Parameters:
+		  - context: context.Context
+		  - comicID: string (UUID)
+		  - userID: string (UUID)
+		  - score: int
+
+		Returns:
+		  - *Rating: Aggregates after the change, including the new score
+		  - error: apperr.NotFound if the comic is missing or deleted
+	*/
+	UpsertRating(context context.Context, comicID, userID string, score int) (*Rating, error)
+
+	/*
+		DeleteRating removes the score of a user and adjusts the comic counters.
+
+		Parameters:
+		  - context: context.Context
+		  - comicID: string (UUID)
+		  - userID: string (UUID)
+
+		Returns:
+		  - error: apperr.NotFound if the user has not rated the comic
+	*/
+	DeleteRating(context context.Context, comicID, userID string) error
+
+	/*
+		RatingMean returns the average score across every live comic.
+
+		Returns:
+		  - float64: Global mean, zero when nothing is rated
+		  - error: Database retrieval failures
+	*/
+	RatingMean(context context.Context) (float64, error)
+
+	/*
+		ListRatingStats returns the raw score totals of comics in ID order.
+
+		Description: Comics without ratings are included with zero totals so
+		their columns are reset once the last score is removed.
+
+		Parameters:
+		  - context: context.Context
+		  - afterID: string (Last ID of the previous batch; empty to start)
+		  - limit: int
+
+		Returns:
+		  - []RatingStats: One entry per live comic
+		  - error: Database retrieval failures
+	*/
+	ListRatingStats(context context.Context, afterID string, limit int) ([]RatingStats, error)
+
+	/*
+		UpdateRatingScores writes recalculated rating columns.
+
+		Parameters:
+		  - context: context.Context
+		  - scores: []RatingScores
+
+		Returns:
+		  - int: Comics whose columns actually changed
+		  - error: Database execution errors
+	*/
+	UpdateRatingScores(context context.Context, scores []RatingScores) (int, error)
+}
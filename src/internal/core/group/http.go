@@ -18,8 +18,10 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/taibuivan/yomira/internal/platform/constants"
+	"github.com/taibuivan/yomira/internal/platform/middleware"
 	requestutil "github.com/taibuivan/yomira/internal/platform/request"
 	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/sec"
 	"github.com/taibuivan/yomira/internal/platform/validate"
 	"github.com/taibuivan/yomira/pkg/pagination"
 )
@@ -47,16 +49,20 @@ func (handler *Handler) Routes() chi.Router {
 
 	// ## Social & Membership (Auth Required)
 	// Note: Authentication middleware should be wrapped when mounting this router in main.go
-	router.Post("/", handler.createGroup)
-	router.Post("/{id}/follow", handler.followGroup)
-	router.Delete("/{id}/follow", handler.unfollowGroup)
-
-	// ## Administrative (Protected per-endpoint)
-	router.Route("/{id}", func(subRouter chi.Router) {
-		subRouter.Patch("/", handler.updateGroup)
-		subRouter.Route("/members", func(members chi.Router) {
-			members.Post("/", handler.addMember)
-			members.Delete("/{userID}", handler.removeMember)
+	router.Group(func(write chi.Router) {
+		write.Use(middleware.RequireScope(sec.ScopeGroupsWrite))
+
+		write.Post("/", handler.createGroup)
+		write.Post("/{id}/follow", handler.followGroup)
+		write.Delete("/{id}/follow", handler.unfollowGroup)
+
+		// ## Administrative (Protected per-endpoint)
+		write.Route("/{id}", func(subRouter chi.Router) {
+			subRouter.Patch("/", handler.updateGroup)
+			subRouter.Route("/members", func(members chi.Router) {
+				members.Post("/", handler.addMember)
+				members.Delete("/{userID}", handler.removeMember)
+			})
 		})
 	})
 
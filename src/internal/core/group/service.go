@@ -8,23 +8,31 @@ import (
 	"log/slog"
 
 	"github.com/taibuivan/yomira/internal/platform/validate"
+	"github.com/taibuivan/yomira/internal/social/notification"
 	"github.com/taibuivan/yomira/pkg/slug"
 	"github.com/taibuivan/yomira/pkg/uuid"
 )
 
 // # Service Layer
 
+// Notifier delivers in-app notifications to users.
+type Notifier interface {
+	Notify(context context.Context, notification *notification.Notification) error
+}
+
 // Service orchestrates business rules for scanlation groups and memberships.
 type Service struct {
-	repo   Repository
-	logger *slog.Logger
+	repo     Repository
+	notifier Notifier
+	logger   *slog.Logger
 }
 
 // NewService constructs a new group [Service].
-func NewService(repo Repository, logger *slog.Logger) *Service {
+func NewService(repo Repository, notifier Notifier, logger *slog.Logger) *Service {
 	return &Service{
-		repo:   repo,
-		logger: logger,
+		repo:     repo,
+		notifier: notifier,
+		logger:   logger,
 	}
 }
 
@@ -169,6 +177,9 @@ func (service *Service) ListMembers(context context.Context, groupID string) ([]
 /*
 AddMember invites or adds a new user to the group roster.
 
+Description: The new member receives a group invite notification; a
+delivery failure is logged and does not undo the membership.
+
 Parameters:
   - context: context.Context
   - m: *Member
@@ -178,7 +189,34 @@ Returns:
 */
 func (service *Service) AddMember(context context.Context, member *Member) error {
 	// Verification logic (isactive user, etc) would go here
-	return service.repo.AddMember(context, member)
+	if err := service.repo.AddMember(context, member); err != nil {
+		return err
+	}
+
+	service.notifyInvite(context, member)
+	return nil
+}
+
+// notifyInvite tells a new member which group they joined.
+func (service *Service) notifyInvite(context context.Context, member *Member) {
+	group, err := service.repo.FindByID(context, member.GroupID)
+	if err == nil {
+		err = service.notifier.Notify(context, &notification.Notification{
+			UserID:     member.UserID,
+			Kind:       notification.KindGroupInvite,
+			Title:      "You were added to " + group.Name,
+			EntityType: notification.EntityGroup,
+			EntityID:   group.ID,
+		})
+	}
+
+	if err != nil {
+		service.logger.Error("group_invite_notification_failed",
+			slog.String("group_id", member.GroupID),
+			slog.String("user_id", member.UserID),
+			slog.Any("error", err),
+		)
+	}
 }
 
 /*
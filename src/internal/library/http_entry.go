@@ -0,0 +1,339 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+/*
+Package library provides the HTTP interface for a reader's personal library.
+
+# Routing Strategy
+
+  - Public (v1): Shared custom lists under /lists/... honour the list visibility.
+  - Private (v1): Shelf and list management under /me/... require an authenticated session.
+
+The handler translates between the web/JSON layer and the internal domain [Service].
+*/
+package library
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/taibuivan/yomira/internal/platform/middleware"
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+	"github.com/taibuivan/yomira/pkg/convert"
+	"github.com/taibuivan/yomira/pkg/pagination"
+	"github.com/taibuivan/yomira/pkg/query"
+)
+
+// # Handler Implementation
+
+// Handler implements the HTTP layer for the reader's library.
+type Handler struct {
+	service *Service
+}
+
+// NewHandler constructs a new library [Handler].
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes attaches library endpoints to the root API router.
+// Private endpoints live under the /me/... namespace shared with the account domain,
+// while shared lists are exposed under /lists/....
+func (handler *Handler) RegisterRoutes(api chi.Router) {
+
+	// Shared lists (optional authentication)
+	api.Get("/lists/{listID}", handler.getList)
+	api.Get("/lists/{listID}/items", handler.listItems)
+
+	api.Group(func(user chi.Router) {
+		user.Use(middleware.RequireAuth)
+		user.Use(middleware.RequireScopeByMethod(sec.ScopeLibraryRead, sec.ScopeLibraryWrite))
+
+		// Shelf
+		user.Get("/me/library", handler.listEntries)
+		user.Get("/me/library/{comicID}", handler.getEntry)
+		user.Post("/me/library/{comicID}", handler.addEntry)
+		user.Patch("/me/library/{comicID}", handler.updateEntry)
+		user.Delete("/me/library/{comicID}", handler.removeEntry)
+
+		// Custom lists
+		user.Get("/me/lists", handler.listMyLists)
+		user.Post("/me/lists", handler.createList)
+		user.Patch("/me/lists/{listID}", handler.updateList)
+		user.Delete("/me/lists/{listID}", handler.deleteList)
+		user.Get("/me/lists/{listID}/items", handler.listMyItems)
+		user.Post("/me/lists/{listID}/items", handler.addItems)
+		user.Patch("/me/lists/{listID}/items", handler.reorderItems)
+		user.Delete("/me/lists/{listID}/items", handler.removeItems)
+		user.Delete("/me/lists/{listID}/items/{comicID}", handler.removeItem)
+
+		// Reading progress
+		user.Get("/me/progress", handler.listProgress)
+		user.Get("/me/progress/{comicID}", handler.getProgress)
+		user.Put("/me/progress/{comicID}", handler.saveProgress)
+		user.Delete("/me/progress/{comicID}", handler.resetProgress)
+		user.Get("/me/continue-reading", handler.continueReading)
+
+		// Read history
+		user.Get("/me/chapters/read", handler.listReadHistory)
+		user.Delete("/me/chapters/{chapterID}/read", handler.unmarkChapter)
+		user.Get("/me/comics/{comicID}/read", handler.listReadChapterIDs)
+		user.Post("/me/comics/{comicID}/read-all", handler.markComicRead)
+		user.Delete("/me/comics/{comicID}/read-all", handler.markComicUnread)
+	})
+}
+
+// # Shelf Endpoints
+
+/*
+GET /api/v1/me/library.
+
+Description: Retrieves the authenticated user's shelf with filtering and sorting.
+
+Request:
+  - status: []string (reading, completed, on_hold, dropped, plan_to_read)
+  - hasnew: bool (Only entries with unread chapters)
+  - sort: string (updatedat, createdat, score, title, lastreadat)
+  - limit: int
+  - page: int
+
+Response:
+  - 200: []Entry: Paginated shelf entries
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) listEntries(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	paginationParams := pagination.FromRequest(request)
+	queryParams := request.URL.Query()
+
+	filter := EntryFilter{
+		Status: parseStatusSlice(queryParams["status"]),
+		Sort:   queryParams.Get("sort"),
+	}
+	if raw := queryParams.Get("hasnew"); raw != "" {
+		hasNew := convert.ToBool(raw)
+		filter.HasNew = &hasNew
+	}
+
+	entries, total, err := handler.service.ListEntries(request.Context(), userID, filter, paginationParams.Limit, paginationParams.Offset())
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Paginated(writer, entries, pagination.NewMeta(paginationParams.Page, paginationParams.Limit, total))
+}
+
+/*
+GET /api/v1/me/library/{comicID}.
+
+Description: Retrieves the shelf entry for a single comic.
+
+Request:
+  - comicID: string (UUID)
+
+Response:
+  - 200: Entry: Success
+  - 401: ErrUnauthorized: Authentication required
+  - 404: ErrNotFound: Comic is not on the shelf
+*/
+func (handler *Handler) getEntry(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	entry, err := handler.service.GetEntry(request.Context(), userID, requestutil.ID(request, "comicID"))
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, entry)
+}
+
+// # Request Payloads
+
+// entryRequest defines the inbound JSON schema for shelf mutations.
+//
+// Score is kept raw so that an explicit JSON null can be told apart from
+// an omitted field on PATCH.
+type entryRequest struct {
+	ReadingStatus *ReadingStatus  `json:"reading_status"`
+	Score         json.RawMessage `json:"score"`
+}
+
+// toInput converts the payload into an [EntryInput].
+func (payload entryRequest) toInput() (EntryInput, error) {
+	input := EntryInput{ReadingStatus: payload.ReadingStatus}
+
+	// Omitted vs. explicit null vs. value
+	if len(payload.Score) == 0 {
+		return input, nil
+	}
+	if string(payload.Score) == "null" {
+		input.ClearScore = true
+		return input, nil
+	}
+
+	var score int
+	if err := json.Unmarshal(payload.Score, &score); err != nil {
+		return input, validate.RequiredError(FieldScore, "Must be an integer")
+	}
+	input.Score = &score
+
+	return input, nil
+}
+
+/*
+POST /api/v1/me/library/{comicID}.
+
+Description: Adds a comic to the authenticated user's shelf.
+The reading status defaults to 'plan_to_read'.
+
+Request:
+  - comicID: string (UUID)
+  - body: entryRequest (JSON)
+
+Response:
+  - 201: Entry: Created shelf entry
+  - 400: ErrInvalidJSON/Validation: Invalid input data
+  - 401: ErrUnauthorized: Authentication required
+  - 404: ErrNotFound: Comic not found
+  - 409: ErrConflict: Comic already in the library
+*/
+func (handler *Handler) addEntry(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var payload entryRequest
+	if request.ContentLength != 0 {
+		if err := requestutil.DecodeJSON(request, &payload); err != nil {
+			respond.Error(writer, request, err)
+			return
+		}
+	}
+
+	input, err := payload.toInput()
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	entry, err := handler.service.AddEntry(request.Context(), userID, requestutil.ID(request, "comicID"), input)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Created(writer, entry)
+}
+
+/*
+PATCH /api/v1/me/library/{comicID}.
+
+Description: Updates the reading status or private score of a shelf entry.
+Sending "score": null clears the score.
+
+Request:
+  - comicID: string (UUID)
+  - body: entryRequest (Partial JSON)
+
+Response:
+  - 200: Entry: Updated shelf entry
+  - 400: ErrInvalidJSON/Validation: Invalid input data
+  - 401: ErrUnauthorized: Authentication required
+  - 404: ErrNotFound: Comic is not on the shelf
+*/
+func (handler *Handler) updateEntry(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var payload entryRequest
+	if err := requestutil.DecodeJSON(request, &payload); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	input, err := payload.toInput()
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	entry, err := handler.service.UpdateEntry(request.Context(), userID, requestutil.ID(request, "comicID"), input)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, entry)
+}
+
+/*
+DELETE /api/v1/me/library/{comicID}.
+
+Description: Removes a comic from the shelf. Chapter read history is preserved.
+
+Request:
+  - comicID: string (UUID)
+
+Response:
+  - 204: No Content: Success
+  - 401: ErrUnauthorized: Authentication required
+  - 404: ErrNotFound: Comic is not on the shelf
+*/
+func (handler *Handler) removeEntry(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.service.RemoveEntry(request.Context(), userID, requestutil.ID(request, "comicID")); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+// # Helpers
+
+/*
+parseStatusSlice converts repeated or comma-separated values to a slice of ReadingStatus.
+
+Parameters:
+  - values: A slice of strings to convert.
+
+Returns:
+  - A slice of valid ReadingStatus values.
+*/
+func parseStatusSlice(values []string) []ReadingStatus {
+	var result []ReadingStatus
+	for _, value := range values {
+		for _, segment := range query.StringSlice(value) {
+			status := ReadingStatus(segment)
+			if status.IsValid() {
+				result = append(result, status)
+			}
+		}
+	}
+	return result
+}
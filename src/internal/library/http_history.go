@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"net/http"
+	"time"
+
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Read History Endpoints
+
+/*
+GET /api/v1/me/chapters/read.
+
+Description: Retrieves the authenticated user's finished chapters, most recent first.
+
+Request:
+  - comic_id: string (UUID, optional)
+  - since: string (RFC 3339, optional)
+  - limit: int
+  - page: int
+
+Response:
+  - 200: []ReadRecord: Paginated read records
+  - 400: Validation: Invalid filter values
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) listReadHistory(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	query := request.URL.Query()
+	filter := ReadFilter{ComicID: query.Get(FieldComicID)}
+
+	// Time window parsing
+	if raw := query.Get(FieldSince); raw != "" {
+		since, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			respond.Error(writer, request, validate.RequiredError(FieldSince, "Must be an RFC 3339 timestamp"))
+			return
+		}
+		filter.Since = &since
+	}
+
+	paginationParams := pagination.FromRequest(request)
+
+	records, total, err := handler.service.ListReadHistory(request.Context(), userID, filter, paginationParams.Limit, paginationParams.Offset())
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Paginated(writer, records, pagination.NewMeta(paginationParams.Page, paginationParams.Limit, total))
+}
+
+/*
+DELETE /api/v1/me/chapters/{chapterID}/read.
+
+Description: Marks a single chapter as unread again.
+
+Request:
+  - chapterID: string (UUID)
+
+Response:
+  - 204: No Content: Success
+  - 401: ErrUnauthorized: Authentication required
+  - 404: ErrNotFound: Chapter was not marked as read
+*/
+func (handler *Handler) unmarkChapter(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.service.UnmarkChapter(request.Context(), userID, requestutil.ID(request, "chapterID")); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+/*
+GET /api/v1/me/comics/{comicID}/read.
+
+Description: Lists the IDs of every chapter of a comic the user has read,
+so chapter lists can grey them out.
+
+Request:
+  - comicID: string (UUID)
+
+Response:
+  - 200: []string: Read chapter IDs
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) listReadChapterIDs(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	chapterIDs, err := handler.service.ListReadChapterIDs(request.Context(), userID, requestutil.ID(request, "comicID"))
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, chapterIDs)
+}
+
+/*
+POST /api/v1/me/comics/{comicID}/read-all.
+
+Description: Marks every published chapter of a comic as read.
+
+Request:
+  - comicID: string (UUID)
+  - lang: string (ISO code, optional; restricts to one translation)
+
+Response:
+  - 200: {"marked": int}: Number of chapters newly marked
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) markComicRead(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	marked, err := handler.service.MarkComicRead(request.Context(), userID, requestutil.ID(request, "comicID"), request.URL.Query().Get("lang"))
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, map[string]int{"marked": marked})
+}
+
+/*
+DELETE /api/v1/me/comics/{comicID}/read-all.
+
+Description: Clears the read history of a comic.
+
+Request:
+  - comicID: string (UUID)
+  - lang: string (ISO code, optional; restricts to one translation)
+
+Response:
+  - 200: {"removed": int}: Number of read records removed
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) markComicUnread(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	removed, err := handler.service.MarkComicUnread(request.Context(), userID, requestutil.ID(request, "comicID"), request.URL.Query().Get("lang"))
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, map[string]int{"removed": removed})
+}
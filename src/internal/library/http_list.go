@@ -0,0 +1,416 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"net/http"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Shared List Endpoints
+
+/*
+GET /api/v1/lists/{listID}.
+
+Description: Retrieves a custom list by ID. Public and unlisted lists are
+readable without authentication; private lists require ownership.
+
+Request:
+  - listID: string (UUID)
+
+Response:
+  - 200: List: Success
+  - 403: ErrForbidden: The list is private
+  - 404: ErrNotFound: List not found
+*/
+func (handler *Handler) getList(writer http.ResponseWriter, request *http.Request) {
+	list, err := handler.service.GetList(request.Context(), requestutil.ID(request, "listID"), viewerID(request))
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, list)
+}
+
+/*
+GET /api/v1/lists/{listID}/items.
+
+Description: Retrieves the ordered comics of a list, honouring its visibility.
+
+Request:
+  - listID: string (UUID)
+  - limit: int
+  - page: int
+
+Response:
+  - 200: []ListItem: Paginated list items
+  - 403: ErrForbidden: The list is private
+  - 404: ErrNotFound: List not found
+*/
+func (handler *Handler) listItems(writer http.ResponseWriter, request *http.Request) {
+	paginationParams := pagination.FromRequest(request)
+
+	items, total, err := handler.service.ListItems(request.Context(), requestutil.ID(request, "listID"), viewerID(request), paginationParams.Limit, paginationParams.Offset())
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Paginated(writer, items, pagination.NewMeta(paginationParams.Page, paginationParams.Limit, total))
+}
+
+// # Owned List Endpoints
+
+/*
+GET /api/v1/me/lists.
+
+Description: Retrieves every list owned by the authenticated user, including private ones.
+
+Request:
+  - limit: int
+  - page: int
+
+Response:
+  - 200: []List: Paginated lists
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) listMyLists(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	paginationParams := pagination.FromRequest(request)
+
+	lists, total, err := handler.service.ListMyLists(request.Context(), userID, paginationParams.Limit, paginationParams.Offset())
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Paginated(writer, lists, pagination.NewMeta(paginationParams.Page, paginationParams.Limit, total))
+}
+
+/*
+GET /api/v1/me/lists/{listID}/items.
+
+Description: Retrieves the ordered comics of a list owned by the authenticated user.
+
+Request:
+  - listID: string (UUID)
+  - limit: int
+  - page: int
+
+Response:
+  - 200: []ListItem: Paginated list items
+  - 401: ErrUnauthorized: Authentication required
+  - 403: ErrForbidden: List not owned by the user
+*/
+func (handler *Handler) listMyItems(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	paginationParams := pagination.FromRequest(request)
+
+	items, total, err := handler.service.ListItems(request.Context(), requestutil.ID(request, "listID"), userID, paginationParams.Limit, paginationParams.Offset())
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Paginated(writer, items, pagination.NewMeta(paginationParams.Page, paginationParams.Limit, total))
+}
+
+// # Request Payloads
+
+// listRequest defines the inbound JSON schema for list creation and updates.
+type listRequest struct {
+	Name       *string     `json:"name"`
+	Visibility *Visibility `json:"visibility"`
+}
+
+// addItemsRequest defines the inbound JSON schema for bulk item insertion.
+type addItemsRequest struct {
+	Items []ItemPlacement `json:"items"`
+}
+
+// reorderItemsRequest defines the inbound JSON schema for bulk reordering.
+type reorderItemsRequest struct {
+	Order []ItemPlacement `json:"order"`
+}
+
+// removeItemsRequest defines the inbound JSON schema for bulk item removal.
+type removeItemsRequest struct {
+	ComicIDs []string `json:"comic_ids"`
+}
+
+// # Mutation Endpoints
+
+/*
+POST /api/v1/me/lists.
+
+Description: Creates a new custom list. Visibility defaults to 'private'.
+
+Request (Body):
+  - listRequest: JSON object
+
+Response:
+  - 201: List: Created list
+  - 400: ErrInvalidJSON/Validation: Invalid input data
+  - 401: ErrUnauthorized: Authentication required
+  - 422: ErrUnprocessable: List quota reached
+*/
+func (handler *Handler) createList(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input listRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	list, err := handler.service.CreateList(request.Context(), userID, ListInput{Name: input.Name, Visibility: input.Visibility})
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Created(writer, list)
+}
+
+/*
+PATCH /api/v1/me/lists/{listID}.
+
+Description: Renames a list or changes its visibility.
+
+Request:
+  - listID: string (UUID)
+  - body: listRequest (Partial JSON)
+
+Response:
+  - 200: List: Updated list
+  - 400: ErrInvalidJSON/Validation: Invalid input data
+  - 401: ErrUnauthorized: Authentication required
+  - 403: ErrForbidden: List not owned by the user
+*/
+func (handler *Handler) updateList(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input listRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	list, err := handler.service.UpdateList(request.Context(), userID, requestutil.ID(request, "listID"), ListInput{Name: input.Name, Visibility: input.Visibility})
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, list)
+}
+
+/*
+DELETE /api/v1/me/lists/{listID}.
+
+Description: Soft-deletes a list and removes its items.
+
+Request:
+  - listID: string (UUID)
+
+Response:
+  - 204: No Content: Success
+  - 401: ErrUnauthorized: Authentication required
+  - 403: ErrForbidden: List not owned by the user
+*/
+func (handler *Handler) deleteList(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.service.DeleteList(request.Context(), userID, requestutil.ID(request, "listID")); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+/*
+POST /api/v1/me/lists/{listID}/items.
+
+Description: Adds one or more comics to a list. Items without a sort order
+are appended after the current last item; duplicates are skipped.
+
+Request:
+  - listID: string (UUID)
+  - body: addItemsRequest (JSON)
+
+Response:
+  - 200: {added: int}: Number of comics actually added
+  - 400: ErrInvalidJSON/Validation: Invalid input data
+  - 401: ErrUnauthorized: Authentication required
+  - 403: ErrForbidden: List not owned by the user
+  - 422: ErrUnprocessable: Item quota reached
+*/
+func (handler *Handler) addItems(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input addItemsRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	added, err := handler.service.AddItems(request.Context(), userID, requestutil.ID(request, "listID"), input.Items)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, map[string]int{"added": added})
+}
+
+/*
+PATCH /api/v1/me/lists/{listID}/items.
+
+Description: Reorders items in a list in a single batch.
+
+Request:
+  - listID: string (UUID)
+  - body: reorderItemsRequest (JSON)
+
+Response:
+  - 200: {updated: int}: Number of items actually reordered
+  - 400: ErrInvalidJSON/Validation: Invalid input data
+  - 401: ErrUnauthorized: Authentication required
+  - 403: ErrForbidden: List not owned by the user
+*/
+func (handler *Handler) reorderItems(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input reorderItemsRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	updated, err := handler.service.ReorderItems(request.Context(), userID, requestutil.ID(request, "listID"), input.Order)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, map[string]int{"updated": updated})
+}
+
+/*
+DELETE /api/v1/me/lists/{listID}/items.
+
+Description: Removes several comics from a list in a single batch.
+
+Request:
+  - listID: string (UUID)
+  - body: removeItemsRequest (JSON)
+
+Response:
+  - 200: {removed: int}: Number of comics actually removed
+  - 400: ErrInvalidJSON/Validation: Invalid input data
+  - 401: ErrUnauthorized: Authentication required
+  - 403: ErrForbidden: List not owned by the user
+*/
+func (handler *Handler) removeItems(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input removeItemsRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	removed, err := handler.service.RemoveItems(request.Context(), userID, requestutil.ID(request, "listID"), input.ComicIDs)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, map[string]int{"removed": removed})
+}
+
+/*
+DELETE /api/v1/me/lists/{listID}/items/{comicID}.
+
+Description: Removes a single comic from a list.
+
+Request:
+  - listID: string (UUID)
+  - comicID: string (UUID)
+
+Response:
+  - 204: No Content: Success
+  - 401: ErrUnauthorized: Authentication required
+  - 403: ErrForbidden: List not owned by the user
+  - 404: ErrNotFound: Comic not in this list
+*/
+func (handler *Handler) removeItem(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	removed, err := handler.service.RemoveItems(request.Context(), userID, requestutil.ID(request, "listID"), []string{requestutil.ID(request, "comicID")})
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+	if removed == 0 {
+		respond.Error(writer, request, apperr.NotFound("Comic in this list"))
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+// # Helpers
+
+// viewerID returns the authenticated user's ID, or an empty string for anonymous visitors.
+func viewerID(request *http.Request) string {
+	if claims := requestutil.Claims(request); claims != nil {
+		return claims.UserID
+	}
+	return ""
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"net/http"
+	"time"
+
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Reading Progress Endpoints
+
+/*
+GET /api/v1/me/progress.
+
+Description: Retrieves every reading position of the authenticated user, most recent first.
+
+Request:
+  - limit: int
+  - page: int
+
+Response:
+  - 200: []Progress: Paginated positions
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) listProgress(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	paginationParams := pagination.FromRequest(request)
+
+	positions, total, err := handler.service.ListProgress(request.Context(), userID, paginationParams.Limit, paginationParams.Offset())
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Paginated(writer, positions, pagination.NewMeta(paginationParams.Page, paginationParams.Limit, total))
+}
+
+/*
+GET /api/v1/me/progress/{comicID}.
+
+Description: Retrieves the reading position for a single comic.
+
+Request:
+  - comicID: string (UUID)
+
+Response:
+  - 200: Progress: Success
+  - 401: ErrUnauthorized: Authentication required
+  - 404: ErrNotFound: No reading progress for this comic
+*/
+func (handler *Handler) getProgress(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	progress, err := handler.service.GetProgress(request.Context(), userID, requestutil.ID(request, "comicID"))
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, progress)
+}
+
+// saveProgressRequest defines the inbound JSON schema for position sync.
+type saveProgressRequest struct {
+	ChapterID  string     `json:"chapter_id"`
+	PageNumber int        `json:"page_number"`
+	UpdatedAt  *time.Time `json:"updated_at"` // Device time of the position (RFC 3339)
+}
+
+/*
+PUT /api/v1/me/progress/{comicID}.
+
+Description: Saves the current chapter and page position reported by a reader.
+Conflicts between devices are resolved with last-write-wins on updated_at;
+the response always carries the authoritative stored position.
+
+Request:
+  - comicID: string (UUID)
+  - body: saveProgressRequest (JSON)
+
+Response:
+  - 200: Progress: Authoritative position after the write
+  - 400: ErrInvalidJSON/Validation: Invalid input data
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) saveProgress(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input saveProgressRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	progress, err := handler.service.SaveProgress(request.Context(), userID, requestutil.ID(request, "comicID"), ProgressInput{
+		ChapterID:  input.ChapterID,
+		PageNumber: input.PageNumber,
+		ReportedAt: input.UpdatedAt,
+	})
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, progress)
+}
+
+/*
+DELETE /api/v1/me/progress/{comicID}.
+
+Description: Resets the reading position of a comic so the user can start over.
+
+Request:
+  - comicID: string (UUID)
+
+Response:
+  - 204: No Content: Success
+  - 401: ErrUnauthorized: Authentication required
+  - 404: ErrNotFound: No reading progress for this comic
+*/
+func (handler *Handler) resetProgress(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.service.ResetProgress(request.Context(), userID, requestutil.ID(request, "comicID")); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+/*
+GET /api/v1/me/continue-reading.
+
+Description: Lists the next unread chapter for every shelved comic, most
+recently read first. Comics the user is caught up on are omitted.
+
+Request:
+  - limit: int
+  - page: int
+
+Response:
+  - 200: []ContinueItem: Paginated suggestions
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) continueReading(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	paginationParams := pagination.FromRequest(request)
+
+	items, total, err := handler.service.ContinueReading(request.Context(), userID, paginationParams.Limit, paginationParams.Offset())
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Paginated(writer, items, pagination.NewMeta(paginationParams.Page, paginationParams.Limit, total))
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/system/batch"
+)
+
+// # Background Jobs
+
+// ReleaseJobKey identifies the "has new chapters" fan-out in the batch registry.
+const ReleaseJobKey = "library.hasnew"
+
+/*
+ReleaseJob fans newly published chapters out to the shelves of their followers.
+
+Description: Chapter creation only writes the chapter row. This job sweeps
+the chapters that became visible since its previous successful run
+(including scheduled chapters whose PublishedAt has passed) and flags every
+shelf entry of the affected comics in small batches. Popular comics have
+tens of thousands of followers, so the fan-out never runs inside an HTTP
+request.
+
+The flag is cleared inline by the read flows once the reader reaches the
+latest published chapter.
+*/
+type ReleaseJob struct {
+	releaseRepo ReleaseRepository
+	logger      *slog.Logger
+}
+
+// NewReleaseJob constructs a new [ReleaseJob].
+func NewReleaseJob(releaseRepo ReleaseRepository, logger *slog.Logger) *ReleaseJob {
+	return &ReleaseJob{
+		releaseRepo: releaseRepo,
+		logger:      logger,
+	}
+}
+
+// Definition describes the job for the batch scheduler.
+func (job *ReleaseJob) Definition() batch.Job {
+	return batch.Job{
+		Key:         ReleaseJobKey,
+		Description: "Flag shelf entries of comics with newly published chapters",
+		Cron:        ReleaseSweepCron,
+		Timeout:     ReleaseSweepTimeout,
+		Handler: func(context context.Context, execution batch.Execution) (batch.Result, error) {
+			flagged, comics, err := job.Sweep(context, execution.PreviousSuccessAt)
+			return batch.Result{
+				RowsAffected: int64(flagged),
+				Meta:         map[string]any{"comics": comics, "entries_flagged": flagged},
+			}, err
+		},
+	}
+}
+
+/*
+Sweep flags the shelves of every comic released since the given watermark.
+
+Parameters:
+  - context: context.Context
+  - since: *time.Time (Start of the previous successful sweep; nil = first run)
+
+Returns:
+  - int: Number of shelf entries flagged
+  - int: Number of comics with new chapters
+  - error: Repository level errors
+*/
+func (job *ReleaseJob) Sweep(context context.Context, since *time.Time) (int, int, error) {
+	until := time.Now().UTC()
+
+	// Window resolution with overlap for late commits
+	from := until.Add(-ReleaseSweepLookback)
+	if since != nil {
+		from = since.Add(-ReleaseSweepOverlap)
+	}
+
+	releases, err := job.releaseRepo.ListReleases(context, from, until)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Batched fan-out per comic
+	flagged := 0
+	for _, release := range releases {
+		for {
+			affected, err := job.releaseRepo.FlagNew(context, release, ReleaseFlagBatchSize)
+			if err != nil {
+				return flagged, len(releases), err
+			}
+			flagged += affected
+
+			if affected < ReleaseFlagBatchSize {
+				break
+			}
+		}
+	}
+
+	if flagged > 0 {
+		job.logger.Info("library_release_sweep_finished",
+			slog.Int("comics", len(releases)),
+			slog.Int("entries_flagged", flagged),
+		)
+	}
+
+	return flagged, len(releases), nil
+}
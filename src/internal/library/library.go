@@ -0,0 +1,288 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+/*
+Package library defines the personal reading space of a Yomira user.
+
+It tracks which comics a reader follows, how far they have read, and the
+private metadata (status, score) they attach to each title.
+
+Core Responsibility:
+
+  - Shelf: Manages [Entry] records that bind a user to a followed comic.
+  - Status: Classifies entries by [ReadingStatus] (Reading, Completed, Dropped).
+  - Signals: Exposes the "has new chapters" flag used by the reader dashboard.
+  - Curation: Manages user-authored [List] collections shared with the community.
+  - Progress: Syncs the last chapter and page reached across devices ([Progress]).
+  - History: Records which chapters a reader has finished ([ReadRecord]).
+
+All data in this package is owned by a single user and never shared implicitly.
+*/
+package library
+
+import "time"
+
+// # Domain Enums
+
+// ReadingStatus represents where a reader stands with a followed comic.
+type ReadingStatus string
+
+const (
+	// StatusReading indicates the user is actively following new chapters.
+	StatusReading ReadingStatus = "reading"
+
+	// StatusCompleted indicates the user has finished the comic.
+	StatusCompleted ReadingStatus = "completed"
+
+	// StatusOnHold indicates the user has paused reading temporarily.
+	StatusOnHold ReadingStatus = "on_hold"
+
+	// StatusDropped indicates the user has abandoned the comic.
+	StatusDropped ReadingStatus = "dropped"
+
+	// StatusPlanToRead is the default for newly shelved comics.
+	StatusPlanToRead ReadingStatus = "plan_to_read"
+)
+
+// IsValid reports whether s is a recognised [ReadingStatus] value.
+func (s ReadingStatus) IsValid() bool {
+	switch s {
+	case
+		StatusReading,
+		StatusCompleted,
+		StatusOnHold,
+		StatusDropped,
+		StatusPlanToRead:
+		return true
+	}
+	return false
+}
+
+// readingStatusValues lists every [ReadingStatus] for validation messages.
+var readingStatusValues = []string{
+	string(StatusReading),
+	string(StatusCompleted),
+	string(StatusOnHold),
+	string(StatusDropped),
+	string(StatusPlanToRead),
+}
+
+// Visibility controls who can discover and read a custom [List].
+type Visibility string
+
+const (
+	// VisibilityPublic lists are discoverable and readable by anyone.
+	VisibilityPublic Visibility = "public"
+
+	// VisibilityUnlisted lists are readable by anyone holding the link.
+	VisibilityUnlisted Visibility = "unlisted"
+
+	// VisibilityPrivate lists are only readable by their owner.
+	VisibilityPrivate Visibility = "private"
+)
+
+// IsValid reports whether v is a recognised [Visibility] value.
+func (v Visibility) IsValid() bool {
+	switch v {
+	case VisibilityPublic, VisibilityUnlisted, VisibilityPrivate:
+		return true
+	}
+	return false
+}
+
+// visibilityValues lists every [Visibility] for validation messages.
+var visibilityValues = []string{
+	string(VisibilityPublic),
+	string(VisibilityUnlisted),
+	string(VisibilityPrivate),
+}
+
+// # Shelf Entities
+
+// Entry represents a single comic on a user's library shelf.
+type Entry struct {
+	ID                int64         `json:"id"`
+	UserID            string        `json:"user_id"`
+	ComicID           string        `json:"comic_id"`
+	Comic             *ComicSummary `json:"comic,omitempty"` // Denormalized for display
+	ReadingStatus     ReadingStatus `json:"reading_status"`
+	Score             *int          `json:"score"` // Private score (1-10), distinct from public ratings
+	HasNew            bool          `json:"has_new"`
+	LastReadChapterID *string       `json:"last_read_chapter_id"`
+	LastReadAt        *time.Time    `json:"last_read_at"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+}
+
+// ComicSummary is the minimal comic projection embedded in library responses.
+type ComicSummary struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Slug     string `json:"slug"`
+	CoverURL string `json:"cover_url"`
+	Status   string `json:"status"`
+}
+
+// ChapterSummary is the minimal chapter projection embedded in library responses.
+type ChapterSummary struct {
+	ID       string  `json:"id"`
+	Number   float64 `json:"chapter_number"`
+	Title    string  `json:"title"`
+	Language string  `json:"language"` // BCP-47 code
+}
+
+// # Progress Entities
+
+// Progress is the last reading position of a user in a comic.
+// There is exactly one record per (user, comic) pair.
+type Progress struct {
+	ID         int64           `json:"id"`
+	UserID     string          `json:"user_id"`
+	ComicID    string          `json:"comic_id"`
+	ChapterID  string          `json:"chapter_id"`
+	Chapter    *ChapterSummary `json:"chapter,omitempty"`
+	PageNumber int             `json:"page_number"` // 1 = chapter opened
+	UpdatedAt  time.Time       `json:"updated_at"`  // Device-reported time, used for last-write-wins
+}
+
+// ContinueItem is a "continue reading" suggestion for a shelved comic.
+type ContinueItem struct {
+	ComicID       string          `json:"comic_id"`
+	Comic         *ComicSummary   `json:"comic"`
+	ReadingStatus ReadingStatus   `json:"reading_status"`
+	ChapterID     *string         `json:"chapter_id"`  // Current position, nil if never opened
+	PageNumber    *int            `json:"page_number"` // Current page, nil if never opened
+	NextChapter   *ChapterSummary `json:"next_chapter"`
+	LastReadAt    *time.Time      `json:"last_read_at"`
+}
+
+// # History Entities
+
+// ReadRecord marks a chapter as fully read by a user.
+type ReadRecord struct {
+	ChapterID string          `json:"chapter_id"`
+	ComicID   string          `json:"comic_id"`
+	Chapter   *ChapterSummary `json:"chapter,omitempty"`
+	ReadAt    time.Time       `json:"read_at"`
+}
+
+// ReadFilter holds the parameters for a filtered read history query.
+type ReadFilter struct {
+	ComicID string     `json:"comic_id,omitempty"`
+	Since   *time.Time `json:"since,omitempty"` // Only chapters read after this instant
+}
+
+// # Release Entities
+
+// Release reports that a comic gained newly published chapters.
+type Release struct {
+	ComicID     string
+	PublishedAt time.Time // Latest publication in the scanned window
+}
+
+// # Curation Entities
+
+// List is a user-curated, ordered collection of comics.
+type List struct {
+	ID         string     `json:"id"` // UUIDv7, used in shareable URLs
+	UserID     string     `json:"user_id"`
+	Name       string     `json:"name"`
+	Visibility Visibility `json:"visibility"`
+	ItemCount  int        `json:"item_count"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	DeletedAt  *time.Time `json:"-"` // nil = active; non-nil = soft-deleted
+}
+
+// IsReadableBy reports whether the given user may read the list.
+// An empty userID represents an anonymous visitor.
+func (list *List) IsReadableBy(userID string) bool {
+	return list.Visibility != VisibilityPrivate || (userID != "" && list.UserID == userID)
+}
+
+// ListItem is a single comic placed in a [List].
+type ListItem struct {
+	ListID    string        `json:"list_id"`
+	ComicID   string        `json:"comic_id"`
+	Comic     *ComicSummary `json:"comic,omitempty"`
+	SortOrder int           `json:"sort_order"` // Lower values are shown first
+	AddedAt   time.Time     `json:"added_at"`
+}
+
+// ItemPlacement positions a comic within a [List].
+// A nil SortOrder appends the comic after the current last item.
+type ItemPlacement struct {
+	ComicID   string `json:"comic_id"`
+	SortOrder *int   `json:"sort_order,omitempty"`
+}
+
+// # Search & Filtering
+
+// EntryFilter holds the parameters for a filtered shelf query.
+type EntryFilter struct {
+	Status []ReadingStatus `json:"status,omitempty"`
+	HasNew *bool           `json:"has_new,omitempty"`
+	Sort   string          `json:"sort,omitempty"` // updatedat, createdat, score, title, lastreadat
+}
+
+// # Constraints
+
+const (
+	// MinScore is the lowest private score a user can assign.
+	MinScore = 1
+
+	// MaxScore is the highest private score a user can assign.
+	MaxScore = 10
+
+	// MaxListsPerUser caps the number of active custom lists per user.
+	MaxListsPerUser = 100
+
+	// MaxItemsPerList caps the number of comics in a single custom list.
+	MaxItemsPerList = 500
+
+	// MaxListNameLength is the maximum length of a list name.
+	MaxListNameLength = 200
+
+	// MaxProgressClockSkew bounds how far in the future a device timestamp may be
+	// before it is clamped to server time, so a drifting clock cannot pin progress.
+	MaxProgressClockSkew = time.Minute
+)
+
+// # Background Jobs
+
+const (
+	// ReleaseSweepCron is the default schedule of the release fan-out job.
+	ReleaseSweepCron = "* * * * *"
+
+	// ReleaseSweepTimeout bounds a single fan-out run.
+	ReleaseSweepTimeout = 10 * time.Minute
+
+	// ReleaseSweepOverlap re-scans the tail of the previous window so chapters
+	// committed late (or published by an edit) are not missed. Flagging is idempotent.
+	ReleaseSweepOverlap = 5 * time.Minute
+
+	// ReleaseSweepLookback is the window scanned on the first sweep after startup.
+	ReleaseSweepLookback = 24 * time.Hour
+
+	// ReleaseFlagBatchSize caps the number of shelf entries flagged per statement,
+	// keeping row locks short for comics with many followers.
+	ReleaseFlagBatchSize = 1000
+)
+
+// # Field Identifiers
+
+// Global field names for validation and dynamic query mapping.
+const (
+	FieldComicID       = "comic_id"
+	FieldReadingStatus = "reading_status"
+	FieldScore         = "score"
+	FieldName          = "name"
+	FieldVisibility    = "visibility"
+	FieldItems         = "items"
+	FieldComicIDs      = "comic_ids"
+	FieldSortOrder     = "sort_order"
+	FieldChapterID     = "chapter_id"
+	FieldPageNumber    = "page_number"
+	FieldLanguage      = "language"
+	FieldSince         = "since"
+)
@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/taibuivan/yomira/internal/platform/validate"
+)
+
+// # Service Layer
+
+// Service orchestrates the business logic for a reader's personal library.
+type Service struct {
+	entryRepo    EntryRepository
+	listRepo     ListRepository
+	progressRepo ProgressRepository
+	historyRepo  HistoryRepository
+	logger       *slog.Logger
+}
+
+// NewService constructs a new [Service] with its required repositories.
+func NewService(
+	entryRepo EntryRepository,
+	listRepo ListRepository,
+	progressRepo ProgressRepository,
+	historyRepo HistoryRepository,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		entryRepo:    entryRepo,
+		listRepo:     listRepo,
+		progressRepo: progressRepo,
+		historyRepo:  historyRepo,
+		logger:       logger,
+	}
+}
+
+// # Shelf Lookups
+
+/*
+ListEntries retrieves a paginated and filtered view of the user's shelf.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - filter: EntryFilter (Status, hasnew and sorting)
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*Entry: Slice of shelf entries
+  - int: Total count of entries matching the filter
+  - error: System or repository level errors
+*/
+func (service *Service) ListEntries(context context.Context, userID string, filter EntryFilter, limit, offset int) ([]*Entry, int, error) {
+	return service.entryRepo.List(context, userID, filter, limit, offset)
+}
+
+/*
+GetEntry fetches the shelf entry for a single comic.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+
+Returns:
+  - *Entry: The hydrated entry
+  - error: ErrNotFound if the comic is not on the shelf
+*/
+func (service *Service) GetEntry(context context.Context, userID, comicID string) (*Entry, error) {
+	return service.entryRepo.Find(context, userID, comicID)
+}
+
+// # Shelf Management
+
+// EntryInput defines the mutable subset of shelf entry fields.
+//
+// For updates, a nil field is left untouched. ClearScore removes the
+// private score explicitly since a nil Score means "unchanged".
+type EntryInput struct {
+	ReadingStatus *ReadingStatus
+	Score         *int
+	ClearScore    bool
+}
+
+/*
+AddEntry places a comic on the user's shelf.
+
+Description: Defaults the reading status to 'plan_to_read' when omitted and
+validates the private score range before persisting. The repository bumps
+the comic's follower counter in the same transaction.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+  - input: EntryInput
+
+Returns:
+  - *Entry: The created entry, hydrated with its comic summary
+  - error: Validation, NotFound (comic) or Conflict (already shelved)
+*/
+func (service *Service) AddEntry(context context.Context, userID, comicID string, input EntryInput) (*Entry, error) {
+
+	// Default lifecycle state
+	status := StatusPlanToRead
+	if input.ReadingStatus != nil {
+		status = *input.ReadingStatus
+	}
+
+	// Business attribute validation
+	validator := &validate.Validator{}
+	validator.UUID(FieldComicID, comicID)
+	validator.OneOf(FieldReadingStatus, string(status), readingStatusValues...)
+	if input.Score != nil {
+		validator.Range(FieldScore, *input.Score, MinScore, MaxScore)
+	}
+	if err := validator.Err(); err != nil {
+		return nil, err
+	}
+
+	entry := &Entry{
+		UserID:        userID,
+		ComicID:       comicID,
+		ReadingStatus: status,
+		Score:         input.Score,
+	}
+
+	// Persistence via Repository
+	if err := service.entryRepo.Create(context, entry); err != nil {
+		return nil, err
+	}
+
+	service.logger.Info("library_entry_added",
+		slog.String("user_id", userID),
+		slog.String("comic_id", comicID),
+		slog.String("reading_status", string(status)),
+	)
+
+	// Re-read for the joined comic summary
+	return service.entryRepo.Find(context, userID, comicID)
+}
+
+/*
+UpdateEntry applies partial changes to an existing shelf entry.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+  - input: EntryInput
+
+Returns:
+  - *Entry: The updated entry
+  - error: Validation or NotFound errors
+*/
+func (service *Service) UpdateEntry(context context.Context, userID, comicID string, input EntryInput) (*Entry, error) {
+
+	// Integrity validation for updated fields
+	validator := &validate.Validator{}
+	if input.ReadingStatus != nil {
+		validator.OneOf(FieldReadingStatus, string(*input.ReadingStatus), readingStatusValues...)
+	}
+	if input.Score != nil {
+		validator.Range(FieldScore, *input.Score, MinScore, MaxScore)
+	}
+	if err := validator.Err(); err != nil {
+		return nil, err
+	}
+
+	// Fetch current state
+	entry, err := service.entryRepo.Find(context, userID, comicID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply partial changes
+	if input.ReadingStatus != nil {
+		entry.ReadingStatus = *input.ReadingStatus
+	}
+	if input.Score != nil {
+		entry.Score = input.Score
+	}
+	if input.ClearScore {
+		entry.Score = nil
+	}
+
+	// Execute storage update
+	if err := service.entryRepo.Update(context, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+/*
+RemoveEntry takes a comic off the user's shelf.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+
+Returns:
+  - error: NotFound if the comic was not shelved
+*/
+func (service *Service) RemoveEntry(context context.Context, userID, comicID string) error {
+	if err := service.entryRepo.Delete(context, userID, comicID); err != nil {
+		return err
+	}
+
+	service.logger.Info("library_entry_removed",
+		slog.String("user_id", userID),
+		slog.String("comic_id", comicID),
+	)
+
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/taibuivan/yomira/internal/platform/validate"
+)
+
+// # Read History
+
+/*
+ListReadHistory retrieves the user's finished chapters, most recent first.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - filter: ReadFilter (Comic and time window)
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*ReadRecord: Slice of read records
+  - int: Total count of records matching the filter
+  - error: Validation or repository level errors
+*/
+func (service *Service) ListReadHistory(context context.Context, userID string, filter ReadFilter, limit, offset int) ([]*ReadRecord, int, error) {
+	if filter.ComicID != "" {
+		validator := &validate.Validator{}
+		validator.UUID(FieldComicID, filter.ComicID)
+		if err := validator.Err(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return service.historyRepo.List(context, userID, filter, limit, offset)
+}
+
+/*
+ListReadChapterIDs returns the IDs of every chapter of a comic the user has read.
+
+Description: Used by chapter lists to grey out finished chapters without
+shipping the full history payload.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+
+Returns:
+  - []string: Read chapter IDs (never nil)
+  - error: Validation or repository level errors
+*/
+func (service *Service) ListReadChapterIDs(context context.Context, userID, comicID string) ([]string, error) {
+	validator := &validate.Validator{}
+	validator.UUID(FieldComicID, comicID)
+	if err := validator.Err(); err != nil {
+		return nil, err
+	}
+
+	return service.historyRepo.ListChapterIDs(context, userID, comicID)
+}
+
+/*
+UnmarkChapter removes a chapter from the user's read history.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - chapterID: string
+
+Returns:
+  - error: NotFound if the chapter was not marked as read
+*/
+func (service *Service) UnmarkChapter(context context.Context, userID, chapterID string) error {
+	return service.historyRepo.Unmark(context, userID, chapterID)
+}
+
+/*
+MarkComicRead marks every published chapter of a comic as read.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+  - language: string (Optional ISO code; empty = all languages)
+
+Returns:
+  - int: Number of chapters newly marked as read
+  - error: Validation or repository level errors
+*/
+func (service *Service) MarkComicRead(context context.Context, userID, comicID, language string) (int, error) {
+	validator := &validate.Validator{}
+	validator.UUID(FieldComicID, comicID)
+	validator.MaxLen(FieldLanguage, language, 10)
+	if err := validator.Err(); err != nil {
+		return 0, err
+	}
+
+	marked, err := service.historyRepo.MarkAll(context, userID, comicID, language)
+	if err != nil {
+		return 0, err
+	}
+
+	service.logger.Info("library_comic_marked_read",
+		slog.String("user_id", userID),
+		slog.String("comic_id", comicID),
+		slog.Int("marked", marked),
+	)
+
+	return marked, nil
+}
+
+/*
+MarkComicUnread clears the read history of a comic.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+  - language: string (Optional ISO code; empty = all languages)
+
+Returns:
+  - int: Number of read records removed
+  - error: Validation or repository level errors
+*/
+func (service *Service) MarkComicUnread(context context.Context, userID, comicID, language string) (int, error) {
+	validator := &validate.Validator{}
+	validator.UUID(FieldComicID, comicID)
+	validator.MaxLen(FieldLanguage, language, 10)
+	if err := validator.Err(); err != nil {
+		return 0, err
+	}
+
+	removed, err := service.historyRepo.UnmarkAll(context, userID, comicID, language)
+	if err != nil {
+		return 0, err
+	}
+
+	service.logger.Info("library_comic_marked_unread",
+		slog.String("user_id", userID),
+		slog.String("comic_id", comicID),
+		slog.Int("removed", removed),
+	)
+
+	return removed, nil
+}
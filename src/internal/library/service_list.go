@@ -0,0 +1,374 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # Custom List Lookups
+
+/*
+ListMyLists retrieves the authenticated user's lists, including private ones.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*List: Slice of owned lists
+  - int: Total number of active lists
+  - error: Repository level errors
+*/
+func (service *Service) ListMyLists(context context.Context, userID string, limit, offset int) ([]*List, int, error) {
+	return service.listRepo.ListByOwner(context, userID, limit, offset)
+}
+
+/*
+GetList fetches a list on behalf of a viewer, enforcing its visibility.
+
+Description: Public and unlisted lists are readable by anyone; private
+lists are only returned to their owner.
+
+Parameters:
+  - context: context.Context
+  - listID: string
+  - viewerID: string (Empty for anonymous visitors)
+
+Returns:
+  - *List: The hydrated list
+  - error: NotFound if missing, Forbidden if private and not owned
+*/
+func (service *Service) GetList(context context.Context, listID, viewerID string) (*List, error) {
+	list, err := service.listRepo.FindByID(context, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !list.IsReadableBy(viewerID) {
+		return nil, apperr.Forbidden("This list is private")
+	}
+
+	return list, nil
+}
+
+/*
+ListItems retrieves the comics of a list on behalf of a viewer.
+
+Parameters:
+  - context: context.Context
+  - listID: string
+  - viewerID: string (Empty for anonymous visitors)
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*ListItem: Ordered slice of list items
+  - int: Total number of visible items
+  - error: NotFound or Forbidden according to visibility
+*/
+func (service *Service) ListItems(context context.Context, listID, viewerID string, limit, offset int) ([]*ListItem, int, error) {
+	if _, err := service.GetList(context, listID, viewerID); err != nil {
+		return nil, 0, err
+	}
+	return service.listRepo.ListItems(context, listID, limit, offset)
+}
+
+// # Custom List Management
+
+// ListInput defines the mutable subset of custom list fields.
+// For updates, a nil field is left untouched.
+type ListInput struct {
+	Name       *string
+	Visibility *Visibility
+}
+
+/*
+CreateList initialises a new custom list for the user.
+
+Description: Enforces the per-user list quota, defaults visibility to
+'private' and generates a UUIDv7 identity used in shareable URLs.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - input: ListInput
+
+Returns:
+  - *List: The created list
+  - error: Validation, quota or persistence errors
+*/
+func (service *Service) CreateList(context context.Context, userID string, input ListInput) (*List, error) {
+
+	// Default visibility
+	visibility := VisibilityPrivate
+	if input.Visibility != nil {
+		visibility = *input.Visibility
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = strings.TrimSpace(*input.Name)
+	}
+
+	// Business attribute validation
+	validator := &validate.Validator{}
+	validator.Required(FieldName, name).MaxLen(FieldName, name, MaxListNameLength)
+	validator.OneOf(FieldVisibility, string(visibility), visibilityValues...)
+	if err := validator.Err(); err != nil {
+		return nil, err
+	}
+
+	// Quota enforcement
+	count, err := service.listRepo.CountByOwner(context, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= MaxListsPerUser {
+		return nil, apperr.Unprocessable(fmt.Sprintf("You have reached the maximum number of custom lists (%d)", MaxListsPerUser))
+	}
+
+	list := &List{
+		ID:         uuid.New(),
+		UserID:     userID,
+		Name:       name,
+		Visibility: visibility,
+	}
+
+	// Persistence via Repository
+	if err := service.listRepo.Create(context, list); err != nil {
+		return nil, err
+	}
+
+	service.logger.Info("custom_list_created",
+		slog.String("list_id", list.ID),
+		slog.String("user_id", userID),
+	)
+
+	return list, nil
+}
+
+/*
+UpdateList applies partial changes to a list owned by the user.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - listID: string
+  - input: ListInput
+
+Returns:
+  - *List: The updated list
+  - error: Validation, ownership or persistence errors
+*/
+func (service *Service) UpdateList(context context.Context, userID, listID string, input ListInput) (*List, error) {
+
+	// Integrity validation for updated fields
+	validator := &validate.Validator{}
+	if input.Name != nil {
+		trimmed := strings.TrimSpace(*input.Name)
+		input.Name = &trimmed
+		validator.Required(FieldName, trimmed).MaxLen(FieldName, trimmed, MaxListNameLength)
+	}
+	if input.Visibility != nil {
+		validator.OneOf(FieldVisibility, string(*input.Visibility), visibilityValues...)
+	}
+	if err := validator.Err(); err != nil {
+		return nil, err
+	}
+
+	list, err := service.ownedList(context, userID, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply partial changes
+	if input.Name != nil {
+		list.Name = *input.Name
+	}
+	if input.Visibility != nil {
+		list.Visibility = *input.Visibility
+	}
+
+	if err := service.listRepo.Update(context, list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+/*
+DeleteList soft-deletes a list owned by the user.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - listID: string
+
+Returns:
+  - error: Ownership or persistence errors
+*/
+func (service *Service) DeleteList(context context.Context, userID, listID string) error {
+	if _, err := service.ownedList(context, userID, listID); err != nil {
+		return err
+	}
+
+	if err := service.listRepo.SoftDelete(context, listID); err != nil {
+		return err
+	}
+
+	service.logger.Info("custom_list_deleted",
+		slog.String("list_id", listID),
+		slog.String("user_id", userID),
+	)
+
+	return nil
+}
+
+// # Item Management
+
+/*
+AddItems places one or more comics into a list owned by the user.
+
+Description: Validates every placement, rejects batches that would push the
+list over [MaxItemsPerList], then inserts in a single statement. Comics
+already present or unknown are skipped rather than failing the batch.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - listID: string
+  - placements: []ItemPlacement
+
+Returns:
+  - int: Number of comics actually added
+  - error: Validation, ownership, quota or persistence errors
+*/
+func (service *Service) AddItems(context context.Context, userID, listID string, placements []ItemPlacement) (int, error) {
+
+	// Batch validation
+	validator := &validate.Validator{}
+	validator.Custom(FieldItems, len(placements) == 0, "At least one item is required")
+	validator.Custom(FieldItems, len(placements) > MaxItemsPerList, fmt.Sprintf("Must contain at most %d items", MaxItemsPerList))
+	for _, placement := range placements {
+		validator.UUID(FieldComicID, placement.ComicID)
+		if placement.SortOrder != nil {
+			validator.Custom(FieldSortOrder, *placement.SortOrder < 0, "Must be greater than or equal to 0")
+		}
+	}
+	if err := validator.Err(); err != nil {
+		return 0, err
+	}
+
+	if _, err := service.ownedList(context, userID, listID); err != nil {
+		return 0, err
+	}
+
+	// Quota enforcement
+	count, err := service.listRepo.CountItems(context, listID)
+	if err != nil {
+		return 0, err
+	}
+	if count+len(placements) > MaxItemsPerList {
+		return 0, apperr.Unprocessable(fmt.Sprintf("List has reached the maximum item limit (%d)", MaxItemsPerList))
+	}
+
+	return service.listRepo.AddItems(context, listID, placements)
+}
+
+/*
+RemoveItems takes one or more comics out of a list owned by the user.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - listID: string
+  - comicIDs: []string
+
+Returns:
+  - int: Number of comics actually removed
+  - error: Validation, ownership or persistence errors
+*/
+func (service *Service) RemoveItems(context context.Context, userID, listID string, comicIDs []string) (int, error) {
+	validator := &validate.Validator{}
+	validator.Custom(FieldComicIDs, len(comicIDs) == 0, "At least one comic is required")
+	validator.Custom(FieldComicIDs, len(comicIDs) > MaxItemsPerList, fmt.Sprintf("Must contain at most %d items", MaxItemsPerList))
+	if err := validator.Err(); err != nil {
+		return 0, err
+	}
+
+	if _, err := service.ownedList(context, userID, listID); err != nil {
+		return 0, err
+	}
+
+	return service.listRepo.RemoveItems(context, listID, comicIDs)
+}
+
+/*
+ReorderItems rewrites the position of comics within a list owned by the user.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - listID: string
+  - placements: []ItemPlacement (Every entry must carry a sort order)
+
+Returns:
+  - int: Number of items actually updated
+  - error: Validation, ownership or persistence errors
+*/
+func (service *Service) ReorderItems(context context.Context, userID, listID string, placements []ItemPlacement) (int, error) {
+
+	// Batch validation
+	order := make(map[string]int, len(placements))
+	validator := &validate.Validator{}
+	validator.Custom(FieldItems, len(placements) == 0, "At least one item is required")
+	validator.Custom(FieldItems, len(placements) > MaxItemsPerList, fmt.Sprintf("Must contain at most %d items", MaxItemsPerList))
+	for _, placement := range placements {
+		if placement.SortOrder == nil {
+			validator.Custom(FieldSortOrder, true, "Is required for every item")
+			continue
+		}
+		validator.Custom(FieldSortOrder, *placement.SortOrder < 0, "Must be greater than or equal to 0")
+		order[placement.ComicID] = *placement.SortOrder
+	}
+	if err := validator.Err(); err != nil {
+		return 0, err
+	}
+
+	if _, err := service.ownedList(context, userID, listID); err != nil {
+		return 0, err
+	}
+
+	return service.listRepo.ReorderItems(context, listID, order)
+}
+
+// # Internal Helpers
+
+// ownedList loads a list and ensures it belongs to the user.
+// Foreign lists are reported as Forbidden without revealing their visibility.
+func (service *Service) ownedList(context context.Context, userID, listID string) (*List, error) {
+	list, err := service.listRepo.FindByID(context, listID)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, apperr.Forbidden("List not found or you do not own this list")
+		}
+		return nil, err
+	}
+
+	if list.UserID != userID {
+		return nil, apperr.Forbidden("List not found or you do not own this list")
+	}
+
+	return list, nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+)
+
+// # Reading Progress
+
+/*
+ListProgress retrieves the user's reading positions, most recent first.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*Progress: Slice of positions
+  - int: Total number of positions
+  - error: Repository level errors
+*/
+func (service *Service) ListProgress(context context.Context, userID string, limit, offset int) ([]*Progress, int, error) {
+	return service.progressRepo.List(context, userID, limit, offset)
+}
+
+/*
+GetProgress fetches the reading position of a user in a comic.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+
+Returns:
+  - *Progress: The stored position
+  - error: NotFound if the comic was never opened
+*/
+func (service *Service) GetProgress(context context.Context, userID, comicID string) (*Progress, error) {
+	return service.progressRepo.Find(context, userID, comicID)
+}
+
+// ProgressInput carries a position reported by a reader device.
+type ProgressInput struct {
+	ChapterID  string
+	PageNumber int
+	ReportedAt *time.Time // Device time of the position; nil = now
+}
+
+/*
+SaveProgress records a reading position reported by one of the user's devices.
+
+Description: Conflicts between devices are resolved with last-write-wins on
+the reported timestamp. Timestamps too far in the future are clamped to
+server time so a drifting clock cannot pin a stale position. When the write
+loses, the newer stored position is returned so the device can resync.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+  - input: ProgressInput
+
+Returns:
+  - *Progress: The authoritative position after the write
+  - error: Validation or persistence errors
+*/
+func (service *Service) SaveProgress(context context.Context, userID, comicID string, input ProgressInput) (*Progress, error) {
+
+	// Business attribute validation
+	validator := &validate.Validator{}
+	validator.UUID(FieldComicID, comicID)
+	validator.Required(FieldChapterID, input.ChapterID)
+	validator.Custom(FieldPageNumber, input.PageNumber < 1, "Must be greater than or equal to 1")
+	if err := validator.Err(); err != nil {
+		return nil, err
+	}
+
+	// Chapter ownership and page bounds
+	pageCount, err := service.progressRepo.ChapterPageCount(context, comicID, input.ChapterID)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, validate.RequiredError(FieldChapterID, "Chapter does not belong to this comic")
+		}
+		return nil, err
+	}
+	if pageCount > 0 && input.PageNumber > pageCount {
+		return nil, validate.RequiredError(FieldPageNumber, "Page number exceeds chapter page count")
+	}
+
+	// Clock normalisation
+	now := time.Now().UTC()
+	reportedAt := now
+	if input.ReportedAt != nil && input.ReportedAt.Before(now.Add(MaxProgressClockSkew)) {
+		reportedAt = input.ReportedAt.UTC()
+	}
+
+	progress := &Progress{
+		UserID:     userID,
+		ComicID:    comicID,
+		ChapterID:  input.ChapterID,
+		PageNumber: input.PageNumber,
+		UpdatedAt:  reportedAt,
+	}
+
+	// Conditional persistence (last-write-wins)
+	applied, err := service.progressRepo.Save(context, progress)
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		service.logger.Debug("reading_progress_stale_write",
+			slog.String("user_id", userID),
+			slog.String("comic_id", comicID),
+		)
+	}
+
+	return service.progressRepo.Find(context, userID, comicID)
+}
+
+/*
+ResetProgress clears the reading position of a comic so the user can start over.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+
+Returns:
+  - error: NotFound if no position was stored
+*/
+func (service *Service) ResetProgress(context context.Context, userID, comicID string) error {
+	return service.progressRepo.Delete(context, userID, comicID)
+}
+
+/*
+ContinueReading lists the next unread chapter for every shelved comic.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*ContinueItem: Suggestions, most recently read first
+  - int: Total number of suggestions
+  - error: Repository level errors
+*/
+func (service *Service) ContinueReading(context context.Context, userID string, limit, offset int) ([]*ContinueItem, int, error) {
+	return service.progressRepo.ContinueReading(context, userID, limit, offset)
+}
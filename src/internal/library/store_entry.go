@@ -0,0 +1,80 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import "context"
+
+// # Shelf Data Access
+
+// EntryRepository defines the data access contract for library shelf entries.
+type EntryRepository interface {
+
+	/*
+		List returns a filtered, paginated slice of a user's shelf entries.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string (Shelf owner)
+		  - filter: EntryFilter (Status, hasnew and sorting)
+		  - limit: int
+		  - offset: int
+
+		Returns:
+		  - []*Entry: Entries hydrated with their comic summary
+		  - int: Total count of entries matching the filter
+		  - error: Database retrieval failures
+	*/
+	List(context context.Context, userID string, filter EntryFilter, limit, offset int) ([]*Entry, int, error)
+
+	/*
+		Find returns the shelf entry binding a user to a comic.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - comicID: string
+
+		Returns:
+		  - *Entry: The hydrated entry
+		  - error: ErrNotFound if the comic is not on the shelf
+	*/
+	Find(context context.Context, userID, comicID string) (*Entry, error)
+
+	/*
+		Create shelves a comic and increments its follower counter atomically.
+
+		Parameters:
+		  - context: context.Context
+		  - entry: *Entry (UserID, ComicID, status and score)
+
+		Returns:
+		  - error: ErrNotFound if the comic is missing, Conflict if already shelved
+	*/
+	Create(context context.Context, entry *Entry) error
+
+	/*
+		Update persists the mutable fields (status, score) of an entry.
+
+		Parameters:
+		  - context: context.Context
+		  - entry: *Entry (UserID, ComicID and modified attributes)
+
+		Returns:
+		  - error: ErrNotFound if the entry does not exist
+	*/
+	Update(context context.Context, entry *Entry) error
+
+	/*
+		Delete removes an entry and decrements the comic's follower counter.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - comicID: string
+
+		Returns:
+		  - error: ErrNotFound if the entry does not exist
+	*/
+	Delete(context context.Context, userID, comicID string) error
+}
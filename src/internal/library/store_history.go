@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import "context"
+
+// # Read History Data Access
+
+// HistoryRepository defines the data access contract for the chapter read history.
+type HistoryRepository interface {
+
+	/*
+		List returns a paginated slice of the user's read records, most recent first.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - filter: ReadFilter (Comic and time window)
+		  - limit: int
+		  - offset: int
+
+		Returns:
+		  - []*ReadRecord: Records hydrated with their chapter summary
+		  - int: Total number of matching records
+		  - error: Database retrieval failures
+	*/
+	List(context context.Context, userID string, filter ReadFilter, limit, offset int) ([]*ReadRecord, int, error)
+
+	/*
+		ListChapterIDs returns the IDs of every chapter of a comic the user has read.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - comicID: string
+
+		Returns:
+		  - []string: Read chapter IDs
+		  - error: Database retrieval failures
+	*/
+	ListChapterIDs(context context.Context, userID, comicID string) ([]string, error)
+
+	/*
+		Unmark removes a single read record and re-derives the shelf's last read chapter.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - chapterID: string
+
+		Returns:
+		  - error: ErrNotFound if the chapter was not marked as read
+	*/
+	Unmark(context context.Context, userID, chapterID string) error
+
+	/*
+		MarkAll marks every published chapter of a comic as read.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - comicID: string
+		  - language: string (BCP-47 code, empty for all languages)
+
+		Returns:
+		  - int: Number of newly created read records
+		  - error: Storage failures
+	*/
+	MarkAll(context context.Context, userID, comicID, language string) (int, error)
+
+	/*
+		UnmarkAll removes every read record of a comic.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - comicID: string
+		  - language: string (BCP-47 code, empty for all languages)
+
+		Returns:
+		  - int: Number of removed read records
+		  - error: Storage failures
+	*/
+	UnmarkAll(context context.Context, userID, comicID, language string) (int, error)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import "context"
+
+// # Custom List Data Access
+
+// ListRepository defines the data access contract for custom lists and their items.
+type ListRepository interface {
+
+	/*
+		ListByOwner returns a paginated slice of the user's active lists, including private ones.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - limit: int
+		  - offset: int
+
+		Returns:
+		  - []*List: Lists hydrated with their item count
+		  - int: Total number of active lists owned by the user
+		  - error: Database retrieval failures
+	*/
+	ListByOwner(context context.Context, userID string, limit, offset int) ([]*List, int, error)
+
+	/*
+		FindByID returns an active list regardless of its visibility.
+
+		Parameters:
+		  - context: context.Context
+		  - id: string (UUID)
+
+		Returns:
+		  - *List: The hydrated list
+		  - error: ErrNotFound if missing or soft-deleted
+	*/
+	FindByID(context context.Context, id string) (*List, error)
+
+	/*
+		CountByOwner returns the number of active lists owned by the user.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - int: Active list count
+		  - error: Database retrieval failures
+	*/
+	CountByOwner(context context.Context, userID string) (int, error)
+
+	/*
+		Create persists a new list.
+
+		Parameters:
+		  - context: context.Context
+		  - list: *List (ID, UserID, Name and Visibility)
+
+		Returns:
+		  - error: Storage or constraint failures
+	*/
+	Create(context context.Context, list *List) error
+
+	/*
+		Update persists the list's name and visibility.
+
+		Parameters:
+		  - context: context.Context
+		  - list: *List
+
+		Returns:
+		  - error: ErrNotFound if missing or soft-deleted
+	*/
+	Update(context context.Context, list *List) error
+
+	/*
+		SoftDelete hides a list and drops its items.
+
+		Parameters:
+		  - context: context.Context
+		  - id: string (UUID)
+
+		Returns:
+		  - error: ErrNotFound if missing or already deleted
+	*/
+	SoftDelete(context context.Context, id string) error
+
+	/*
+		ListItems returns a paginated slice of a list's items ordered by sort order.
+
+		Parameters:
+		  - context: context.Context
+		  - listID: string
+		  - limit: int
+		  - offset: int
+
+		Returns:
+		  - []*ListItem: Items hydrated with their comic summary
+		  - int: Total number of visible items
+		  - error: Database retrieval failures
+	*/
+	ListItems(context context.Context, listID string, limit, offset int) ([]*ListItem, int, error)
+
+	/*
+		CountItems returns the number of comics in a list.
+
+		Parameters:
+		  - context: context.Context
+		  - listID: string
+
+		Returns:
+		  - int: Item count
+		  - error: Database retrieval failures
+	*/
+	CountItems(context context.Context, listID string) (int, error)
+
+	/*
+		AddItems inserts comics into a list in a single statement.
+
+		Description: Missing or deleted comics and comics already present
+		in the list are skipped silently.
+
+		Parameters:
+		  - context: context.Context
+		  - listID: string
+		  - placements: []ItemPlacement
+
+		Returns:
+		  - int: Number of items actually inserted
+		  - error: Storage failures
+	*/
+	AddItems(context context.Context, listID string, placements []ItemPlacement) (int, error)
+
+	/*
+		RemoveItems deletes comics from a list in a single statement.
+
+		Parameters:
+		  - context: context.Context
+		  - listID: string
+		  - comicIDs: []string
+
+		Returns:
+		  - int: Number of items actually removed
+		  - error: Storage failures
+	*/
+	RemoveItems(context context.Context, listID string, comicIDs []string) (int, error)
+
+	/*
+		ReorderItems rewrites the sort order of the given comics in a list.
+
+		Parameters:
+		  - context: context.Context
+		  - listID: string
+		  - order: map[string]int (Comic ID to new sort order)
+
+		Returns:
+		  - int: Number of items actually updated
+		  - error: Storage failures
+	*/
+	ReorderItems(context context.Context, listID string, order map[string]int) (int, error)
+}
@@ -0,0 +1,372 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+/*
+Package library provides the PostgreSQL implementation for the reader's shelf.
+
+Shelf mutations touch two tables at once: the 'library.entry' row and the
+denormalised 'core.comic.followcount' counter. Both writes are executed inside
+a single transaction so the counter never drifts from the real follower set.
+*/
+package library
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+)
+
+// # PostgreSQL Repositories
+
+// entryRepository implements the [EntryRepository] interface using pgx.
+type entryRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewEntryRepository constructs a PostgreSQL backed shelf store.
+func NewEntryRepository(pool *pgxpool.Pool) EntryRepository {
+	return &entryRepository{pool: pool}
+}
+
+// # Entry Repository Implementation
+
+/*
+List returns a filtered, paginated slice of a user's shelf entries.
+
+Description: Joins the comic catalogue to hydrate a display summary and
+uses COUNT(*) OVER() to retrieve the total without a second round-trip.
+Soft-deleted comics are hidden from the shelf.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - filter: EntryFilter
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*Entry: Slice of hydrated entries
+  - int: Total count matching filters
+  - error: Database execution errors
+*/
+func (repository *entryRepository) List(context context.Context, userID string, filter EntryFilter, limit, offset int) ([]*Entry, int, error) {
+
+	// Query build initialization
+	var queryBuilder strings.Builder
+	args := []any{userID}
+	argID := 2
+
+	queryBuilder.WriteString(fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER() AS total_count
+		FROM %s e
+		JOIN %s c ON c.%s = e.%s AND c.%s IS NULL
+		WHERE e.%s = $1
+	`,
+		entryProjection(),
+		schema.LibraryEntry.Table,
+		schema.CoreComic.Table, schema.CoreComic.ID, schema.LibraryEntry.ComicID, schema.CoreComic.DeletedAt,
+		schema.LibraryEntry.UserID,
+	))
+
+	// Reading status filtering
+	if len(filter.Status) > 0 {
+		queryBuilder.WriteString(fmt.Sprintf(" AND e.%s = ANY($%d)", schema.LibraryEntry.ReadingStatus, argID))
+		args = append(args, filter.Status)
+		argID++
+	}
+
+	// Unread chapter filtering
+	if filter.HasNew != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND e.%s = $%d", schema.LibraryEntry.HasNew, argID))
+		args = append(args, *filter.HasNew)
+		argID++
+	}
+
+	// Apply Sorting Logic
+	sort := fmt.Sprintf("e.%s DESC", schema.LibraryEntry.UpdatedAt) // default
+	switch filter.Sort {
+	case "createdat":
+		sort = fmt.Sprintf("e.%s DESC", schema.LibraryEntry.CreatedAt)
+	case "score":
+		sort = fmt.Sprintf("e.%s DESC NULLS LAST", schema.LibraryEntry.Score)
+	case "title":
+		sort = fmt.Sprintf("c.%s ASC", schema.CoreComic.Title)
+	case "lastreadat":
+		sort = fmt.Sprintf("e.%s DESC NULLS LAST", schema.LibraryEntry.LastReadAt)
+	}
+	queryBuilder.WriteString(fmt.Sprintf(" ORDER BY %s, e.%s DESC", sort, schema.LibraryEntry.ID))
+
+	// Pagination injection
+	queryBuilder.WriteString(fmt.Sprintf(" LIMIT $%d OFFSET $%d", argID, argID+1))
+	args = append(args, limit, offset)
+
+	// Query Execution
+	rows, err := repository.pool.Query(context, queryBuilder.String(), args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("postgres: failed to list library entries: %w", err)
+	}
+	defer rows.Close()
+
+	// Row Iteration and Entity Hydration
+	var entries []*Entry
+	var totalCount int
+
+	for rows.Next() {
+		entry := &Entry{Comic: &ComicSummary{}}
+		if err := rows.Scan(append(entryScanTargets(entry), &totalCount)...); err != nil {
+			return nil, 0, fmt.Errorf("postgres: failed to scan library entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, totalCount, rows.Err()
+}
+
+/*
+Find returns the shelf entry binding a user to a comic.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+
+Returns:
+  - *Entry: The hydrated entry
+  - error: apperr.NotFound if the comic is not on the shelf
+*/
+func (repository *entryRepository) Find(context context.Context, userID, comicID string) (*Entry, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s e
+		JOIN %s c ON c.%s = e.%s AND c.%s IS NULL
+		WHERE e.%s = $1 AND e.%s = $2
+	`,
+		entryProjection(),
+		schema.LibraryEntry.Table,
+		schema.CoreComic.Table, schema.CoreComic.ID, schema.LibraryEntry.ComicID, schema.CoreComic.DeletedAt,
+		schema.LibraryEntry.UserID, schema.LibraryEntry.ComicID,
+	)
+
+	// Execute and hydrate
+	entry := &Entry{Comic: &ComicSummary{}}
+	err := repository.pool.QueryRow(context, query, userID, comicID).Scan(entryScanTargets(entry)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("Entry")
+		}
+		return nil, fmt.Errorf("postgres: failed to find library entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+/*
+Create shelves a comic and increments its follower counter atomically.
+
+Description: Verifies that the comic is active, inserts the entry with
+ON CONFLICT DO NOTHING to detect duplicates without aborting the
+transaction, then bumps 'core.comic.followcount'.
+
+Parameters:
+  - context: context.Context
+  - entry: *Entry
+
+Returns:
+  - error: apperr.NotFound if the comic is missing, apperr.Conflict if already shelved
+*/
+func (repository *entryRepository) Create(context context.Context, entry *Entry) error {
+
+	// Transaction boundary for entry + counter
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Comic existence guard
+	var exists bool
+	existsQuery := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE %s = $1 AND %s IS NULL)`,
+		schema.CoreComic.Table, schema.CoreComic.ID, schema.CoreComic.DeletedAt)
+	if err := transaction.QueryRow(context, existsQuery, entry.ComicID).Scan(&exists); err != nil {
+		return fmt.Errorf("postgres: failed to check comic existence: %w", err)
+	}
+	if !exists {
+		return apperr.NotFound("Comic")
+	}
+
+	// Entry insertion
+	insertQuery := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (%s, %s) DO NOTHING
+		RETURNING %s, %s, %s, %s
+	`,
+		schema.LibraryEntry.Table,
+		schema.LibraryEntry.UserID, schema.LibraryEntry.ComicID, schema.LibraryEntry.ReadingStatus, schema.LibraryEntry.Score,
+		schema.LibraryEntry.UserID, schema.LibraryEntry.ComicID,
+		schema.LibraryEntry.ID, schema.LibraryEntry.HasNew, schema.LibraryEntry.CreatedAt, schema.LibraryEntry.UpdatedAt,
+	)
+
+	err = transaction.QueryRow(context, insertQuery, entry.UserID, entry.ComicID, entry.ReadingStatus, entry.Score).
+		Scan(&entry.ID, &entry.HasNew, &entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperr.Conflict("Comic already in your library")
+		}
+		return fmt.Errorf("postgres: failed to create library entry: %w", err)
+	}
+
+	// Follower counter increment
+	if err := adjustFollowCount(context, transaction, entry.ComicID, 1); err != nil {
+		return err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres: failed to commit library entry: %w", err)
+	}
+
+	return nil
+}
+
+/*
+Update persists the mutable fields (status, score) of an entry.
+
+Parameters:
+  - context: context.Context
+  - entry: *Entry
+
+Returns:
+  - error: apperr.NotFound if the entry does not exist
+*/
+func (repository *entryRepository) Update(context context.Context, entry *Entry) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET %s = $1, %s = $2, %s = NOW()
+		WHERE %s = $3 AND %s = $4
+		RETURNING %s
+	`,
+		schema.LibraryEntry.Table,
+		schema.LibraryEntry.ReadingStatus, schema.LibraryEntry.Score, schema.LibraryEntry.UpdatedAt,
+		schema.LibraryEntry.UserID, schema.LibraryEntry.ComicID,
+		schema.LibraryEntry.UpdatedAt,
+	)
+
+	err := repository.pool.QueryRow(context, query, entry.ReadingStatus, entry.Score, entry.UserID, entry.ComicID).Scan(&entry.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperr.NotFound("Entry")
+		}
+		return fmt.Errorf("postgres: failed to update library entry: %w", err)
+	}
+
+	return nil
+}
+
+/*
+Delete removes an entry and decrements the comic's follower counter.
+
+Description: Reading history in 'library.chapterread' is intentionally
+preserved so re-shelving a comic restores the reader's progress.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+
+Returns:
+  - error: apperr.NotFound if the entry does not exist
+*/
+func (repository *entryRepository) Delete(context context.Context, userID, comicID string) error {
+
+	// Transaction boundary for entry + counter
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1 AND %s = $2`,
+		schema.LibraryEntry.Table, schema.LibraryEntry.UserID, schema.LibraryEntry.ComicID)
+
+	result, err := transaction.Exec(context, query, userID, comicID)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to delete library entry: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperr.NotFound("Entry")
+	}
+
+	// Follower counter decrement
+	if err := adjustFollowCount(context, transaction, comicID, -1); err != nil {
+		return err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres: failed to commit library entry removal: %w", err)
+	}
+
+	return nil
+}
+
+// # Internal Helpers
+
+// entryProjection returns the shared SELECT list for entry queries (aliases: e = entry, c = comic).
+func entryProjection() string {
+	return fmt.Sprintf(`
+		e.%s, e.%s, e.%s, e.%s, e.%s, e.%s, e.%s, e.%s, e.%s, e.%s,
+		c.%s, c.%s, c.%s, c.%s, c.%s`,
+		schema.LibraryEntry.ID,
+		schema.LibraryEntry.UserID,
+		schema.LibraryEntry.ComicID,
+		schema.LibraryEntry.ReadingStatus,
+		schema.LibraryEntry.Score,
+		schema.LibraryEntry.HasNew,
+		schema.LibraryEntry.LastReadChapterID,
+		schema.LibraryEntry.LastReadAt,
+		schema.LibraryEntry.CreatedAt,
+		schema.LibraryEntry.UpdatedAt,
+		schema.CoreComic.ID,
+		schema.CoreComic.Title,
+		schema.CoreComic.Slug,
+		schema.CoreComic.CoverURL,
+		schema.CoreComic.Status,
+	)
+}
+
+// entryScanTargets returns the scan destinations matching [entryProjection].
+func entryScanTargets(entry *Entry) []any {
+	return []any{
+		&entry.ID,
+		&entry.UserID,
+		&entry.ComicID,
+		&entry.ReadingStatus,
+		&entry.Score,
+		&entry.HasNew,
+		&entry.LastReadChapterID,
+		&entry.LastReadAt,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+		&entry.Comic.ID,
+		&entry.Comic.Title,
+		&entry.Comic.Slug,
+		&entry.Comic.CoverURL,
+		&entry.Comic.Status,
+	}
+}
+
+// adjustFollowCount applies delta to the comic's denormalised follower counter, never below zero.
+func adjustFollowCount(context context.Context, transaction pgx.Tx, comicID string, delta int) error {
+	query := fmt.Sprintf(`UPDATE %s SET %s = GREATEST(%s + $1, 0) WHERE %s = $2`,
+		schema.CoreComic.Table, schema.CoreComic.FollowCount, schema.CoreComic.FollowCount, schema.CoreComic.ID)
+
+	if _, err := transaction.Exec(context, query, delta, comicID); err != nil {
+		return fmt.Errorf("postgres: failed to adjust follow count: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/internal/platform/database/shelf"
+)
+
+// # PostgreSQL Repositories
+
+// historyRepository implements the [HistoryRepository] interface using pgx.
+type historyRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewHistoryRepository constructs a PostgreSQL backed read history store.
+func NewHistoryRepository(pool *pgxpool.Pool) HistoryRepository {
+	return &historyRepository{pool: pool}
+}
+
+// # History Repository Implementation
+
+/*
+List returns a paginated slice of the user's read records, most recent first.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - filter: ReadFilter
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*ReadRecord: Records hydrated with their chapter summary
+  - int: Total number of matching records
+  - error: Database execution errors
+*/
+func (repository *historyRepository) List(context context.Context, userID string, filter ReadFilter, limit, offset int) ([]*ReadRecord, int, error) {
+
+	// Query build initialization
+	var queryBuilder strings.Builder
+	args := []any{userID}
+	argID := 2
+
+	queryBuilder.WriteString(fmt.Sprintf(`
+		SELECT
+			r.%s, ch.%s, r.%s,
+			ch.%s, ch.%s, COALESCE(ch.%s, ''), l.%s,
+			COUNT(*) OVER() AS total_count
+		FROM %s r
+		JOIN %s ch ON ch.%s = r.%s
+		JOIN %s l ON l.%s = ch.%s
+		WHERE r.%s = $1
+	`,
+		schema.CoreUserRead.ChapterID, schema.CoreChapter.ComicID, schema.CoreUserRead.ReadAt,
+		schema.CoreChapter.ID, schema.CoreChapter.Number, schema.CoreChapter.Title, schema.RefLanguage.Code,
+		schema.CoreUserRead.Table,
+		schema.CoreChapter.Table, schema.CoreChapter.ID, schema.CoreUserRead.ChapterID,
+		schema.RefLanguage.Table, schema.RefLanguage.ID, schema.CoreChapter.LanguageID,
+		schema.CoreUserRead.UserID,
+	))
+
+	// Comic filtering
+	if filter.ComicID != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" AND ch.%s = $%d", schema.CoreChapter.ComicID, argID))
+		args = append(args, filter.ComicID)
+		argID++
+	}
+
+	// Time window filtering
+	if filter.Since != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND r.%s > $%d", schema.CoreUserRead.ReadAt, argID))
+		args = append(args, *filter.Since)
+		argID++
+	}
+
+	queryBuilder.WriteString(fmt.Sprintf(" ORDER BY r.%s DESC, ch.%s DESC", schema.CoreUserRead.ReadAt, schema.CoreChapter.Number))
+	queryBuilder.WriteString(fmt.Sprintf(" LIMIT $%d OFFSET $%d", argID, argID+1))
+	args = append(args, limit, offset)
+
+	rows, err := repository.pool.Query(context, queryBuilder.String(), args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("postgres: failed to list read history: %w", err)
+	}
+	defer rows.Close()
+
+	// Row Iteration and Entity Hydration
+	var records []*ReadRecord
+	var totalCount int
+
+	for rows.Next() {
+		record := &ReadRecord{Chapter: &ChapterSummary{}}
+		err := rows.Scan(
+			&record.ChapterID,
+			&record.ComicID,
+			&record.ReadAt,
+			&record.Chapter.ID,
+			&record.Chapter.Number,
+			&record.Chapter.Title,
+			&record.Chapter.Language,
+			&totalCount,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("postgres: failed to scan read record: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	return records, totalCount, rows.Err()
+}
+
+// ListChapterIDs returns the IDs of every chapter of a comic the user has read.
+func (repository *historyRepository) ListChapterIDs(context context.Context, userID, comicID string) ([]string, error) {
+	query := fmt.Sprintf(`
+		SELECT r.%s
+		FROM %s r
+		JOIN %s ch ON ch.%s = r.%s
+		WHERE r.%s = $1 AND ch.%s = $2
+	`,
+		schema.CoreUserRead.ChapterID,
+		schema.CoreUserRead.Table,
+		schema.CoreChapter.Table, schema.CoreChapter.ID, schema.CoreUserRead.ChapterID,
+		schema.CoreUserRead.UserID, schema.CoreChapter.ComicID,
+	)
+
+	rows, err := repository.pool.Query(context, query, userID, comicID)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list read chapter ids: %w", err)
+	}
+
+	chapterIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to scan read chapter ids: %w", err)
+	}
+
+	// Always return a JSON array, never null
+	if chapterIDs == nil {
+		chapterIDs = []string{}
+	}
+
+	return chapterIDs, nil
+}
+
+/*
+Unmark removes a single read record and re-derives the shelf's last read chapter.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - chapterID: string
+
+Returns:
+  - error: apperr.NotFound if the chapter was not marked as read
+*/
+func (repository *historyRepository) Unmark(context context.Context, userID, chapterID string) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Removal with owning comic resolution
+	query := fmt.Sprintf(`
+		DELETE FROM %s r
+		USING %s ch
+		WHERE r.%s = $1 AND r.%s = $2 AND ch.%s = r.%s
+		RETURNING ch.%s
+	`,
+		schema.CoreUserRead.Table,
+		schema.CoreChapter.Table,
+		schema.CoreUserRead.UserID, schema.CoreUserRead.ChapterID, schema.CoreChapter.ID, schema.CoreUserRead.ChapterID,
+		schema.CoreChapter.ComicID,
+	)
+
+	var comicID string
+	if err := transaction.QueryRow(context, query, userID, chapterID).Scan(&comicID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperr.NotFound("Read record")
+		}
+		return fmt.Errorf("postgres: failed to unmark chapter: %w", err)
+	}
+
+	if err := shelf.SyncLastRead(context, transaction, userID, comicID); err != nil {
+		return err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres: failed to commit chapter unmark: %w", err)
+	}
+
+	return nil
+}
+
+/*
+MarkAll marks every published chapter of a comic as read.
+
+Description: A single INSERT ... SELECT with ON CONFLICT DO NOTHING covers
+the whole comic regardless of its chapter count. The shelf's last read
+chapter is then re-derived, which resolves to the highest chapter number
+since all new records share the same timestamp.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+  - language: string
+
+Returns:
+  - int: Number of newly created read records
+  - error: Database execution errors
+*/
+func (repository *historyRepository) MarkAll(context context.Context, userID, comicID, language string) (int, error) {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s)
+		SELECT $1, ch.%s
+		FROM %s ch
+		JOIN %s l ON l.%s = ch.%s
+		WHERE ch.%s = $2
+		  AND ch.%s IS NULL
+		  AND ch.%s IS NOT NULL AND ch.%s <= NOW()
+		  AND ($3::text = '' OR l.%s = $3)
+		ON CONFLICT DO NOTHING
+	`,
+		schema.CoreUserRead.Table, schema.CoreUserRead.UserID, schema.CoreUserRead.ChapterID,
+		schema.CoreChapter.ID,
+		schema.CoreChapter.Table,
+		schema.RefLanguage.Table, schema.RefLanguage.ID, schema.CoreChapter.LanguageID,
+		schema.CoreChapter.ComicID,
+		schema.CoreChapter.DeletedAt,
+		schema.CoreChapter.PublishedAt, schema.CoreChapter.PublishedAt,
+		schema.RefLanguage.Code,
+	)
+
+	result, err := transaction.Exec(context, query, userID, comicID, language)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to mark comic as read: %w", err)
+	}
+
+	if err := shelf.SyncPosition(context, transaction, userID, comicID); err != nil {
+		return 0, err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return 0, fmt.Errorf("postgres: failed to commit comic read-all: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// UnmarkAll removes every read record of a comic.
+func (repository *historyRepository) UnmarkAll(context context.Context, userID, comicID, language string) (int, error) {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	query := fmt.Sprintf(`
+		DELETE FROM %s r
+		USING %s ch, %s l
+		WHERE r.%s = $1
+		  AND ch.%s = r.%s AND ch.%s = $2
+		  AND l.%s = ch.%s
+		  AND ($3::text = '' OR l.%s = $3)
+	`,
+		schema.CoreUserRead.Table,
+		schema.CoreChapter.Table, schema.RefLanguage.Table,
+		schema.CoreUserRead.UserID,
+		schema.CoreChapter.ID, schema.CoreUserRead.ChapterID, schema.CoreChapter.ComicID,
+		schema.RefLanguage.ID, schema.CoreChapter.LanguageID,
+		schema.RefLanguage.Code,
+	)
+
+	result, err := transaction.Exec(context, query, userID, comicID, language)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to unmark comic: %w", err)
+	}
+
+	if err := shelf.SyncLastRead(context, transaction, userID, comicID); err != nil {
+		return 0, err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return 0, fmt.Errorf("postgres: failed to commit comic unread-all: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
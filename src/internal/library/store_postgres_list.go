@@ -0,0 +1,502 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+)
+
+// # PostgreSQL Repositories
+
+// listRepository implements the [ListRepository] interface using pgx.
+type listRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewListRepository constructs a PostgreSQL backed custom list store.
+func NewListRepository(pool *pgxpool.Pool) ListRepository {
+	return &listRepository{pool: pool}
+}
+
+// # List Repository Implementation
+
+/*
+ListByOwner returns a paginated slice of the user's active lists.
+
+Description: Item counts are resolved with a correlated sub-query and the
+total with COUNT(*) OVER() to keep the call to a single round-trip.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*List: Lists hydrated with their item count
+  - int: Total number of active lists
+  - error: Database execution errors
+*/
+func (repository *listRepository) ListByOwner(context context.Context, userID string, limit, offset int) ([]*List, int, error) {
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER() AS total_count
+		FROM %s l
+		WHERE l.%s = $1 AND l.%s IS NULL
+		ORDER BY l.%s DESC, l.%s DESC
+		LIMIT $2 OFFSET $3
+	`,
+		listProjection(),
+		schema.LibraryCustomList.Table,
+		schema.LibraryCustomList.UserID, schema.LibraryCustomList.DeletedAt,
+		schema.LibraryCustomList.UpdatedAt, schema.LibraryCustomList.ID,
+	)
+
+	rows, err := repository.pool.Query(context, query, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("postgres: failed to list custom lists: %w", err)
+	}
+	defer rows.Close()
+
+	// Row Iteration and Entity Hydration
+	var lists []*List
+	var totalCount int
+
+	for rows.Next() {
+		list := &List{}
+		if err := rows.Scan(append(listScanTargets(list), &totalCount)...); err != nil {
+			return nil, 0, fmt.Errorf("postgres: failed to scan custom list: %w", err)
+		}
+		lists = append(lists, list)
+	}
+
+	return lists, totalCount, rows.Err()
+}
+
+/*
+FindByID returns an active list regardless of its visibility.
+
+Parameters:
+  - context: context.Context
+  - id: string
+
+Returns:
+  - *List: The hydrated list
+  - error: apperr.NotFound if missing or soft-deleted
+*/
+func (repository *listRepository) FindByID(context context.Context, id string) (*List, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s l
+		WHERE l.%s = $1 AND l.%s IS NULL
+	`,
+		listProjection(),
+		schema.LibraryCustomList.Table,
+		schema.LibraryCustomList.ID, schema.LibraryCustomList.DeletedAt,
+	)
+
+	list := &List{}
+	if err := repository.pool.QueryRow(context, query, id).Scan(listScanTargets(list)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("List")
+		}
+		return nil, fmt.Errorf("postgres: failed to find custom list: %w", err)
+	}
+
+	return list, nil
+}
+
+// CountByOwner returns the number of active lists owned by the user.
+func (repository *listRepository) CountByOwner(context context.Context, userID string) (int, error) {
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s = $1 AND %s IS NULL`,
+		schema.LibraryCustomList.Table, schema.LibraryCustomList.UserID, schema.LibraryCustomList.DeletedAt)
+
+	var count int
+	if err := repository.pool.QueryRow(context, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("postgres: failed to count custom lists: %w", err)
+	}
+	return count, nil
+}
+
+// Create persists a new list.
+func (repository *listRepository) Create(context context.Context, list *List) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s)
+		VALUES ($1, $2, $3, $4)
+		RETURNING %s, %s
+	`,
+		schema.LibraryCustomList.Table,
+		schema.LibraryCustomList.ID, schema.LibraryCustomList.UserID, schema.LibraryCustomList.Name, schema.LibraryCustomList.Visibility,
+		schema.LibraryCustomList.CreatedAt, schema.LibraryCustomList.UpdatedAt,
+	)
+
+	err := repository.pool.QueryRow(context, query, list.ID, list.UserID, list.Name, list.Visibility).
+		Scan(&list.CreatedAt, &list.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to create custom list: %w", err)
+	}
+
+	return nil
+}
+
+// Update persists the list's name and visibility.
+func (repository *listRepository) Update(context context.Context, list *List) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET %s = $1, %s = $2, %s = NOW()
+		WHERE %s = $3 AND %s IS NULL
+		RETURNING %s
+	`,
+		schema.LibraryCustomList.Table,
+		schema.LibraryCustomList.Name, schema.LibraryCustomList.Visibility, schema.LibraryCustomList.UpdatedAt,
+		schema.LibraryCustomList.ID, schema.LibraryCustomList.DeletedAt,
+		schema.LibraryCustomList.UpdatedAt,
+	)
+
+	if err := repository.pool.QueryRow(context, query, list.Name, list.Visibility, list.ID).Scan(&list.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperr.NotFound("List")
+		}
+		return fmt.Errorf("postgres: failed to update custom list: %w", err)
+	}
+
+	return nil
+}
+
+/*
+SoftDelete hides a list and drops its items.
+
+Description: The list row is kept for auditing while its items are removed
+in the same transaction, mirroring the cascade a hard delete would trigger.
+
+Parameters:
+  - context: context.Context
+  - id: string
+
+Returns:
+  - error: apperr.NotFound if missing or already deleted
+*/
+func (repository *listRepository) SoftDelete(context context.Context, id string) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Timestamp update execution
+	query := fmt.Sprintf(`UPDATE %s SET %s = NOW() WHERE %s = $1 AND %s IS NULL`,
+		schema.LibraryCustomList.Table, schema.LibraryCustomList.DeletedAt,
+		schema.LibraryCustomList.ID, schema.LibraryCustomList.DeletedAt)
+
+	result, err := transaction.Exec(context, query, id)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to delete custom list: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperr.NotFound("List")
+	}
+
+	// Item cleanup
+	itemsQuery := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1`,
+		schema.LibraryCustomListItem.Table, schema.LibraryCustomListItem.ListID)
+	if _, err := transaction.Exec(context, itemsQuery, id); err != nil {
+		return fmt.Errorf("postgres: failed to delete custom list items: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres: failed to commit custom list removal: %w", err)
+	}
+
+	return nil
+}
+
+// # Item Management
+
+/*
+ListItems returns a paginated slice of a list's items ordered by sort order.
+
+Description: Soft-deleted comics are hidden but keep their slot, so
+restoring a comic brings it back at its original position.
+
+Parameters:
+  - context: context.Context
+  - listID: string
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*ListItem: Items hydrated with their comic summary
+  - int: Total number of visible items
+  - error: Database execution errors
+*/
+func (repository *listRepository) ListItems(context context.Context, listID string, limit, offset int) ([]*ListItem, int, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			i.%s, i.%s, i.%s, i.%s,
+			c.%s, c.%s, c.%s, c.%s, c.%s,
+			COUNT(*) OVER() AS total_count
+		FROM %s i
+		JOIN %s c ON c.%s = i.%s AND c.%s IS NULL
+		WHERE i.%s = $1
+		ORDER BY i.%s ASC, i.%s ASC
+		LIMIT $2 OFFSET $3
+	`,
+		schema.LibraryCustomListItem.ListID,
+		schema.LibraryCustomListItem.ComicID,
+		schema.LibraryCustomListItem.SortOrder,
+		schema.LibraryCustomListItem.AddedAt,
+		schema.CoreComic.ID,
+		schema.CoreComic.Title,
+		schema.CoreComic.Slug,
+		schema.CoreComic.CoverURL,
+		schema.CoreComic.Status,
+		schema.LibraryCustomListItem.Table,
+		schema.CoreComic.Table, schema.CoreComic.ID, schema.LibraryCustomListItem.ComicID, schema.CoreComic.DeletedAt,
+		schema.LibraryCustomListItem.ListID,
+		schema.LibraryCustomListItem.SortOrder, schema.LibraryCustomListItem.AddedAt,
+	)
+
+	rows, err := repository.pool.Query(context, query, listID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("postgres: failed to list custom list items: %w", err)
+	}
+	defer rows.Close()
+
+	// Row Iteration and Entity Hydration
+	var items []*ListItem
+	var totalCount int
+
+	for rows.Next() {
+		item := &ListItem{Comic: &ComicSummary{}}
+		err := rows.Scan(
+			&item.ListID,
+			&item.ComicID,
+			&item.SortOrder,
+			&item.AddedAt,
+			&item.Comic.ID,
+			&item.Comic.Title,
+			&item.Comic.Slug,
+			&item.Comic.CoverURL,
+			&item.Comic.Status,
+			&totalCount,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("postgres: failed to scan custom list item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	return items, totalCount, rows.Err()
+}
+
+// CountItems returns the number of comics in a list.
+func (repository *listRepository) CountItems(context context.Context, listID string) (int, error) {
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s = $1`,
+		schema.LibraryCustomListItem.Table, schema.LibraryCustomListItem.ListID)
+
+	var count int
+	if err := repository.pool.QueryRow(context, query, listID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("postgres: failed to count custom list items: %w", err)
+	}
+	return count, nil
+}
+
+/*
+AddItems inserts comics into a list in a single statement.
+
+Description: The placements are unnested server-side. Items without an
+explicit sort order are appended after the current maximum, preserving the
+order in which they were sent. Unknown comics are filtered by the join and
+duplicates by ON CONFLICT DO NOTHING.
+
+Parameters:
+  - context: context.Context
+  - listID: string
+  - placements: []ItemPlacement
+
+Returns:
+  - int: Number of items actually inserted
+  - error: Database execution errors
+*/
+func (repository *listRepository) AddItems(context context.Context, listID string, placements []ItemPlacement) (int, error) {
+
+	// Column-oriented parameters for unnest
+	comicIDs := make([]string, len(placements))
+	sortOrders := make([]*int32, len(placements))
+	for index, placement := range placements {
+		comicIDs[index] = placement.ComicID
+		if placement.SortOrder != nil {
+			order := int32(*placement.SortOrder)
+			sortOrders[index] = &order
+		}
+	}
+
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s)
+		SELECT $1, p.comicid, COALESCE(p.sortorder, base.maxorder + p.position::int)
+		FROM unnest($2::text[], $3::int[]) WITH ORDINALITY AS p(comicid, sortorder, position)
+		CROSS JOIN (SELECT COALESCE(MAX(%s), -1) AS maxorder FROM %s WHERE %s = $1) base
+		JOIN %s c ON c.%s = p.comicid AND c.%s IS NULL
+		ON CONFLICT (%s, %s) DO NOTHING
+	`,
+		schema.LibraryCustomListItem.Table,
+		schema.LibraryCustomListItem.ListID, schema.LibraryCustomListItem.ComicID, schema.LibraryCustomListItem.SortOrder,
+		schema.LibraryCustomListItem.SortOrder, schema.LibraryCustomListItem.Table, schema.LibraryCustomListItem.ListID,
+		schema.CoreComic.Table, schema.CoreComic.ID, schema.CoreComic.DeletedAt,
+		schema.LibraryCustomListItem.ListID, schema.LibraryCustomListItem.ComicID,
+	)
+
+	result, err := transaction.Exec(context, query, listID, comicIDs, sortOrders)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to add custom list items: %w", err)
+	}
+
+	if err := touchList(context, transaction, listID); err != nil {
+		return 0, err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return 0, fmt.Errorf("postgres: failed to commit custom list items: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// RemoveItems deletes comics from a list in a single statement.
+func (repository *listRepository) RemoveItems(context context.Context, listID string, comicIDs []string) (int, error) {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1 AND %s = ANY($2)`,
+		schema.LibraryCustomListItem.Table, schema.LibraryCustomListItem.ListID, schema.LibraryCustomListItem.ComicID)
+
+	result, err := transaction.Exec(context, query, listID, comicIDs)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to remove custom list items: %w", err)
+	}
+
+	if err := touchList(context, transaction, listID); err != nil {
+		return 0, err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return 0, fmt.Errorf("postgres: failed to commit custom list items: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+/*
+ReorderItems rewrites the sort order of the given comics in a list.
+
+Description: Applies the whole batch with a single UPDATE ... FROM unnest
+so a reorder is atomic and costs one round-trip regardless of its size.
+
+Parameters:
+  - context: context.Context
+  - listID: string
+  - order: map[string]int
+
+Returns:
+  - int: Number of items actually updated
+  - error: Database execution errors
+*/
+func (repository *listRepository) ReorderItems(context context.Context, listID string, order map[string]int) (int, error) {
+
+	// Column-oriented parameters for unnest
+	comicIDs := make([]string, 0, len(order))
+	sortOrders := make([]int32, 0, len(order))
+	for comicID, sortOrder := range order {
+		comicIDs = append(comicIDs, comicID)
+		sortOrders = append(sortOrders, int32(sortOrder))
+	}
+
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	query := fmt.Sprintf(`
+		UPDATE %s i
+		SET %s = o.sortorder
+		FROM unnest($2::text[], $3::int[]) AS o(comicid, sortorder)
+		WHERE i.%s = $1 AND i.%s = o.comicid
+	`,
+		schema.LibraryCustomListItem.Table,
+		schema.LibraryCustomListItem.SortOrder,
+		schema.LibraryCustomListItem.ListID, schema.LibraryCustomListItem.ComicID,
+	)
+
+	result, err := transaction.Exec(context, query, listID, comicIDs, sortOrders)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to reorder custom list items: %w", err)
+	}
+
+	if err := touchList(context, transaction, listID); err != nil {
+		return 0, err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return 0, fmt.Errorf("postgres: failed to commit custom list order: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// # Internal Helpers
+
+// listProjection returns the shared SELECT list for list queries (alias: l = customlist).
+func listProjection() string {
+	return fmt.Sprintf(`
+		l.%s, l.%s, l.%s, l.%s, l.%s, l.%s,
+		(SELECT COUNT(*) FROM %s i WHERE i.%s = l.%s) AS item_count`,
+		schema.LibraryCustomList.ID,
+		schema.LibraryCustomList.UserID,
+		schema.LibraryCustomList.Name,
+		schema.LibraryCustomList.Visibility,
+		schema.LibraryCustomList.CreatedAt,
+		schema.LibraryCustomList.UpdatedAt,
+		schema.LibraryCustomListItem.Table, schema.LibraryCustomListItem.ListID, schema.LibraryCustomList.ID,
+	)
+}
+
+// listScanTargets returns the scan destinations matching [listProjection].
+func listScanTargets(list *List) []any {
+	return []any{
+		&list.ID,
+		&list.UserID,
+		&list.Name,
+		&list.Visibility,
+		&list.CreatedAt,
+		&list.UpdatedAt,
+		&list.ItemCount,
+	}
+}
+
+// touchList bumps the list's updatedat so owners see recently edited lists first.
+func touchList(context context.Context, transaction pgx.Tx, listID string) error {
+	query := fmt.Sprintf(`UPDATE %s SET %s = NOW() WHERE %s = $1`,
+		schema.LibraryCustomList.Table, schema.LibraryCustomList.UpdatedAt, schema.LibraryCustomList.ID)
+
+	if _, err := transaction.Exec(context, query, listID); err != nil {
+		return fmt.Errorf("postgres: failed to touch custom list: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,431 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/internal/platform/database/shelf"
+)
+
+// # PostgreSQL Repositories
+
+// progressRepository implements the [ProgressRepository] interface using pgx.
+type progressRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewProgressRepository constructs a PostgreSQL backed reading progress store.
+func NewProgressRepository(pool *pgxpool.Pool) ProgressRepository {
+	return &progressRepository{pool: pool}
+}
+
+// # Progress Repository Implementation
+
+/*
+List returns a paginated slice of the user's reading positions, most recent first.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*Progress: Positions hydrated with their chapter summary
+  - int: Total number of positions
+  - error: Database execution errors
+*/
+func (repository *progressRepository) List(context context.Context, userID string, limit, offset int) ([]*Progress, int, error) {
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER() AS total_count
+		%s
+		WHERE p.%s = $1
+		ORDER BY p.%s DESC, p.%s DESC
+		LIMIT $2 OFFSET $3
+	`,
+		progressProjection(),
+		progressSource(),
+		schema.LibraryReadingProgress.UserID,
+		schema.LibraryReadingProgress.UpdatedAt, schema.LibraryReadingProgress.ID,
+	)
+
+	rows, err := repository.pool.Query(context, query, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("postgres: failed to list reading progress: %w", err)
+	}
+	defer rows.Close()
+
+	// Row Iteration and Entity Hydration
+	var positions []*Progress
+	var totalCount int
+
+	for rows.Next() {
+		progress := &Progress{Chapter: &ChapterSummary{}}
+		if err := rows.Scan(append(progressScanTargets(progress), &totalCount)...); err != nil {
+			return nil, 0, fmt.Errorf("postgres: failed to scan reading progress: %w", err)
+		}
+		positions = append(positions, progress)
+	}
+
+	return positions, totalCount, rows.Err()
+}
+
+/*
+Find returns the reading position of a user in a comic.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+
+Returns:
+  - *Progress: The hydrated position
+  - error: apperr.NotFound if the comic was never opened
+*/
+func (repository *progressRepository) Find(context context.Context, userID, comicID string) (*Progress, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		%s
+		WHERE p.%s = $1 AND p.%s = $2
+	`,
+		progressProjection(),
+		progressSource(),
+		schema.LibraryReadingProgress.UserID, schema.LibraryReadingProgress.ComicID,
+	)
+
+	progress := &Progress{Chapter: &ChapterSummary{}}
+	if err := repository.pool.QueryRow(context, query, userID, comicID).Scan(progressScanTargets(progress)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("Reading progress")
+		}
+		return nil, fmt.Errorf("postgres: failed to find reading progress: %w", err)
+	}
+
+	return progress, nil
+}
+
+/*
+Save upserts a reading position using last-write-wins on UpdatedAt.
+
+Description: The conditional ON CONFLICT ... DO UPDATE ... WHERE clause
+makes the comparison atomic, so two devices racing on the same comic can
+never overwrite a newer position with an older one. When the write wins,
+the shelf entry's denormalised last read markers are refreshed in the same
+transaction.
+
+Parameters:
+  - context: context.Context
+  - progress: *Progress
+
+Returns:
+  - bool: False when a newer position was already stored
+  - error: Database execution errors
+*/
+func (repository *progressRepository) Save(context context.Context, progress *Progress) (bool, error) {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return false, fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Conditional upsert (last-write-wins)
+	upsertQuery := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s, %[6]s)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (%[2]s, %[3]s) DO UPDATE
+		SET %[4]s = EXCLUDED.%[4]s, %[5]s = EXCLUDED.%[5]s, %[6]s = EXCLUDED.%[6]s
+		WHERE %[1]s.%[6]s < EXCLUDED.%[6]s
+		RETURNING %[7]s
+	`,
+		schema.LibraryReadingProgress.Table,
+		schema.LibraryReadingProgress.UserID,
+		schema.LibraryReadingProgress.ComicID,
+		schema.LibraryReadingProgress.ChapterID,
+		schema.LibraryReadingProgress.PageNumber,
+		schema.LibraryReadingProgress.UpdatedAt,
+		schema.LibraryReadingProgress.ID,
+	)
+
+	err = transaction.QueryRow(context, upsertQuery,
+		progress.UserID, progress.ComicID, progress.ChapterID, progress.PageNumber, progress.UpdatedAt,
+	).Scan(&progress.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil // A newer position is already stored
+		}
+		return false, fmt.Errorf("postgres: failed to save reading progress: %w", err)
+	}
+
+	// Shelf fast path (only when the entry exists)
+	entryQuery := fmt.Sprintf(`
+		UPDATE %s SET %s = $1, %s = $2
+		WHERE %s = $3 AND %s = $4
+	`,
+		schema.LibraryEntry.Table,
+		schema.LibraryEntry.LastReadChapterID, schema.LibraryEntry.LastReadAt,
+		schema.LibraryEntry.UserID, schema.LibraryEntry.ComicID,
+	)
+	if _, err := transaction.Exec(context, entryQuery, progress.ChapterID, progress.UpdatedAt, progress.UserID, progress.ComicID); err != nil {
+		return false, fmt.Errorf("postgres: failed to update library entry position: %w", err)
+	}
+
+	if err := shelf.ClearHasNew(context, transaction, progress.UserID, progress.ComicID); err != nil {
+		return false, err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return false, fmt.Errorf("postgres: failed to commit reading progress: %w", err)
+	}
+
+	return true, nil
+}
+
+/*
+Delete resets the reading position and the shelf's last read markers.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - comicID: string
+
+Returns:
+  - error: apperr.NotFound if no position was stored
+*/
+func (repository *progressRepository) Delete(context context.Context, userID, comicID string) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1 AND %s = $2`,
+		schema.LibraryReadingProgress.Table, schema.LibraryReadingProgress.UserID, schema.LibraryReadingProgress.ComicID)
+
+	result, err := transaction.Exec(context, query, userID, comicID)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to delete reading progress: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperr.NotFound("Reading progress")
+	}
+
+	// Shelf markers reset
+	entryQuery := fmt.Sprintf(`UPDATE %s SET %s = NULL, %s = NULL WHERE %s = $1 AND %s = $2`,
+		schema.LibraryEntry.Table,
+		schema.LibraryEntry.LastReadChapterID, schema.LibraryEntry.LastReadAt,
+		schema.LibraryEntry.UserID, schema.LibraryEntry.ComicID,
+	)
+	if _, err := transaction.Exec(context, entryQuery, userID, comicID); err != nil {
+		return fmt.Errorf("postgres: failed to reset library entry position: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres: failed to commit reading progress reset: %w", err)
+	}
+
+	return nil
+}
+
+// ChapterPageCount resolves the page count of a chapter within a comic.
+func (repository *progressRepository) ChapterPageCount(context context.Context, comicID, chapterID string) (int, error) {
+	query := fmt.Sprintf(`
+		SELECT (SELECT COUNT(*) FROM %s pg WHERE pg.%s = ch.%s)
+		FROM %s ch
+		WHERE ch.%s = $1 AND ch.%s = $2 AND ch.%s IS NULL
+	`,
+		schema.CorePage.Table, schema.CorePage.ChapterID, schema.CoreChapter.ID,
+		schema.CoreChapter.Table,
+		schema.CoreChapter.ID, schema.CoreChapter.ComicID, schema.CoreChapter.DeletedAt,
+	)
+
+	var pageCount int
+	if err := repository.pool.QueryRow(context, query, chapterID, comicID).Scan(&pageCount); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, apperr.NotFound("Chapter")
+		}
+		return 0, fmt.Errorf("postgres: failed to count chapter pages: %w", err)
+	}
+
+	return pageCount, nil
+}
+
+/*
+ContinueReading resolves the next unread chapter of every shelved comic in one query.
+
+Description: A LATERAL sub-query picks, per shelf entry, the lowest-numbered
+published chapter that comes after the current position and has not been
+read yet. Chapters in the language the user is currently reading are
+preferred when several releases share a number. Entries that are fully
+caught up are omitted.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*ContinueItem: Comics with an unread chapter, most recently read first
+  - int: Total number of suggestions
+  - error: Database execution errors
+*/
+func (repository *progressRepository) ContinueReading(context context.Context, userID string, limit, offset int) ([]*ContinueItem, int, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			e.%[1]s, c.%[2]s, c.%[3]s, c.%[4]s, c.%[5]s, c.%[6]s,
+			e.%[7]s, p.%[8]s, p.%[9]s, COALESCE(p.%[10]s, e.%[11]s),
+			nx.%[12]s, nx.%[13]s, COALESCE(nx.%[14]s, ''), l.%[15]s,
+			COUNT(*) OVER() AS total_count
+		FROM %[16]s e
+		JOIN %[17]s c ON c.%[2]s = e.%[1]s AND c.%[18]s IS NULL
+		LEFT JOIN %[19]s p ON p.%[20]s = e.%[21]s AND p.%[22]s = e.%[1]s
+		LEFT JOIN %[23]s cur ON cur.%[12]s = COALESCE(p.%[8]s, e.%[24]s)
+		JOIN LATERAL (
+			SELECT ch.%[12]s, ch.%[13]s, ch.%[14]s, ch.%[25]s
+			FROM %[23]s ch
+			WHERE ch.%[26]s = e.%[1]s
+			  AND ch.%[27]s IS NULL
+			  AND ch.%[28]s IS NOT NULL AND ch.%[28]s <= NOW()
+			  AND (cur.%[12]s IS NULL OR ch.%[13]s > cur.%[13]s)
+			  AND NOT EXISTS (
+				SELECT 1 FROM %[29]s r
+				WHERE r.%[30]s = e.%[21]s AND r.%[31]s = ch.%[12]s
+			  )
+			ORDER BY ch.%[13]s ASC, (ch.%[25]s IS NOT DISTINCT FROM cur.%[25]s) DESC
+			LIMIT 1
+		) nx ON TRUE
+		JOIN %[32]s l ON l.%[33]s = nx.%[25]s
+		WHERE e.%[21]s = $1
+		ORDER BY COALESCE(p.%[10]s, e.%[11]s, e.%[34]s) DESC, e.%[35]s DESC
+		LIMIT $2 OFFSET $3
+	`,
+		schema.LibraryEntry.ComicID,              // 1
+		schema.CoreComic.ID,                      // 2
+		schema.CoreComic.Title,                   // 3
+		schema.CoreComic.Slug,                    // 4
+		schema.CoreComic.CoverURL,                // 5
+		schema.CoreComic.Status,                  // 6
+		schema.LibraryEntry.ReadingStatus,        // 7
+		schema.LibraryReadingProgress.ChapterID,  // 8
+		schema.LibraryReadingProgress.PageNumber, // 9
+		schema.LibraryReadingProgress.UpdatedAt,  // 10
+		schema.LibraryEntry.LastReadAt,           // 11
+		schema.CoreChapter.ID,                    // 12
+		schema.CoreChapter.Number,                // 13
+		schema.CoreChapter.Title,                 // 14
+		schema.RefLanguage.Code,                  // 15
+		schema.LibraryEntry.Table,                // 16
+		schema.CoreComic.Table,                   // 17
+		schema.CoreComic.DeletedAt,               // 18
+		schema.LibraryReadingProgress.Table,      // 19
+		schema.LibraryReadingProgress.UserID,     // 20
+		schema.LibraryEntry.UserID,               // 21
+		schema.LibraryReadingProgress.ComicID,    // 22
+		schema.CoreChapter.Table,                 // 23
+		schema.LibraryEntry.LastReadChapterID,    // 24
+		schema.CoreChapter.LanguageID,            // 25
+		schema.CoreChapter.ComicID,               // 26
+		schema.CoreChapter.DeletedAt,             // 27
+		schema.CoreChapter.PublishedAt,           // 28
+		schema.CoreUserRead.Table,                // 29
+		schema.CoreUserRead.UserID,               // 30
+		schema.CoreUserRead.ChapterID,            // 31
+		schema.RefLanguage.Table,                 // 32
+		schema.RefLanguage.ID,                    // 33
+		schema.LibraryEntry.UpdatedAt,            // 34
+		schema.LibraryEntry.ID,                   // 35
+	)
+
+	rows, err := repository.pool.Query(context, query, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("postgres: failed to resolve continue reading: %w", err)
+	}
+	defer rows.Close()
+
+	// Row Iteration and Entity Hydration
+	var items []*ContinueItem
+	var totalCount int
+
+	for rows.Next() {
+		item := &ContinueItem{Comic: &ComicSummary{}, NextChapter: &ChapterSummary{}}
+		err := rows.Scan(
+			&item.ComicID,
+			&item.Comic.ID,
+			&item.Comic.Title,
+			&item.Comic.Slug,
+			&item.Comic.CoverURL,
+			&item.Comic.Status,
+			&item.ReadingStatus,
+			&item.ChapterID,
+			&item.PageNumber,
+			&item.LastReadAt,
+			&item.NextChapter.ID,
+			&item.NextChapter.Number,
+			&item.NextChapter.Title,
+			&item.NextChapter.Language,
+			&totalCount,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("postgres: failed to scan continue reading item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	return items, totalCount, rows.Err()
+}
+
+// # Internal Helpers
+
+// progressProjection returns the shared SELECT list for progress queries (aliases: p, ch, l).
+func progressProjection() string {
+	return fmt.Sprintf(`
+		p.%s, p.%s, p.%s, p.%s, p.%s, p.%s,
+		ch.%s, ch.%s, COALESCE(ch.%s, ''), l.%s`,
+		schema.LibraryReadingProgress.ID,
+		schema.LibraryReadingProgress.UserID,
+		schema.LibraryReadingProgress.ComicID,
+		schema.LibraryReadingProgress.ChapterID,
+		schema.LibraryReadingProgress.PageNumber,
+		schema.LibraryReadingProgress.UpdatedAt,
+		schema.CoreChapter.ID,
+		schema.CoreChapter.Number,
+		schema.CoreChapter.Title,
+		schema.RefLanguage.Code,
+	)
+}
+
+// progressSource returns the FROM clause matching [progressProjection].
+func progressSource() string {
+	return fmt.Sprintf(`
+		FROM %s p
+		JOIN %s ch ON ch.%s = p.%s
+		JOIN %s l ON l.%s = ch.%s`,
+		schema.LibraryReadingProgress.Table,
+		schema.CoreChapter.Table, schema.CoreChapter.ID, schema.LibraryReadingProgress.ChapterID,
+		schema.RefLanguage.Table, schema.RefLanguage.ID, schema.CoreChapter.LanguageID,
+	)
+}
+
+// progressScanTargets returns the scan destinations matching [progressProjection].
+func progressScanTargets(progress *Progress) []any {
+	return []any{
+		&progress.ID,
+		&progress.UserID,
+		&progress.ComicID,
+		&progress.ChapterID,
+		&progress.PageNumber,
+		&progress.UpdatedAt,
+		&progress.Chapter.ID,
+		&progress.Chapter.Number,
+		&progress.Chapter.Title,
+		&progress.Chapter.Language,
+	}
+}
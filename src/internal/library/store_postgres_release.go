@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+)
+
+// # PostgreSQL Repositories
+
+// releaseRepository implements the [ReleaseRepository] interface using pgx.
+type releaseRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewReleaseRepository constructs a PostgreSQL backed release fan-out store.
+func NewReleaseRepository(pool *pgxpool.Pool) ReleaseRepository {
+	return &releaseRepository{pool: pool}
+}
+
+// # Release Repository Implementation
+
+/*
+ListReleases returns the comics that gained a published chapter within a window.
+
+Description: A chapter enters the window by its publication time only, the
+same rule as the new-chapter notifications, so editing an old chapter never
+announces it again. Chapters scheduled for the future are excluded until
+their time has passed. Each comic reports its latest publication, so every
+reader who last read before it is flagged.
+
+Parameters:
+  - context: context.Context
+  - from: time.Time
+  - until: time.Time
+
+Returns:
+  - []Release: One release per comic
+  - error: Database execution errors
+*/
+func (repository *releaseRepository) ListReleases(context context.Context, from, until time.Time) ([]Release, error) {
+	query := fmt.Sprintf(`
+		SELECT %[1]s, MAX(%[2]s)
+		FROM %[3]s
+		WHERE %[4]s IS NULL
+		  AND %[2]s > $1 AND %[2]s <= $2
+		GROUP BY %[1]s
+	`,
+		schema.CoreChapter.ComicID,     // 1
+		schema.CoreChapter.PublishedAt, // 2
+		schema.CoreChapter.Table,       // 3
+		schema.CoreChapter.DeletedAt,   // 4
+	)
+
+	rows, err := repository.pool.Query(context, query, from, until)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list releases: %w", err)
+	}
+
+	releases, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Release, error) {
+		var release Release
+		err := row.Scan(&release.ComicID, &release.PublishedAt)
+		return release, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to scan release: %w", err)
+	}
+
+	return releases, nil
+}
+
+/*
+FlagNew sets the "has new chapters" flag on a batch of shelf entries.
+
+Description: The batch is selected by primary key in a sub-query so each
+statement locks at most batchSize rows. Callers loop until fewer rows than
+batchSize are affected.
+
+Parameters:
+  - context: context.Context
+  - release: Release
+  - batchSize: int
+
+Returns:
+  - int: Number of entries flagged
+  - error: Database execution errors
+*/
+func (repository *releaseRepository) FlagNew(context context.Context, release Release, batchSize int) (int, error) {
+	query := fmt.Sprintf(`
+		UPDATE %[1]s SET %[2]s = TRUE
+		WHERE %[3]s IN (
+			SELECT %[3]s FROM %[1]s
+			WHERE %[4]s = $1
+			  AND %[2]s = FALSE
+			  AND (%[5]s IS NULL OR %[5]s < $2)
+			LIMIT $3
+		)
+	`,
+		schema.LibraryEntry.Table,      // 1
+		schema.LibraryEntry.HasNew,     // 2
+		schema.LibraryEntry.ID,         // 3
+		schema.LibraryEntry.ComicID,    // 4
+		schema.LibraryEntry.LastReadAt, // 5
+	)
+
+	result, err := repository.pool.Exec(context, query, release.ComicID, release.PublishedAt, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to flag new chapters: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
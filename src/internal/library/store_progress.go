@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import "context"
+
+// # Reading Progress Data Access
+
+// ProgressRepository defines the data access contract for per-comic reading positions.
+type ProgressRepository interface {
+
+	/*
+		List returns a paginated slice of the user's reading positions, most recent first.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - limit: int
+		  - offset: int
+
+		Returns:
+		  - []*Progress: Positions hydrated with their chapter summary
+		  - int: Total number of positions
+		  - error: Database retrieval failures
+	*/
+	List(context context.Context, userID string, limit, offset int) ([]*Progress, int, error)
+
+	/*
+		Find returns the reading position of a user in a comic.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - comicID: string
+
+		Returns:
+		  - *Progress: The hydrated position
+		  - error: ErrNotFound if the comic was never opened
+	*/
+	Find(context context.Context, userID, comicID string) (*Progress, error)
+
+	/*
+		Save upserts a reading position using last-write-wins on UpdatedAt.
+
+		Description: The write is only applied when progress.UpdatedAt is newer
+		than the stored value. Applied writes also refresh the shelf entry's
+		last read chapter and clear its "has new chapters" flag once the
+		latest chapter is reached.
+
+		Parameters:
+		  - context: context.Context
+		  - progress: *Progress (UserID, ComicID, ChapterID, PageNumber, UpdatedAt)
+
+		Returns:
+		  - bool: False when a newer position was already stored
+		  - error: Storage failures
+	*/
+	Save(context context.Context, progress *Progress) (bool, error)
+
+	/*
+		Delete resets the reading position and the shelf's last read markers.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - comicID: string
+
+		Returns:
+		  - error: ErrNotFound if no position was stored
+	*/
+	Delete(context context.Context, userID, comicID string) error
+
+	/*
+		ChapterPageCount resolves the page count of a chapter within a comic.
+
+		Parameters:
+		  - context: context.Context
+		  - comicID: string
+		  - chapterID: string
+
+		Returns:
+		  - int: Number of pages (0 for externally hosted chapters)
+		  - error: ErrNotFound if the chapter does not belong to the comic
+	*/
+	ChapterPageCount(context context.Context, comicID, chapterID string) (int, error)
+
+	/*
+		ContinueReading resolves the next unread chapter of every shelved comic in one query.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - limit: int
+		  - offset: int
+
+		Returns:
+		  - []*ContinueItem: Comics with an unread chapter, most recently read first
+		  - int: Total number of suggestions
+		  - error: Database retrieval failures
+	*/
+	ContinueReading(context context.Context, userID string, limit, offset int) ([]*ContinueItem, int, error)
+}
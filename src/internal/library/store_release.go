@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package library
+
+import (
+	"context"
+	"time"
+)
+
+// # Release Fan-out Data Access
+
+// ReleaseRepository defines the data access contract for the "has new chapters" fan-out.
+type ReleaseRepository interface {
+
+	/*
+		ListReleases returns the comics that gained a published chapter within a window.
+
+		Description: A chapter counts once its publication time has passed, so
+		scheduled chapters surface on the first sweep after their PublishedAt.
+
+		Parameters:
+		  - context: context.Context
+		  - from: time.Time (Exclusive lower bound)
+		  - until: time.Time (Inclusive upper bound)
+
+		Returns:
+		  - []Release: One release per comic
+		  - error: Database retrieval failures
+	*/
+	ListReleases(context context.Context, from, until time.Time) ([]Release, error)
+
+	/*
+		FlagNew sets the "has new chapters" flag on a batch of shelf entries.
+
+		Description: Only entries whose last read predates the release are
+		touched, so readers who already caught up are left alone.
+
+		Parameters:
+		  - context: context.Context
+		  - release: Release
+		  - batchSize: int
+
+		Returns:
+		  - int: Number of entries flagged (less than batchSize when done)
+		  - error: Database execution errors
+	*/
+	FlagNew(context context.Context, release Release, batchSize int) (int, error)
+}
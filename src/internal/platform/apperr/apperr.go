@@ -44,6 +44,8 @@ type AppError struct {
 	Cause error `json:"-"`
 	// Details holds per-field validation errors for VALIDATION_ERROR responses.
 	Details []FieldError `json:"details,omitempty"`
+	// RetryAfter is the number of seconds a client must wait, sent as the Retry-After header.
+	RetryAfter int `json:"-"`
 }
 
 // FieldError represents a single field-level validation failure.
@@ -84,6 +86,14 @@ func IsNotFound(err error) bool {
 	return ae != nil && ae.Code == "NOT_FOUND"
 }
 
+/*
+IsUnauthorized reports whether err is an AppError with code UNAUTHORIZED.
+*/
+func IsUnauthorized(err error) bool {
+	ae := As(err)
+	return ae != nil && ae.Code == "UNAUTHORIZED"
+}
+
 /*
 As extracts the [*AppError] from err's chain. It returns nil if not found.
 
@@ -220,6 +230,21 @@ func RateLimited(retryAfterSeconds int) *AppError {
 		Code:       "RATE_LIMITED",
 		Message:    fmt.Sprintf("Too many requests. Try again in %ds.", retryAfterSeconds),
 		HTTPStatus: http.StatusTooManyRequests,
+		RetryAfter: retryAfterSeconds,
+	}
+}
+
+/*
+AccountSuspended creates a 403 [AppError] for accounts barred by an administrator.
+
+Returns:
+  - *AppError: Formatted ACCOUNT_SUSPENDED error
+*/
+func AccountSuspended() *AppError {
+	return &AppError{
+		Code:       "ACCOUNT_SUSPENDED",
+		Message:    "Account is suspended",
+		HTTPStatus: http.StatusForbidden,
 	}
 }
 
@@ -26,10 +26,15 @@ package config
 
 import (
 	"fmt"
+	"net/mail"
+	"net/netip"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v11"
+
+	"github.com/taibuivan/yomira/internal/platform/sec"
 )
 
 // # Configuration Schema
@@ -56,6 +61,46 @@ type Config struct {
 	JWTPrivKeyPath string `env:"JWT_PRIVATE_KEY_PATH,required"`
 	JWTPubKeyPath  string `env:"JWT_PUBLIC_KEY_PATH,required"`
 
+	// Key rotation: staged ("path") or retired ("path@RFC3339") public keys still accepted
+	JWTAdditionalKeys []string      `env:"JWT_ADDITIONAL_PUBLIC_KEYS" envSeparator:","`
+	JWTKeyOverlap     time.Duration `env:"JWT_KEY_OVERLAP"            envDefault:"1h"`
+
+	// MFARequiredRole makes two-factor mandatory for this role and above (empty = optional for everyone)
+	MFARequiredRole string `env:"MFA_REQUIRED_ROLE"`
+
+	// AppURL is the public base URL of the web app (OAuth landing page, email links)
+	AppURL string `env:"APP_URL" envDefault:"http://localhost:3000"`
+
+	// Social login: a provider is enabled when its client ID is set
+	OAuthRedirectBaseURL string `env:"OAUTH_REDIRECT_BASE_URL" envDefault:"http://localhost:8080/api/v1/auth/oauth"`
+	GoogleClientID       string `env:"GOOGLE_CLIENT_ID"`
+	GoogleClientSecret   string `env:"GOOGLE_CLIENT_SECRET"`
+	DiscordClientID      string `env:"DISCORD_CLIENT_ID"`
+	DiscordClientSecret  string `env:"DISCORD_CLIENT_SECRET"`
+	GitHubClientID       string `env:"GITHUB_CLIENT_ID"`
+	GitHubClientSecret   string `env:"GITHUB_CLIENT_SECRET"`
+
+	// Generic OpenID Connect issuer (discovered from OIDC_ISSUER_URL)
+	OIDCProviderName string `env:"OIDC_PROVIDER_NAME" envDefault:"oidc"`
+	OIDCIssuerURL    string `env:"OIDC_ISSUER_URL"`
+	OIDCClientID     string `env:"OIDC_CLIENT_ID"`
+	OIDCClientSecret string `env:"OIDC_CLIENT_SECRET"`
+
+	// Transactional email: "smtp" delivers, "file" writes .eml files, "log" only logs (development)
+	MailProvider string `env:"MAIL_PROVIDER"  envDefault:"log"`
+	MailFrom     string `env:"MAIL_FROM"      envDefault:"Yomira <noreply@yomira.app>"`
+	MailReplyTo  string `env:"MAIL_REPLY_TO"`
+	MailFileDir  string `env:"MAIL_FILE_DIR"  envDefault:"./tmp/mail"`
+	SMTPHost     string `env:"SMTP_HOST"`
+	SMTPPort     int    `env:"SMTP_PORT"      envDefault:"587"`
+	SMTPUser     string `env:"SMTP_USER"`
+	SMTPPass     string `env:"SMTP_PASS"`
+	SMTPTLS      string `env:"SMTP_TLS"       envDefault:"starttls"`
+
+	// Comment filter: matching comments are held for moderator review
+	CommentBannedWords  []string `env:"COMMENT_BANNED_WORDS"  envSeparator:","`
+	CommentAllowedHosts []string `env:"COMMENT_ALLOWED_HOSTS" envSeparator:"," envDefault:"yomira.app"`
+
 	// Object Storage (Cloudflare R2 / S3-compatible)
 	S3Bucket   string `env:"S3_BUCKET"`
 	S3Region   string `env:"S3_REGION"   envDefault:"auto"`
@@ -63,6 +108,10 @@ type Config struct {
 
 	// Cross-Origin Resource Sharing
 	ExtraOrigins string `env:"EXTRA_ORIGINS"`
+
+	// Reverse proxies (addresses or CIDR ranges) whose forwarding headers are trusted.
+	// Empty means the client address is always the TCP peer.
+	TrustedProxies []string `env:"TRUSTED_PROXIES" envSeparator:","`
 }
 
 // # Configuration Loading
@@ -109,9 +158,102 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("JWT_PUBLIC_KEY_PATH file not found: %s", c.JWTPubKeyPath)
 	}
 
+	for _, spec := range c.JWTAdditionalKeys {
+		path, _ := sec.ParseKeySpec(spec)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return fmt.Errorf("JWT_ADDITIONAL_PUBLIC_KEYS file not found: %s", path)
+		}
+	}
+
+	if c.JWTKeyOverlap <= 0 {
+		return fmt.Errorf("JWT_KEY_OVERLAP must be positive")
+	}
+
+	// 3. Security Policy
+	if c.MFARequiredRole != "" && !sec.UserRole(c.MFARequiredRole).IsValid() {
+		return fmt.Errorf("MFA_REQUIRED_ROLE must be one of admin, moderator, author, member")
+	}
+
+	// 4. Public URLs
+	for name, value := range map[string]string{"APP_URL": c.AppURL, "OAUTH_REDIRECT_BASE_URL": c.OAuthRedirectBaseURL} {
+		if !strings.HasPrefix(value, "http://") && !strings.HasPrefix(value, "https://") {
+			return fmt.Errorf("%s must be an absolute http(s) URL", name)
+		}
+	}
+
+	if c.OIDCIssuerURL != "" && c.OIDCClientID == "" {
+		return fmt.Errorf("OIDC_CLIENT_ID is required when OIDC_ISSUER_URL is set")
+	}
+
+	// 5. Transactional Email
+	// Development sinks would silently drop production mail (and log its links).
+	switch c.MailProvider {
+	case "smtp":
+		if c.SMTPHost == "" {
+			return fmt.Errorf("SMTP_HOST is required when MAIL_PROVIDER is smtp")
+		}
+		if c.SMTPPort <= 0 || c.SMTPPort > 65535 {
+			return fmt.Errorf("SMTP_PORT must be a valid TCP port")
+		}
+		if c.SMTPTLS != "starttls" && c.SMTPTLS != "tls" && c.SMTPTLS != "none" {
+			return fmt.Errorf("SMTP_TLS must be one of starttls, tls, none")
+		}
+	case "file", "log":
+		if c.IsProduction() {
+			return fmt.Errorf("MAIL_PROVIDER must be smtp in production")
+		}
+	default:
+		return fmt.Errorf("MAIL_PROVIDER must be one of smtp, file, log")
+	}
+
+	if _, err := mail.ParseAddress(c.MailFrom); err != nil {
+		return fmt.Errorf("MAIL_FROM must be a valid email address: %w", err)
+	}
+
+	if c.MailReplyTo != "" {
+		if _, err := mail.ParseAddress(c.MailReplyTo); err != nil {
+			return fmt.Errorf("MAIL_REPLY_TO must be a valid email address: %w", err)
+		}
+	}
+
+	// 6. Network
+	for _, proxy := range c.TrustedProxies {
+		if _, err := parseProxy(proxy); err != nil {
+			return fmt.Errorf("TRUSTED_PROXIES: %q is not an IP address or CIDR range", proxy)
+		}
+	}
+
 	return nil
 }
 
+// TrustedProxyPrefixes returns [Config.TrustedProxies] as address ranges; single
+// addresses become one-address ranges. Invalid entries are rejected by [Config.Validate].
+func (c *Config) TrustedProxyPrefixes() []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, proxy := range c.TrustedProxies {
+		if prefix, err := parseProxy(proxy); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// parseProxy parses an address or CIDR range.
+func parseProxy(value string) (netip.Prefix, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		return prefix.Masked(), err
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
 // IsDevelopment reports whether the server is running in development mode.
 func (c *Config) IsDevelopment() bool {
 	return c.Environment == "development"
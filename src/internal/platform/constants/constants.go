@@ -108,9 +108,24 @@ const (
 // # Redis Prefixes (Cache Taxonomy)
 
 const (
-	RedisPrefixResetToken  = "auth:reset_token:"
-	RedisPrefixVerifyToken = "auth:verify_token:"
-	RedisPrefixSession     = "auth:session:"
+	RedisPrefixResetToken          = "auth:reset_token:"
+	RedisPrefixVerifyToken         = "auth:verify_token:"
+	RedisPrefixSession             = "auth:session:"
+	RedisPrefixLoginFail           = "auth:login_fail:"
+	RedisPrefixLoginBlock          = "auth:login_block:"
+	RedisPrefixMFAChallenge        = "auth:mfa_challenge:"
+	RedisPrefixOAuthState          = "auth:oauth_state:"
+	RedisPrefixSuspended           = "auth:suspended:"
+	RedisPrefixRevokedToken        = "auth:revoked_token:"
+	RedisPrefixRevokedBefore       = "auth:revoked_before:"
+	RedisPrefixCommentRate         = "social:comment_rate:"
+	RedisPrefixUnreadNotifications = "social:notifications_unread:"
+)
+
+// # Redis Channels (Pub/Sub)
+
+const (
+	RedisChannelNotifications = "social:notifications:events"
 )
 
 // # HTTP Headers
@@ -124,6 +139,7 @@ const (
 	HeaderContentType   = "Content-Type"
 	HeaderOrigin        = "Origin"
 	HeaderUserAgent     = "User-Agent"
+	HeaderRetryAfter    = "Retry-After"
 )
 
 // # Database Tables
@@ -28,4 +28,7 @@ const (
 
 	// KeyLogger is the context key for the per-request [*log/slog.Logger].
 	KeyLogger key = "logger"
+
+	// KeyClientIP is the context key for the resolved client address.
+	KeyClientIP key = "client_ip"
 )
@@ -26,6 +26,18 @@ func GetRequestID(ctx context.Context) string {
 	return id
 }
 
+// WithClientIP returns a new context with the resolved client address attached.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, ctxkey.KeyClientIP, ip)
+}
+
+// GetClientIP retrieves the resolved client address from the context.
+// Returns an empty string if not found.
+func GetClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(ctxkey.KeyClientIP).(string)
+	return ip
+}
+
 // # Structured Logging
 
 // WithLogger returns a new context with the provided logger attached.
@@ -8,9 +8,11 @@ type SocialCommentTable struct {
 	ComicID    string
 	ChapterID  string
 	ParentID   string
+	Depth      string
 	Body       string
 	IsDeleted  string
 	IsApproved string
+	ReviewedAt string
 	Upvotes    string
 	Downvotes  string
 	CreatedAt  string
@@ -25,9 +27,11 @@ var SocialComment = SocialCommentTable{
 	ComicID:    "comicid",
 	ChapterID:  "chapterid",
 	ParentID:   "parentid",
+	Depth:      "depth",
 	Body:       "body",
 	IsDeleted:  "isdeleted",
 	IsApproved: "isapproved",
+	ReviewedAt: "reviewedat",
 	Upvotes:    "upvotes",
 	Downvotes:  "downvotes",
 	CreatedAt:  "createdat",
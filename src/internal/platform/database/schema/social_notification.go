@@ -0,0 +1,29 @@
+package schema
+
+// SocialNotificationTable represents the 'social.notification' table
+type SocialNotificationTable struct {
+	Table      string
+	ID         string
+	UserID     string
+	Type       string
+	Title      string
+	Body       string
+	EntityType string
+	EntityID   string
+	IsRead     string
+	CreatedAt  string
+}
+
+// SocialNotification is the schema definition for social.notification
+var SocialNotification = SocialNotificationTable{
+	Table:      "social.notification",
+	ID:         "id",
+	UserID:     "userid",
+	Type:       "type",
+	Title:      "title",
+	Body:       "body",
+	EntityType: "entitytype",
+	EntityID:   "entityid",
+	IsRead:     "isread",
+	CreatedAt:  "createdat",
+}
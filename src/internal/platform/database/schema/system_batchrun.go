@@ -0,0 +1,43 @@
+package schema
+
+// SystemBatchRunTable represents the 'system.batchrun' table
+type SystemBatchRunTable struct {
+	Table             string
+	ID                string
+	JobKey            string
+	Status            string
+	TriggeredBy       string
+	TriggeredByUserID string
+	StartedAt         string
+	FinishedAt        string
+	DurationMs        string
+	RowsAffected      string
+	LastError         string
+	Meta              string
+	CreatedAt         string
+}
+
+// SystemBatchRun is the schema definition for system.batchrun
+var SystemBatchRun = SystemBatchRunTable{
+	Table:             "system.batchrun",
+	ID:                "id",
+	JobKey:            "jobkey",
+	Status:            "status",
+	TriggeredBy:       "triggeredby",
+	TriggeredByUserID: "triggeredbyuserid",
+	StartedAt:         "startedat",
+	FinishedAt:        "finishedat",
+	DurationMs:        "durationms",
+	RowsAffected:      "rowsaffected",
+	LastError:         "lasterror",
+	Meta:              "meta",
+	CreatedAt:         "createdat",
+}
+
+// Columns returns all standard column names
+func (t SystemBatchRunTable) Columns() []string {
+	return []string{
+		t.ID, t.JobKey, t.Status, t.TriggeredBy, t.TriggeredByUserID, t.StartedAt, t.FinishedAt,
+		t.DurationMs, t.RowsAffected, t.LastError, t.Meta, t.CreatedAt,
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+// SystemBatchScheduleTable represents the 'system.batchschedule' table
+type SystemBatchScheduleTable struct {
+	Table     string
+	JobKey    string
+	Cron      string
+	IsEnabled string
+	UpdatedBy string
+	UpdatedAt string
+}
+
+// SystemBatchSchedule is the schema definition for system.batchschedule
+var SystemBatchSchedule = SystemBatchScheduleTable{
+	Table:     "system.batchschedule",
+	JobKey:    "jobkey",
+	Cron:      "cron",
+	IsEnabled: "isenabled",
+	UpdatedBy: "updatedby",
+	UpdatedAt: "updatedat",
+}
+
+// Columns returns all standard column names
+func (t SystemBatchScheduleTable) Columns() []string {
+	return []string{
+		t.JobKey, t.Cron, t.IsEnabled, t.UpdatedBy, t.UpdatedAt,
+	}
+}
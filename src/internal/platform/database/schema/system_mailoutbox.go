@@ -0,0 +1,41 @@
+package schema
+
+// SystemMailOutboxTable represents the 'system.mailoutbox' table
+type SystemMailOutboxTable struct {
+	Table         string
+	ID            string
+	Template      string
+	Recipient     string
+	Subject       string
+	Payload       string
+	Status        string
+	Attempts      string
+	LastError     string
+	NextAttemptAt string
+	SentAt        string
+	CreatedAt     string
+}
+
+// SystemMailOutbox is the schema definition for system.mailoutbox
+var SystemMailOutbox = SystemMailOutboxTable{
+	Table:         "system.mailoutbox",
+	ID:            "id",
+	Template:      "template",
+	Recipient:     "recipient",
+	Subject:       "subject",
+	Payload:       "payload",
+	Status:        "status",
+	Attempts:      "attempts",
+	LastError:     "lasterror",
+	NextAttemptAt: "nextattemptat",
+	SentAt:        "sentat",
+	CreatedAt:     "createdat",
+}
+
+// Columns returns all standard column names
+func (t SystemMailOutboxTable) Columns() []string {
+	return []string{
+		t.ID, t.Template, t.Recipient, t.Subject, t.Payload, t.Status, t.Attempts,
+		t.LastError, t.NextAttemptAt, t.SentAt, t.CreatedAt,
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+// UserAccessTokenTable represents the 'users.accesstoken' table
+type UserAccessTokenTable struct {
+	Table       string
+	ID          string
+	UserID      string
+	Name        string
+	TokenPrefix string
+	TokenHash   string
+	Scopes      string
+	ExpiresAt   string
+	LastUsedAt  string
+	LastUsedIP  string
+	RevokedAt   string
+	CreatedAt   string
+}
+
+// UserAccessToken is the schema definition for users.accesstoken
+var UserAccessToken = UserAccessTokenTable{
+	Table:       "users.accesstoken",
+	ID:          "id",
+	UserID:      "userid",
+	Name:        "name",
+	TokenPrefix: "tokenprefix",
+	TokenHash:   "tokenhash",
+	Scopes:      "scopes",
+	ExpiresAt:   "expiresat",
+	LastUsedAt:  "lastusedat",
+	LastUsedIP:  "lastusedip",
+	RevokedAt:   "revokedat",
+	CreatedAt:   "createdat",
+}
+
+// Columns returns all standard column names
+func (t UserAccessTokenTable) Columns() []string {
+	return []string{
+		t.ID, t.UserID, t.Name, t.TokenPrefix, t.TokenHash, t.Scopes, t.ExpiresAt, t.LastUsedAt, t.LastUsedIP, t.RevokedAt, t.CreatedAt,
+	}
+}
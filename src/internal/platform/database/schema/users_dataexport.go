@@ -0,0 +1,36 @@
+package schema
+
+// UserDataExportTable represents the 'users.dataexport' table
+type UserDataExportTable struct {
+	Table       string
+	ID          string
+	UserID      string
+	Status      string
+	Archive     string
+	SizeBytes   string
+	LastError   string
+	CreatedAt   string
+	CompletedAt string
+	ExpiresAt   string
+}
+
+// UserDataExport is the schema definition for users.dataexport
+var UserDataExport = UserDataExportTable{
+	Table:       "users.dataexport",
+	ID:          "id",
+	UserID:      "userid",
+	Status:      "status",
+	Archive:     "archive",
+	SizeBytes:   "sizebytes",
+	LastError:   "lasterror",
+	CreatedAt:   "createdat",
+	CompletedAt: "completedat",
+	ExpiresAt:   "expiresat",
+}
+
+// Columns returns all standard column names
+func (t UserDataExportTable) Columns() []string {
+	return []string{
+		t.ID, t.UserID, t.Status, t.Archive, t.SizeBytes, t.LastError, t.CreatedAt, t.CompletedAt, t.ExpiresAt,
+	}
+}
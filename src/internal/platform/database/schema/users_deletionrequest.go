@@ -0,0 +1,26 @@
+package schema
+
+// UserDeletionRequestTable represents the 'users.deletionrequest' table
+type UserDeletionRequestTable struct {
+	Table       string
+	UserID      string
+	TokenHash   string
+	RequestedAt string
+	PurgeAfter  string
+}
+
+// UserDeletionRequest is the schema definition for users.deletionrequest
+var UserDeletionRequest = UserDeletionRequestTable{
+	Table:       "users.deletionrequest",
+	UserID:      "userid",
+	TokenHash:   "tokenhash",
+	RequestedAt: "requestedat",
+	PurgeAfter:  "purgeafter",
+}
+
+// Columns returns all standard column names
+func (t UserDeletionRequestTable) Columns() []string {
+	return []string{
+		t.UserID, t.TokenHash, t.RequestedAt, t.PurgeAfter,
+	}
+}
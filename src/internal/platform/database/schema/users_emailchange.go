@@ -0,0 +1,36 @@
+package schema
+
+// UserEmailChangeTable represents the 'users.emailchange' table
+type UserEmailChangeTable struct {
+	Table           string
+	UserID          string
+	NewEmail        string
+	TokenHash       string
+	CancelTokenHash string
+	ExpiresAt       string
+	RequestCount    string
+	WindowStartedAt string
+	RequestedAt     string
+	CreatedAt       string
+}
+
+// UserEmailChange is the schema definition for users.emailchange
+var UserEmailChange = UserEmailChangeTable{
+	Table:           "users.emailchange",
+	UserID:          "userid",
+	NewEmail:        "newemail",
+	TokenHash:       "tokenhash",
+	CancelTokenHash: "canceltokenhash",
+	ExpiresAt:       "expiresat",
+	RequestCount:    "requestcount",
+	WindowStartedAt: "windowstartedat",
+	RequestedAt:     "requestedat",
+	CreatedAt:       "createdat",
+}
+
+// Columns returns all standard column names
+func (t UserEmailChangeTable) Columns() []string {
+	return []string{
+		t.UserID, t.NewEmail, t.TokenHash, t.CancelTokenHash, t.ExpiresAt, t.RequestCount, t.WindowStartedAt, t.RequestedAt, t.CreatedAt,
+	}
+}
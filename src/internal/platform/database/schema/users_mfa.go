@@ -0,0 +1,32 @@
+package schema
+
+// UserMFATable represents the 'users.mfa' table
+type UserMFATable struct {
+	Table        string
+	UserID       string
+	Secret       string
+	IsEnabled    string
+	LastUsedStep string
+	EnabledAt    string
+	CreatedAt    string
+	UpdatedAt    string
+}
+
+// UserMFA is the schema definition for users.mfa
+var UserMFA = UserMFATable{
+	Table:        "users.mfa",
+	UserID:       "userid",
+	Secret:       "secret",
+	IsEnabled:    "isenabled",
+	LastUsedStep: "lastusedstep",
+	EnabledAt:    "enabledat",
+	CreatedAt:    "createdat",
+	UpdatedAt:    "updatedat",
+}
+
+// Columns returns all standard column names
+func (t UserMFATable) Columns() []string {
+	return []string{
+		t.UserID, t.Secret, t.IsEnabled, t.LastUsedStep, t.EnabledAt, t.CreatedAt, t.UpdatedAt,
+	}
+}
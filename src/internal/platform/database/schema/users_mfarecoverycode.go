@@ -0,0 +1,28 @@
+package schema
+
+// UserMFARecoveryCodeTable represents the 'users.mfarecoverycode' table
+type UserMFARecoveryCodeTable struct {
+	Table     string
+	ID        string
+	UserID    string
+	CodeHash  string
+	UsedAt    string
+	CreatedAt string
+}
+
+// UserMFARecoveryCode is the schema definition for users.mfarecoverycode
+var UserMFARecoveryCode = UserMFARecoveryCodeTable{
+	Table:     "users.mfarecoverycode",
+	ID:        "id",
+	UserID:    "userid",
+	CodeHash:  "codehash",
+	UsedAt:    "usedat",
+	CreatedAt: "createdat",
+}
+
+// Columns returns all standard column names
+func (t UserMFARecoveryCodeTable) Columns() []string {
+	return []string{
+		t.ID, t.UserID, t.CodeHash, t.UsedAt, t.CreatedAt,
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+// UserOAuthProviderTable represents the 'users.oauthprovider' table
+type UserOAuthProviderTable struct {
+	Table       string
+	ID          string
+	UserID      string
+	Provider    string
+	ProviderID  string
+	Email       string
+	AccessToken string
+	CreatedAt   string
+}
+
+// UserOAuthProvider is the schema definition for users.oauthprovider
+var UserOAuthProvider = UserOAuthProviderTable{
+	Table:       "users.oauthprovider",
+	ID:          "id",
+	UserID:      "userid",
+	Provider:    "provider",
+	ProviderID:  "providerid",
+	Email:       "email",
+	AccessToken: "accesstoken",
+	CreatedAt:   "createdat",
+}
+
+// Columns returns all standard column names
+func (t UserOAuthProviderTable) Columns() []string {
+	return []string{
+		t.ID, t.UserID, t.Provider, t.ProviderID, t.Email, t.AccessToken, t.CreatedAt,
+	}
+}
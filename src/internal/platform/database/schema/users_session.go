@@ -6,6 +6,8 @@ type UserSessionTable struct {
 	ID         string
 	UserID     string
 	TokenHash  string
+	ParentID   string
+	FamilyID   string
 	DeviceName string
 	IPAddress  string
 	UserAgent  string
@@ -21,6 +23,8 @@ var UserSession = UserSessionTable{
 	ID:         "id",
 	UserID:     "userid",
 	TokenHash:  "tokenhash",
+	ParentID:   "parentid",
+	FamilyID:   "familyid",
 	DeviceName: "devicename",
 	IPAddress:  "ipaddress",
 	UserAgent:  "useragent",
@@ -33,6 +37,6 @@ var UserSession = UserSessionTable{
 // Columns returns all standard column names
 func (t UserSessionTable) Columns() []string {
 	return []string{
-		t.ID, t.UserID, t.TokenHash, t.DeviceName, t.IPAddress, t.UserAgent, t.IsRevoked, t.ExpiresAt, t.RevokedAt, t.CreatedAt,
+		t.ID, t.UserID, t.TokenHash, t.ParentID, t.FamilyID, t.DeviceName, t.IPAddress, t.UserAgent, t.IsRevoked, t.ExpiresAt, t.RevokedAt, t.CreatedAt,
 	}
 }
@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+/*
+Package shelf keeps the reading position of library shelf entries in step
+with the read history.
+
+Both the library and the chapter read marker change the read history, so
+the rule deriving the shelf position lives here, below both domains. Every
+helper runs inside the caller's transaction.
+*/
+package shelf
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+)
+
+/*
+SyncPosition points a user's shelf entry for a comic at the furthest
+chapter read, and drops its "has new chapters" flag when that chapter is
+the latest published one.
+
+Description: Marking an earlier chapter never moves the position back. A
+comic that is not shelved is left untouched.
+
+Parameters:
+  - context: context.Context
+  - transaction: pgx.Tx (Caller's transaction, after the read history changed)
+  - userID: string
+  - comicID: string
+
+Returns:
+  - error: Update failures
+*/
+func SyncPosition(context context.Context, transaction pgx.Tx, userID, comicID string) error {
+	if err := SyncLastRead(context, transaction, userID, comicID); err != nil {
+		return err
+	}
+	return ClearHasNew(context, transaction, userID, comicID)
+}
+
+// SyncLastRead re-derives the shelf entry's last read chapter from the remaining read history,
+// preferring the highest chapter number. The row sub-query yields NULLs when nothing is left,
+// clearing the markers.
+func SyncLastRead(context context.Context, transaction pgx.Tx, userID, comicID string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET (%s, %s) = (
+			SELECT r.%s, r.%s
+			FROM %s r
+			JOIN %s ch ON ch.%s = r.%s
+			WHERE r.%s = $1 AND ch.%s = $2
+			ORDER BY ch.%s DESC, r.%s DESC
+			LIMIT 1
+		)
+		WHERE %s = $1 AND %s = $2
+	`,
+		schema.LibraryEntry.Table,
+		schema.LibraryEntry.LastReadChapterID, schema.LibraryEntry.LastReadAt,
+		schema.CoreUserRead.ChapterID, schema.CoreUserRead.ReadAt,
+		schema.CoreUserRead.Table,
+		schema.CoreChapter.Table, schema.CoreChapter.ID, schema.CoreUserRead.ChapterID,
+		schema.CoreUserRead.UserID, schema.CoreChapter.ComicID,
+		schema.CoreChapter.Number, schema.CoreUserRead.ReadAt,
+		schema.LibraryEntry.UserID, schema.LibraryEntry.ComicID,
+	)
+
+	if _, err := transaction.Exec(context, query, userID, comicID); err != nil {
+		return fmt.Errorf("postgres: failed to sync library entry position: %w", err)
+	}
+	return nil
+}
+
+// ClearHasNew drops the "has new chapters" flag once the entry's last read chapter
+// is the latest published one. Entries without a last read chapter keep their flag.
+func ClearHasNew(context context.Context, transaction pgx.Tx, userID, comicID string) error {
+	query := fmt.Sprintf(`
+		UPDATE %[1]s e SET %[2]s = FALSE
+		WHERE e.%[3]s = $1 AND e.%[4]s = $2
+		  AND e.%[2]s = TRUE
+		  AND e.%[5]s IS NOT NULL
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM %[6]s nx
+			JOIN %[6]s cur ON cur.%[7]s = e.%[5]s
+			WHERE nx.%[8]s = e.%[4]s
+			  AND nx.%[9]s IS NULL
+			  AND nx.%[10]s IS NOT NULL AND nx.%[10]s <= NOW()
+			  AND nx.%[11]s > cur.%[11]s
+		  )
+	`,
+		schema.LibraryEntry.Table,             // 1
+		schema.LibraryEntry.HasNew,            // 2
+		schema.LibraryEntry.UserID,            // 3
+		schema.LibraryEntry.ComicID,           // 4
+		schema.LibraryEntry.LastReadChapterID, // 5
+		schema.CoreChapter.Table,              // 6
+		schema.CoreChapter.ID,                 // 7
+		schema.CoreChapter.ComicID,            // 8
+		schema.CoreChapter.DeletedAt,          // 9
+		schema.CoreChapter.PublishedAt,        // 10
+		schema.CoreChapter.Number,             // 11
+	)
+
+	if _, err := transaction.Exec(context, query, userID, comicID); err != nil {
+		return fmt.Errorf("postgres: failed to clear new chapter flag: %w", err)
+	}
+	return nil
+}
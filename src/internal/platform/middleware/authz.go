@@ -11,6 +11,8 @@
 package middleware
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
 	"strings"
 
@@ -28,10 +30,28 @@ type TokenVerifier interface {
 	VerifyToken(tokenStr string) (*sec.AuthClaims, error)
 }
 
+// PersonalTokenVerifier defines the interface needed to authenticate personal access tokens.
+type PersonalTokenVerifier interface {
+	// VerifyPersonalToken resolves a token to its owner's claims, recording its use from ipAddress.
+	VerifyPersonalToken(context context.Context, token, ipAddress string) (*sec.AuthClaims, error)
+}
+
+// AccessChecker defines the interface needed to reject revoked access tokens.
+type AccessChecker interface {
+	// CheckAccess returns an application error for revoked tokens and suspended
+	// users; any other error means the denylist could not be reached.
+	CheckAccess(context context.Context, claims *sec.AuthClaims) error
+}
+
 // # Middleware (Authentication)
 
-// Authenticate extracts and verifies the JWT from the Authorization header.
-func Authenticate(verifier TokenVerifier) func(http.Handler) http.Handler {
+// Authenticate extracts and verifies the JWT or personal access token from the Authorization header.
+//
+// Revoked access tokens and suspended users are rejected even while the JWT
+// is valid. The check fails open during an outage: revocation also ends the
+// refresh sessions, so access still stops when the access token expires.
+// Personal access tokens skip it; their lookup already covers both.
+func Authenticate(verifier TokenVerifier, personalTokens PersonalTokenVerifier, access AccessChecker) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
 
@@ -51,9 +71,21 @@ func Authenticate(verifier TokenVerifier) func(http.Handler) http.Handler {
 				return
 			}
 
-			// 3. Token Verification via [TokenVerifier]
+			// 3. Token Verification via [TokenVerifier], or [PersonalTokenVerifier] for prefixed tokens
 			tokenStr := parts[1]
-			claims, err := verifier.VerifyToken(tokenStr)
+			var claims *sec.AuthClaims
+			var err error
+			if strings.HasPrefix(tokenStr, sec.PersonalTokenPrefix) {
+				claims, err = personalTokens.VerifyPersonalToken(request.Context(), tokenStr, RealIP(request))
+
+				// A lookup failure is an outage, not a bad credential
+				if err != nil && !apperr.IsAppError(err) {
+					respond.Error(writer, request, err)
+					return
+				}
+			} else {
+				claims, err = verifier.VerifyToken(tokenStr)
+			}
 
 			// If verification fails (expired or forged), abort with 401 Unauthorized
 			if err != nil {
@@ -61,7 +93,18 @@ func Authenticate(verifier TokenVerifier) func(http.Handler) http.Handler {
 				return
 			}
 
-			// 4. Context Injection for downstream handlers/services
+			// 4. Revocation Check
+			if !claims.IsPersonalToken() {
+				if err := access.CheckAccess(request.Context(), claims); err != nil {
+					if apperr.IsAppError(err) {
+						respond.Error(writer, request, err)
+						return
+					}
+					ctxutil.GetLogger(request.Context()).Warn("auth_revocation_check_failed", slog.Any("error", err))
+				}
+			}
+
+			// 5. Context Injection for downstream handlers/services
 			ctx := ctxutil.WithAuthUser(request.Context(), claims)
 			next.ServeHTTP(writer, request.WithContext(ctx))
 		})
@@ -113,5 +156,66 @@ func RequireRole(role sec.UserRole) func(http.Handler) http.Handler {
 	}
 }
 
+// RequireScope blocks personal access tokens that were not granted the scope.
+// Interactive sessions always pass; role checks still apply separately.
+func RequireScope(scope sec.Scope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			claims := ctxutil.GetAuthUser(request.Context())
+
+			if claims == nil {
+				respond.Error(writer, request, apperr.Unauthorized("Authentication required"))
+				return
+			}
+
+			if !claims.HasScope(scope) {
+				respond.Error(writer, request, apperr.Forbidden("Token is missing the required scope: "+string(scope)))
+				return
+			}
+
+			next.ServeHTTP(writer, request)
+		})
+	}
+}
+
+// RequireScopeByMethod applies [RequireScope] with the read scope for safe methods
+// (GET, HEAD, OPTIONS) and the write scope for everything else.
+func RequireScopeByMethod(read, write sec.Scope) func(http.Handler) http.Handler {
+	readCheck, writeCheck := RequireScope(read), RequireScope(write)
+
+	return func(next http.Handler) http.Handler {
+		readHandler, writeHandler := readCheck(next), writeCheck(next)
+
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			switch request.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				readHandler.ServeHTTP(writer, request)
+			default:
+				writeHandler.ServeHTTP(writer, request)
+			}
+		})
+	}
+}
+
+// RequireSession blocks personal access tokens from credential and account security routes,
+// so a leaked token can never mint tokens, change the password or lock its owner out.
+func RequireSession(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		claims := ctxutil.GetAuthUser(request.Context())
+
+		if claims == nil {
+			respond.Error(writer, request, apperr.Unauthorized("Authentication required"))
+			return
+		}
+
+		if claims.IsPersonalToken() {
+			respond.Error(writer, request, apperr.Forbidden("Personal access tokens cannot be used for this action"))
+			return
+		}
+
+		next.ServeHTTP(writer, request)
+	})
+}
+
 // # Helpers
 // GetAuthUser is now centralized in ctxutil.
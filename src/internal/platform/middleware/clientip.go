@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/taibuivan/yomira/internal/platform/constants"
+	"github.com/taibuivan/yomira/internal/platform/ctxutil"
+)
+
+// # Client Address Resolution
+
+/*
+ClientIP resolves the client address once per request for [RealIP].
+
+Description: Forwarding headers are client-controlled unless a proxy we run
+wrote them, so they are only read when the TCP peer is one of the trusted
+proxies. X-Forwarded-For is then walked from the right, skipping trusted
+hops, and the first other address is the client. Without trusted proxies
+the client is always the TCP peer, so rotating headers cannot dodge the
+per-IP limits or pin them on someone else.
+
+Parameters:
+  - trusted: []netip.Prefix (Reverse proxies in front of the API; may be empty)
+
+Returns:
+  - func(http.Handler) http.Handler: Middleware
+*/
+func ClientIP(trusted []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			ip := resolveClientIP(request, trusted)
+			next.ServeHTTP(writer, request.WithContext(ctxutil.WithClientIP(request.Context(), ip)))
+		})
+	}
+}
+
+// resolveClientIP applies the [ClientIP] rules to a request.
+func resolveClientIP(request *http.Request, trusted []netip.Prefix) string {
+	peer := remoteIP(request)
+
+	peerAddr, err := netip.ParseAddr(peer)
+	if err != nil || !isTrusted(peerAddr, trusted) {
+		return peer
+	}
+
+	// Rightmost hops were appended by our own proxies; the first untrusted one is the client
+	client := peerAddr
+	hops := strings.Split(strings.Join(request.Header.Values(constants.HeaderXForwardedFor), ","), ",")
+	for index := len(hops) - 1; index >= 0; index-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[index]))
+		if err != nil {
+			break
+		}
+		client = hop.Unmap()
+		if !isTrusted(client, trusted) {
+			return client.String()
+		}
+	}
+
+	if client != peerAddr {
+		return client.String()
+	}
+
+	if realIP, err := netip.ParseAddr(strings.TrimSpace(request.Header.Get(constants.HeaderXRealIP))); err == nil {
+		return realIP.Unmap().String()
+	}
+
+	return peer
+}
+
+// remoteIP returns the address of the TCP peer.
+func remoteIP(request *http.Request) string {
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr.Unmap().String()
+	}
+	return host
+}
+
+// isTrusted reports whether an address belongs to a trusted proxy.
+func isTrusted(addr netip.Addr, trusted []netip.Prefix) bool {
+	addr = addr.Unmap()
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
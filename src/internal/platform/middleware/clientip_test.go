@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taibuivan/yomira/internal/platform/middleware"
+)
+
+/*
+TestClientIP ignores forwarding headers from untrusted peers and takes the
+first untrusted hop when the request came through a trusted proxy.
+*/
+func TestClientIP(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	cases := []struct {
+		name      string
+		trusted   []netip.Prefix
+		remote    string
+		forwarded string
+		realIP    string
+		want      string
+	}{
+		{"no proxies configured", nil, "203.0.113.7:5000", "198.51.100.1", "198.51.100.2", "203.0.113.7"},
+		{"untrusted peer", trusted, "203.0.113.7:5000", "198.51.100.1", "198.51.100.2", "203.0.113.7"},
+		{"trusted proxy", trusted, "10.0.0.2:5000", "198.51.100.1", "", "198.51.100.1"},
+		{"spoofed leftmost hop", trusted, "10.0.0.2:5000", "1.2.3.4, 198.51.100.1", "", "198.51.100.1"},
+		{"proxy chain", trusted, "10.0.0.2:5000", "198.51.100.1, 10.0.0.3", "", "198.51.100.1"},
+		{"real ip header", trusted, "10.0.0.2:5000", "", "198.51.100.2", "198.51.100.2"},
+		{"malformed hop", trusted, "10.0.0.2:5000", "garbage", "", "10.0.0.2"},
+		{"ipv6 peer", nil, "[2001:db8::1]:5000", "198.51.100.1", "", "2001:db8::1"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got string
+			handler := middleware.ClientIP(tc.trusted)(http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+				got = middleware.RealIP(request)
+			}))
+
+			request := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
+			request.RemoteAddr = tc.remote
+			if tc.forwarded != "" {
+				request.Header.Set("X-Forwarded-For", tc.forwarded)
+			}
+			if tc.realIP != "" {
+				request.Header.Set("X-Real-IP", tc.realIP)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), request)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -23,13 +23,15 @@ import (
 	"context"
 	"encoding/json"
 	"log/slog"
-	"net"
 	"net/http"
+	"path"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
+	chimw "github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
 	"golang.org/x/time/rate"
 
@@ -80,6 +82,11 @@ func (recorder *statusRecorder) WriteHeader(code int) {
 	recorder.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap exposes the underlying writer to [http.ResponseController] for flushing and deadlines.
+func (recorder *statusRecorder) Unwrap() http.ResponseWriter {
+	return recorder.ResponseWriter
+}
+
 // StructuredLogger logs every request status and performance metrics.
 // It also injects a request-specific logger into the context.
 func StructuredLogger(logger *slog.Logger) func(http.Handler) http.Handler {
@@ -132,6 +139,36 @@ func StructuredLogger(logger *slog.Logger) func(http.Handler) http.Handler {
 	}
 }
 
+// # Request Deadlines
+
+/*
+Timeout applies chi's request deadline to every route but the listed streams.
+
+Description: Long-lived responses such as Server-Sent Events cannot finish
+within a global deadline; they bound each write themselves and end with
+the client connection or the application context.
+
+Parameters:
+  - timeout: time.Duration
+  - streamPaths: ...string (Clean request paths exempt from the deadline)
+*/
+func Timeout(timeout time.Duration, streamPaths ...string) func(http.Handler) http.Handler {
+	withDeadline := chimw.Timeout(timeout)
+
+	return func(next http.Handler) http.Handler {
+		bounded := withDeadline(next)
+
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			// CleanPath runs later in the chain, so "//" and trailing slash variants are folded here
+			if slices.Contains(streamPaths, path.Clean(request.URL.Path)) {
+				next.ServeHTTP(writer, request)
+				return
+			}
+			bounded.ServeHTTP(writer, request)
+		})
+	}
+}
+
 // # Rate Limiting
 
 type rateLimitClient struct {
@@ -292,21 +329,16 @@ func CORS(cfg AppConfig) func(http.Handler) http.Handler {
 
 // # Middleware Helpers
 
-// RealIP extracts client IP, respecting common proxy headers.
+// RealIP returns the client IP resolved by [ClientIP].
+// Proxy headers are honoured only from trusted proxies; without the middleware
+// the direct connection's address is used.
 func RealIP(request *http.Request) string {
-
-	// Check standard proxy headers first
-	if ip := request.Header.Get(constants.HeaderXRealIP); ip != "" {
+	if ip := ctxutil.GetClientIP(request.Context()); ip != "" {
 		return ip
 	}
 
-	if forwarded := request.Header.Get(constants.HeaderXForwardedFor); forwarded != "" {
-		return strings.TrimSpace(strings.Split(forwarded, ",")[0])
-	}
-
 	// Fallback to the direct connection's address
-	host, _, _ := net.SplitHostPort(request.RemoteAddr)
-	return host
+	return remoteIP(request)
 }
 
 // writeError outputs a simple JSON error payload.
@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taibuivan/yomira/internal/platform/middleware"
+)
+
+/*
+TestTimeout exempts stream paths from the deadline, including the unclean
+spellings that still reach the stream route.
+*/
+func TestTimeout(t *testing.T) {
+	const stream = "/api/v1/me/notifications/stream"
+
+	cases := []struct {
+		name         string
+		path         string
+		wantDeadline bool
+	}{
+		{"regular route", "/api/v1/me/notifications", true},
+		{"stream", stream, false},
+		{"double slash", "/api/v1//me/notifications/stream", false},
+		{"trailing slash", stream + "/", false},
+		{"dot segment", "/api/v1/me/./notifications/stream", false},
+		{"prefix only", stream + "s", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var hasDeadline bool
+			handler := middleware.Timeout(time.Minute, stream)(http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+				_, hasDeadline = request.Context().Deadline()
+			}))
+
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			request.URL.Path = tc.path
+
+			handler.ServeHTTP(httptest.NewRecorder(), request)
+			assert.Equal(t, tc.wantDeadline, hasDeadline)
+		})
+	}
+}
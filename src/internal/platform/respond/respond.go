@@ -22,8 +22,10 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
 
 	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/constants"
 	"github.com/taibuivan/yomira/internal/platform/ctxutil"
 	"github.com/taibuivan/yomira/pkg/pagination"
 )
@@ -41,6 +43,12 @@ type PaginatedEnvelope struct {
 	Meta pagination.Meta `json:"meta"`
 }
 
+// CursorEnvelope is the JSON envelope for cursor-paginated list responses.
+type CursorEnvelope struct {
+	Data interface{}           `json:"data"`
+	Meta pagination.CursorMeta `json:"meta"`
+}
+
 // ErrorEnvelope is the JSON envelope for error responses.
 type ErrorEnvelope struct {
 	Error   string              `json:"error"`
@@ -92,6 +100,17 @@ func Created(writer http.ResponseWriter, data interface{}) {
 	JSON(writer, http.StatusCreated, SuccessEnvelope{Data: data})
 }
 
+/*
+Accepted writes a 202 Accepted response for work that continues in the background.
+
+Parameters:
+  - writer: http.ResponseWriter
+  - data: interface{} (A handle to track the accepted work)
+*/
+func Accepted(writer http.ResponseWriter, data interface{}) {
+	JSON(writer, http.StatusAccepted, SuccessEnvelope{Data: data})
+}
+
 /*
 Paginated writes a 200 OK response with paginated data and a metadata block.
 
@@ -104,6 +123,18 @@ func Paginated(writer http.ResponseWriter, data interface{}, metadata pagination
 	JSON(writer, http.StatusOK, PaginatedEnvelope{Data: data, Meta: metadata})
 }
 
+/*
+CursorPaginated writes a 200 OK response with one page of a cursor-paginated list.
+
+Parameters:
+  - writer: http.ResponseWriter
+  - data: interface{} (Slice of items)
+  - metadata: pagination.CursorMeta (Limit and the cursor of the next page)
+*/
+func CursorPaginated(writer http.ResponseWriter, data interface{}, metadata pagination.CursorMeta) {
+	JSON(writer, http.StatusOK, CursorEnvelope{Data: data, Meta: metadata})
+}
+
 /*
 NoContent writes a 204 No Content response.
 
@@ -169,6 +200,11 @@ func Error(writer http.ResponseWriter, request *http.Request, err error) {
 		)
 	}
 
+	// Tell throttled clients when to come back
+	if appError.RetryAfter > 0 {
+		writer.Header().Set(constants.HeaderRetryAfter, strconv.Itoa(appError.RetryAfter))
+	}
+
 	// Write the final standardized JSON error payload
 	JSON(writer, appError.HTTPStatus, ErrorEnvelope{
 		Error:   appError.Message,
@@ -9,7 +9,8 @@ role-based access control (RBAC) levels.
 
 Core Components:
 
-  - JWT: RS256-signed tokens for stateless authentication.
+  - JWT: RS256-signed tokens for stateless authentication, with kid-selected
+    verification keys published as a JWKS for zero-downtime rotation.
   - Hash: Secure password derivation using Bcrypt/Argon2.
   - Role: Hierarchy logic for privilege escalation checks.
 
@@ -22,9 +23,12 @@ import (
 	"crypto/rsa"
 	"fmt"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/taibuivan/yomira/pkg/uuid"
 )
 
 // # Identity Claims
@@ -37,6 +41,10 @@ type AuthClaims struct {
 	UserID   string `json:"uid"`
 	Username string `json:"unm"`
 	Role     string `json:"rol"`
+
+	// Personal access token identity; never part of a JWT, empty for interactive sessions.
+	TokenID string  `json:"-"`
+	Scopes  []Scope `json:"-"`
 }
 
 // IsAdmin checks if the user has administrative privileges.
@@ -44,22 +52,51 @@ func (c *AuthClaims) IsAdmin() bool {
 	return UserRole(c.Role) == RoleAdmin
 }
 
+// IsPersonalToken reports whether the request is authenticated by a personal access token.
+func (c *AuthClaims) IsPersonalToken() bool {
+	return c.TokenID != ""
+}
+
+// HasScope reports whether the credential may act within the scope.
+// Interactive sessions are unrestricted; personal access tokens only hold their granted scopes.
+func (c *AuthClaims) HasScope(scope Scope) bool {
+	return !c.IsPersonalToken() || slices.Contains(c.Scopes, scope)
+}
+
 // # Token Provider (RSA)
 
+/*
+KeyConfig locates the RSA keys used to sign and verify access tokens.
+
+Description: Tokens are always signed with the active private key. Every
+additional public key is accepted for verification and published in the
+JWKS, which is what makes rotation seamless:
+  - "path": a staged key, accepted indefinitely (publish before signing with it)
+  - "path@2026-10-16T09:00:00Z": a retired key, accepted until the timestamp plus Overlap
+*/
+type KeyConfig struct {
+	PrivateKeyPath     string        // Active signing key
+	PublicKeyPath      string        // Public half of the active key; must match PrivateKeyPath
+	AdditionalKeySpecs []string      // Staged or retired public keys (see [ParseKeySpec])
+	Overlap            time.Duration // How long a retired key stays valid after its retirement time
+}
+
 // TokenService handles generation and verification of JWT tokens using RS256.
 type TokenService struct {
-	privateKey *rsa.PrivateKey
-	publicKey  *rsa.PublicKey
-	issuer     string
+	signingKey   *rsa.PrivateKey
+	signingKeyID string
+	keys         map[string]VerificationKey // Keyed by kid
+	overlap      time.Duration
+	issuer       string
 }
 
 // NewTokenService creates a new TokenService.
-func NewTokenService(privateKeyPath, publicKeyPath, issuer string) (*TokenService, error) {
+func NewTokenService(keyConfig KeyConfig, issuer string) (*TokenService, error) {
 
 	// Load the Private Key for signing
-	privateKeyData, err := os.ReadFile(privateKeyPath)
+	privateKeyData, err := os.ReadFile(keyConfig.PrivateKeyPath)
 	if err != nil {
-		return nil, fmt.Errorf("auth: failed to read private key from %s: %w", privateKeyPath, err)
+		return nil, fmt.Errorf("auth: failed to read private key from %s: %w", keyConfig.PrivateKeyPath, err)
 	}
 
 	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privateKeyData)
@@ -67,23 +104,45 @@ func NewTokenService(privateKeyPath, publicKeyPath, issuer string) (*TokenServic
 		return nil, fmt.Errorf("auth: failed to parse private key: %w", err)
 	}
 
-	// Load the Public Key for verification
-	publicKeyData, err := os.ReadFile(publicKeyPath)
+	// Load the Public Key and make sure it belongs to the signing key
+	publicKey, err := loadPublicKey(keyConfig.PublicKeyPath)
 	if err != nil {
-		return nil, fmt.Errorf("auth: failed to read public key from %s: %w", publicKeyPath, err)
+		return nil, err
 	}
 
-	// Parse the public key
-	publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicKeyData)
-	if err != nil {
-		return nil, fmt.Errorf("auth: failed to parse public key: %w", err)
+	if !publicKey.Equal(&privateKey.PublicKey) {
+		return nil, fmt.Errorf("auth: public key %s does not match the private key", keyConfig.PublicKeyPath)
+	}
+
+	service := &TokenService{
+		signingKey:   privateKey,
+		signingKeyID: KeyID(publicKey),
+		keys:         make(map[string]VerificationKey, 1+len(keyConfig.AdditionalKeySpecs)),
+		overlap:      keyConfig.Overlap,
+		issuer:       issuer,
 	}
 
-	return &TokenService{
-		privateKey: privateKey,
-		publicKey:  publicKey,
-		issuer:     issuer,
-	}, nil
+	// Staged and retired keys; the active key always wins a duplicate kid
+	for _, spec := range keyConfig.AdditionalKeySpecs {
+		path, retiredAt := ParseKeySpec(spec)
+
+		additionalKey, err := loadPublicKey(path)
+		if err != nil {
+			return nil, err
+		}
+
+		keyID := KeyID(additionalKey)
+		service.keys[keyID] = VerificationKey{ID: keyID, PublicKey: additionalKey, RetiredAt: retiredAt}
+	}
+
+	service.keys[service.signingKeyID] = VerificationKey{ID: service.signingKeyID, PublicKey: publicKey}
+
+	return service, nil
+}
+
+// SigningKeyID returns the kid stamped on newly issued tokens.
+func (service *TokenService) SigningKeyID() string {
+	return service.signingKeyID
 }
 
 // GenerateAccessToken creates a new JWT access token for a user.
@@ -91,9 +150,10 @@ func (service *TokenService) GenerateAccessToken(userID, username, role string,
 
 	currentTime := time.Now()
 
-	// Construct the claims with standard Registered claims (iss, sub, iat, exp)
+	// Construct the claims with standard Registered claims (jti, iss, sub, iat, exp)
 	claims := AuthClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New(), // Lets a single token be revoked before it expires
 			Subject:   userID,
 			Issuer:    service.issuer,
 			IssuedAt:  jwt.NewNumericDate(currentTime),
@@ -104,9 +164,10 @@ func (service *TokenService) GenerateAccessToken(userID, username, role string,
 		Role:     role,
 	}
 
-	// Sign the token using the RS256 algorithm (Asymmetric)
+	// Sign the token using the RS256 algorithm (Asymmetric), naming the key for verifiers
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-	signedToken, err := token.SignedString(service.privateKey)
+	token.Header["kid"] = service.signingKeyID
+	signedToken, err := token.SignedString(service.signingKey)
 
 	if err != nil {
 		return "", fmt.Errorf("auth: failed to sign token: %w", err)
@@ -126,7 +187,7 @@ func (service *TokenService) VerifyToken(tokenString string) (*AuthClaims, error
 			return nil, fmt.Errorf("auth: unexpected signing method: %v", token.Header["alg"])
 		}
 
-		return service.publicKey, nil
+		return service.verificationKey(token.Header["kid"], time.Now())
 	})
 
 	// Handle parsing/validation errors (e.g. expired, malformed)
@@ -142,3 +203,44 @@ func (service *TokenService) VerifyToken(tokenString string) (*AuthClaims, error
 
 	return claims, nil
 }
+
+/*
+verificationKey selects the public key named by a token header.
+
+Description: Tokens without a kid predate key rotation and were signed with
+the active key. A retired key is refused once its overlap window has passed.
+*/
+func (service *TokenService) verificationKey(kid any, now time.Time) (*rsa.PublicKey, error) {
+	if kid == nil {
+		return service.signingKey.Public().(*rsa.PublicKey), nil
+	}
+
+	keyID, _ := kid.(string)
+	key, ok := service.keys[keyID]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	if !key.IsUsable(now, service.overlap) {
+		return nil, ErrKeyRetired
+	}
+
+	return key.PublicKey, nil
+}
+
+// # Internal Helpers
+
+// loadPublicKey reads a PEM encoded RSA public key.
+func loadPublicKey(path string) (*rsa.PublicKey, error) {
+	publicKeyData, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("auth: failed to read public key from %s: %w", path, err)
+	}
+
+	publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicKeyData)
+	if err != nil {
+		return nil, fmt.Errorf("auth: failed to parse public key %s: %w", path, err)
+	}
+
+	return publicKey, nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package sec
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// # Verification Keys
+
+var (
+	// ErrUnknownKey is returned when a token names a kid that is not in the key set.
+	ErrUnknownKey = errors.New("sec: unknown signing key")
+
+	// ErrKeyRetired is returned when a token is signed by a key past its overlap window.
+	ErrKeyRetired = errors.New("sec: signing key retired")
+)
+
+// VerificationKey is a public key accepted for access token signatures.
+type VerificationKey struct {
+	ID        string // RFC 7638 thumbprint, stamped as the token "kid"
+	PublicKey *rsa.PublicKey
+	RetiredAt time.Time // Zero for the active and staged keys
+}
+
+// IsUsable reports whether the key still verifies tokens at the given time.
+func (key VerificationKey) IsUsable(now time.Time, overlap time.Duration) bool {
+	return key.RetiredAt.IsZero() || now.Before(key.RetiredAt.Add(overlap))
+}
+
+/*
+KeyID derives the kid of an RSA public key.
+
+Description: The RFC 7638 JWK thumbprint is deterministic, so every instance
+names the same key identically without any extra configuration.
+
+Parameters:
+  - publicKey: *rsa.PublicKey
+
+Returns:
+  - string: Base64url SHA-256 thumbprint
+*/
+func KeyID(publicKey *rsa.PublicKey) string {
+	jwk := publicJWK("", publicKey)
+
+	// Required members only, in lexicographic order, without whitespace
+	canonical := fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, jwk.E, jwk.N)
+	digest := sha256.Sum256([]byte(canonical))
+
+	return base64.RawURLEncoding.EncodeToString(digest[:])
+}
+
+/*
+ParseKeySpec splits an additional key entry into its path and retirement time.
+
+Description: The text after the last '@' is a retirement time only when it
+parses as RFC 3339; otherwise the whole entry is the path, so paths that
+contain '@' load as staged keys.
+
+Parameters:
+  - spec: string ("path" or "path@RFC3339")
+
+Returns:
+  - string: Public key path
+  - time.Time: Retirement time (zero for a staged key)
+*/
+func ParseKeySpec(spec string) (string, time.Time) {
+	spec = strings.TrimSpace(spec)
+
+	separator := strings.LastIndex(spec, "@")
+	if separator < 0 {
+		return spec, time.Time{}
+	}
+
+	retiredAt, err := time.Parse(time.RFC3339, spec[separator+1:])
+	if err != nil {
+		return spec, time.Time{}
+	}
+
+	return spec[:separator], retiredAt
+}
+
+// # JSON Web Key Set
+
+// JWK is the public RSA signing key representation of RFC 7517.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+}
+
+// JWKSet is the document served at /.well-known/jwks.json.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+/*
+JWKS lists the keys a verifier must accept right now.
+
+Description: The active key comes first, then staged and retired keys by kid.
+Retired keys drop out on their own once their overlap window has passed.
+
+Returns:
+  - JWKSet: Public keys only
+*/
+func (service *TokenService) JWKS() JWKSet {
+	now := time.Now()
+
+	others := make([]VerificationKey, 0, len(service.keys))
+	for _, key := range service.keys {
+		if key.ID != service.signingKeyID && key.IsUsable(now, service.overlap) {
+			others = append(others, key)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i].ID < others[j].ID })
+
+	set := JWKSet{Keys: make([]JWK, 0, 1+len(others))}
+	set.Keys = append(set.Keys, publicJWK(service.signingKeyID, service.keys[service.signingKeyID].PublicKey))
+	for _, key := range others {
+		set.Keys = append(set.Keys, publicJWK(key.ID, key.PublicKey))
+	}
+
+	return set
+}
+
+// publicJWK encodes an RSA public key as an RS256 signing JWK.
+func publicJWK(keyID string, publicKey *rsa.PublicKey) JWK {
+	return JWK{
+		KeyType:   "RSA",
+		Use:       "sig",
+		Algorithm: "RS256",
+		KeyID:     keyID,
+		N:         base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package sec_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taibuivan/yomira/internal/platform/sec"
+)
+
+// # Fixtures
+
+// keyFiles is an RSA keypair written as PEM files.
+type keyFiles struct {
+	privatePath string
+	publicPath  string
+	publicKey   *rsa.PublicKey
+}
+
+func writeKeyPair(t *testing.T, name string) keyFiles {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	publicDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	files := keyFiles{
+		privatePath: filepath.Join(dir, name+".pem"),
+		publicPath:  filepath.Join(dir, name+".pub.pem"),
+		publicKey:   &key.PublicKey,
+	}
+
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+	require.NoError(t, os.WriteFile(files.privatePath, privatePEM, 0o600))
+	require.NoError(t, os.WriteFile(files.publicPath, publicPEM, 0o600))
+
+	return files
+}
+
+func newTokenService(t *testing.T, active keyFiles, additional ...string) *sec.TokenService {
+	service, err := sec.NewTokenService(sec.KeyConfig{
+		PrivateKeyPath:     active.privatePath,
+		PublicKeyPath:      active.publicPath,
+		AdditionalKeySpecs: additional,
+		Overlap:            time.Hour,
+	}, "yomira-test")
+	require.NoError(t, err)
+	return service
+}
+
+// # Tests
+
+/*
+TestTokenService_Rotation verifies that tokens signed by the previous key stay
+valid during the overlap window and that both keys are published.
+*/
+func TestTokenService_Rotation(t *testing.T) {
+	oldKey := writeKeyPair(t, "old")
+	newKey := writeKeyPair(t, "new")
+
+	before := newTokenService(t, oldKey, newKey.publicPath)
+	token, err := before.GenerateAccessToken("user-1", "reader", "member", time.Minute)
+	require.NoError(t, err)
+
+	retiredAt := time.Now().Add(-10 * time.Minute).UTC().Format(time.RFC3339)
+	after := newTokenService(t, newKey, oldKey.publicPath+"@"+retiredAt)
+
+	claims, err := after.VerifyToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID)
+
+	jwks := after.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	assert.Equal(t, sec.KeyID(newKey.publicKey), jwks.Keys[0].KeyID)
+	assert.Equal(t, sec.KeyID(oldKey.publicKey), jwks.Keys[1].KeyID)
+	assert.Equal(t, after.SigningKeyID(), jwks.Keys[0].KeyID)
+}
+
+/*
+TestTokenService_RetiredKeyExpires refuses tokens of a key past its overlap
+window and stops publishing it.
+*/
+func TestTokenService_RetiredKeyExpires(t *testing.T) {
+	oldKey := writeKeyPair(t, "old")
+	newKey := writeKeyPair(t, "new")
+
+	token, err := newTokenService(t, oldKey).GenerateAccessToken("user-1", "reader", "member", 24*time.Hour)
+	require.NoError(t, err)
+
+	retiredAt := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	after := newTokenService(t, newKey, oldKey.publicPath+"@"+retiredAt)
+
+	_, err = after.VerifyToken(token)
+	assert.ErrorIs(t, err, sec.ErrKeyRetired)
+	assert.Len(t, after.JWKS().Keys, 1)
+}
+
+/*
+TestTokenService_UnknownKey rejects tokens signed by a key outside the set.
+*/
+func TestTokenService_UnknownKey(t *testing.T) {
+	foreign := newTokenService(t, writeKeyPair(t, "foreign"))
+	token, err := foreign.GenerateAccessToken("user-1", "reader", "member", time.Minute)
+	require.NoError(t, err)
+
+	_, err = newTokenService(t, writeKeyPair(t, "ours")).VerifyToken(token)
+	assert.ErrorIs(t, err, sec.ErrUnknownKey)
+}
+
+/*
+TestNewTokenService_MismatchedPublicKey refuses a public key that does not
+belong to the signing key.
+*/
+func TestNewTokenService_MismatchedPublicKey(t *testing.T) {
+	signing := writeKeyPair(t, "signing")
+	other := writeKeyPair(t, "other")
+
+	_, err := sec.NewTokenService(sec.KeyConfig{
+		PrivateKeyPath: signing.privatePath,
+		PublicKeyPath:  other.publicPath,
+		Overlap:        time.Hour,
+	}, "yomira-test")
+	assert.Error(t, err)
+}
+
+/*
+TestParseKeySpec covers staged keys, retired keys, and paths containing '@'
+whose suffix is not a timestamp.
+*/
+func TestParseKeySpec(t *testing.T) {
+	path, retiredAt := sec.ParseKeySpec(" ./keys/next.pem ")
+	assert.Equal(t, "./keys/next.pem", path)
+	assert.True(t, retiredAt.IsZero())
+
+	path, retiredAt = sec.ParseKeySpec("./keys/old.pem@2026-10-16T09:00:00Z")
+	assert.Equal(t, "./keys/old.pem", path)
+	assert.Equal(t, time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC), retiredAt)
+
+	path, retiredAt = sec.ParseKeySpec("/run/secrets/jwt@prod.pem")
+	assert.Equal(t, "/run/secrets/jwt@prod.pem", path)
+	assert.True(t, retiredAt.IsZero())
+
+	path, retiredAt = sec.ParseKeySpec("/run/secrets/jwt@prod.pem@2026-10-16T09:00:00Z")
+	assert.Equal(t, "/run/secrets/jwt@prod.pem", path)
+	assert.Equal(t, time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC), retiredAt)
+}
@@ -24,6 +24,11 @@ const (
 
 // # Role Hierarchy
 
+// IsValid reports whether the role is one of the known roles.
+func (r UserRole) IsValid() bool {
+	return r.level() > 0
+}
+
 // AtLeast checks if the current role meets or exceeds the required target role.
 func (r UserRole) AtLeast(target UserRole) bool {
 	return r.level() >= target.level()
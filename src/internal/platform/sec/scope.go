@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package sec
+
+import "slices"
+
+// # Personal Access Token Scopes
+
+// PersonalTokenPrefix marks personal access tokens so they are never mistaken for JWTs
+// and can be found by secret scanners.
+const PersonalTokenPrefix = "ymr_pat_"
+
+// Scope restricts what a personal access token may do on behalf of its owner.
+type Scope string
+
+const (
+	// Read the owner's private profile and preferences
+	ScopeProfileRead Scope = "profile:read"
+
+	// Update the owner's profile and preferences
+	ScopeProfileWrite Scope = "profile:write"
+
+	// Read the shelf, custom lists, reading progress and history
+	ScopeLibraryRead Scope = "library:read"
+
+	// Manage the shelf, custom lists and reading progress
+	ScopeLibraryWrite Scope = "library:write"
+
+	// Manage comic, author and artist metadata (role permitting)
+	ScopeComicsWrite Scope = "comics:write"
+
+	// Upload and manage chapters (role permitting)
+	ScopeChaptersWrite Scope = "chapters:write"
+
+	// Create scanlation groups, manage members and follows
+	ScopeGroupsWrite Scope = "groups:write"
+
+	// Follow users and rate comics
+	ScopeSocialWrite Scope = "social:write"
+)
+
+// allScopes lists every scope in display order.
+var allScopes = []Scope{
+	ScopeProfileRead,
+	ScopeProfileWrite,
+	ScopeLibraryRead,
+	ScopeLibraryWrite,
+	ScopeComicsWrite,
+	ScopeChaptersWrite,
+	ScopeGroupsWrite,
+	ScopeSocialWrite,
+}
+
+// AllScopes returns every scope a personal access token may be granted.
+func AllScopes() []Scope {
+	return slices.Clone(allScopes)
+}
+
+// IsValid reports whether the scope is one of the known scopes.
+func (s Scope) IsValid() bool {
+	return slices.Contains(allScopes, s)
+}
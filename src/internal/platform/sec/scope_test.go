@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package sec_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taibuivan/yomira/internal/platform/sec"
+)
+
+/*
+TestAuthClaims_HasScope keeps interactive sessions unrestricted and limits
+personal access tokens to their granted scopes.
+*/
+func TestAuthClaims_HasScope(t *testing.T) {
+	session := &sec.AuthClaims{UserID: "user-1"}
+	assert.False(t, session.IsPersonalToken())
+	assert.True(t, session.HasScope(sec.ScopeChaptersWrite))
+
+	token := &sec.AuthClaims{UserID: "user-1", TokenID: "token-1", Scopes: []sec.Scope{sec.ScopeLibraryRead}}
+	assert.True(t, token.IsPersonalToken())
+	assert.True(t, token.HasScope(sec.ScopeLibraryRead))
+	assert.False(t, token.HasScope(sec.ScopeLibraryWrite))
+
+	scopeless := &sec.AuthClaims{UserID: "user-1", TokenID: "token-2"}
+	assert.False(t, scopeless.HasScope(sec.ScopeLibraryRead))
+}
+
+/*
+TestScope_IsValid accepts only catalogued scopes.
+*/
+func TestScope_IsValid(t *testing.T) {
+	for _, scope := range sec.AllScopes() {
+		assert.True(t, scope.IsValid(), string(scope))
+	}
+
+	assert.False(t, sec.Scope("admin").IsValid())
+	assert.False(t, sec.Scope("").IsValid())
+}
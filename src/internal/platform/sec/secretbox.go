@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package sec
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// # Secret Encryption (AES-256-GCM)
+
+// ErrSecretBoxOpen is returned when a sealed value is malformed or was not sealed with this key.
+var ErrSecretBoxOpen = errors.New("sec: failed to open sealed value")
+
+/*
+SecretBox encrypts small secrets that must be readable again (e.g. TOTP seeds).
+
+Description: Unlike passwords and tokens, which are hashed, these values are
+needed in plain form to verify codes. They are sealed with AES-256-GCM under
+a key derived from the server secret so a database leak alone does not
+expose them.
+*/
+type SecretBox struct {
+	aead cipher.AEAD
+}
+
+// NewSecretBox derives a 256-bit key from the given server secret.
+func NewSecretBox(secret string) (*SecretBox, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("sec: secret box requires a non-empty secret")
+	}
+
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("sec: failed to initialize cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("sec: failed to initialize gcm: %w", err)
+	}
+
+	return &SecretBox{aead: aead}, nil
+}
+
+// Seal encrypts a value into a base64 string (random nonce prepended).
+func (box *SecretBox) Seal(plaintext string) (string, error) {
+	nonce := make([]byte, box.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("sec: failed to generate nonce: %w", err)
+	}
+
+	sealed := box.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value produced by [SecretBox.Seal].
+func (box *SecretBox) Open(sealed string) (string, error) {
+	raw, err := base64.RawStdEncoding.DecodeString(sealed)
+	if err != nil || len(raw) < box.aead.NonceSize() {
+		return "", ErrSecretBoxOpen
+	}
+
+	nonce, ciphertext := raw[:box.aead.NonceSize()], raw[box.aead.NonceSize():]
+	plaintext, err := box.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrSecretBoxOpen
+	}
+
+	return string(plaintext), nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package sec
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// # One-Time Passwords (RFC 4226 / RFC 6238)
+
+const (
+	// TOTPPeriod is the lifetime of a single code (authenticator app default).
+	TOTPPeriod = 30 * time.Second
+
+	// TOTPDigits is the number of digits of a code.
+	TOTPDigits = 6
+
+	// TOTPSecretLength is the byte length of a shared secret (160 bits, as recommended by RFC 4226).
+	TOTPSecretLength = 20
+)
+
+// totpEncoding is the unpadded base32 alphabet expected by authenticator apps.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret creates a random shared secret encoded in base32.
+func GenerateTOTPSecret() (string, error) {
+	bytes := make([]byte, TOTPSecretLength)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("sec: failed to generate totp secret: %w", err)
+	}
+	return totpEncoding.EncodeToString(bytes), nil
+}
+
+/*
+TOTPCode computes the code of a base32 secret at the given instant.
+
+Parameters:
+  - secret: string (Base32, padding and case are ignored)
+  - at: time.Time
+
+Returns:
+  - string: Zero-padded code of [TOTPDigits] digits
+  - error: Malformed secret
+*/
+func TOTPCode(secret string, at time.Time) (string, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, totpStep(at), TOTPDigits), nil
+}
+
+/*
+ValidateTOTP checks a code against the current step and its neighbours.
+
+Description: A skew of 1 accepts the previous and next 30-second window to
+absorb clock drift. The matched step is returned so callers can reject a
+code that was already used (replay protection).
+
+Parameters:
+  - secret: string (Base32)
+  - code: string
+  - at: time.Time
+  - skew: int (Number of steps accepted on each side)
+
+Returns:
+  - int64: Matched time step
+  - bool: Whether the code is valid
+*/
+func ValidateTOTP(secret, code string, at time.Time, skew int) (int64, bool) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil || len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	current := int64(totpStep(at))
+	for offset := -skew; offset <= skew; offset++ {
+		step := current + int64(offset)
+		if step < 0 {
+			continue
+		}
+
+		expected := hotp(key, uint64(step), TOTPDigits)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+/*
+TOTPProvisioningURI builds the otpauth:// URI rendered as a QR code during enrolment.
+
+Parameters:
+  - issuer: string (e.g. "Yomira")
+  - account: string (e.g. the user's email)
+  - secret: string (Base32)
+
+Returns:
+  - string: Key URI understood by authenticator apps
+*/
+func TOTPProvisioningURI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(TOTPDigits))
+	query.Set("period", fmt.Sprint(int(TOTPPeriod/time.Second)))
+
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// # Internal Helpers
+
+// totpStep converts an instant into its RFC 6238 time step.
+func totpStep(at time.Time) uint64 {
+	return uint64(at.Unix()) / uint64(TOTPPeriod/time.Second)
+}
+
+// decodeTOTPSecret accepts secrets as typed by humans (lowercase, spaces, padding).
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := totpEncoding.DecodeString(strings.TrimRight(normalized, "="))
+	if err != nil || len(key) == 0 {
+		return nil, fmt.Errorf("sec: invalid totp secret")
+	}
+	return key, nil
+}
+
+// hotp implements the RFC 4226 HMAC-SHA1 one-time password with dynamic truncation.
+func hotp(key []byte, counter uint64, digits int) string {
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	binaryCode := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for range digits {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, binaryCode%modulo)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package sec_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taibuivan/yomira/internal/platform/sec"
+)
+
+// rfcSecret is the SHA-1 seed "12345678901234567890" from RFC 6238 Appendix B, in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+/*
+TestTOTPCode_RFC6238 checks the SHA-1 reference vectors of RFC 6238.
+The RFC lists 8-digit codes; a 6-digit code is their last 6 digits.
+*/
+func TestTOTPCode_RFC6238(t *testing.T) {
+	tests := []struct {
+		unix     int64
+		expected string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		code, err := sec.TOTPCode(rfcSecret, time.Unix(tt.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, code, "unix time %d", tt.unix)
+	}
+}
+
+/*
+TestValidateTOTP_Skew verifies drift tolerance and the returned time step.
+*/
+func TestValidateTOTP_Skew(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+
+	previous, err := sec.TOTPCode(rfcSecret, now.Add(-sec.TOTPPeriod))
+	require.NoError(t, err)
+
+	// 1. Accepted within a skew of one step
+	step, ok := sec.ValidateTOTP(rfcSecret, previous, now, 1)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1111111111/30-1), step)
+
+	// 2. Rejected without skew
+	_, ok = sec.ValidateTOTP(rfcSecret, previous, now, 0)
+	assert.False(t, ok)
+
+	// 3. Malformed input
+	_, ok = sec.ValidateTOTP(rfcSecret, "12345", now, 1)
+	assert.False(t, ok)
+	_, ok = sec.ValidateTOTP("not base32!", "123456", now, 1)
+	assert.False(t, ok)
+}
+
+/*
+TestTOTPSecret_RoundTrip ensures generated secrets are usable and tolerate
+human formatting.
+*/
+func TestTOTPSecret_RoundTrip(t *testing.T) {
+	secret, err := sec.GenerateTOTPSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	now := time.Now()
+	code, err := sec.TOTPCode(secret, now)
+	require.NoError(t, err)
+
+	_, ok := sec.ValidateTOTP(strings.ToLower(secret), code, now, 0)
+	assert.True(t, ok)
+
+	uri := sec.TOTPProvisioningURI("Yomira", "reader@example.com", secret)
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Yomira:reader@example.com?"))
+	assert.Contains(t, uri, "secret="+secret)
+}
+
+/*
+TestSecretBox_SealOpen checks encryption round trips and key separation.
+*/
+func TestSecretBox_SealOpen(t *testing.T) {
+	box, err := sec.NewSecretBox("server-secret")
+	require.NoError(t, err)
+
+	sealed, err := box.Seal(rfcSecret)
+	require.NoError(t, err)
+	assert.NotContains(t, sealed, rfcSecret)
+
+	opened, err := box.Open(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, rfcSecret, opened)
+
+	other, err := sec.NewSecretBox("another-secret")
+	require.NoError(t, err)
+	_, err = other.Open(sealed)
+	assert.ErrorIs(t, err, sec.ErrSecretBoxOpen)
+}
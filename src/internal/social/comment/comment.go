@@ -0,0 +1,254 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+/*
+Package comment implements the discussion threads under comics and chapters.
+
+Core Responsibility:
+
+  - Threads: Top-level comments on a comic or a chapter, with nested replies
+    up to [MaxDepth].
+  - Voting: One up or down vote per user and comment, mirrored in the
+    denormalised upvote and downvote counters.
+  - Listing: "new" and "top" orders with cursor pagination.
+  - Moderation: A word and link [Filter] holds suspicious comments for a
+    moderator queue; per-user [PostingLimits] slow down floods.
+
+Comments are never removed physically; deleted comments stay in the thread
+with their body hidden so replies keep their context. Held and hidden
+comments remain visible to their author only.
+*/
+package comment
+
+import (
+	"time"
+
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Domain Enums
+
+// Sort orders a comment list.
+type Sort string
+
+const (
+	// SortNew lists the most recent comments first.
+	SortNew Sort = "new"
+
+	// SortTop lists the comments with the best score (upvotes - downvotes) first.
+	SortTop Sort = "top"
+)
+
+// IsValid reports whether s is a recognised [Sort] value.
+func (s Sort) IsValid() bool {
+	return s == SortNew || s == SortTop
+}
+
+// Vote is the opinion of a user on a comment.
+type Vote int
+
+const (
+	// VoteUp counts towards the upvotes of a comment.
+	VoteUp Vote = 1
+
+	// VoteDown counts towards the downvotes of a comment.
+	VoteDown Vote = -1
+)
+
+// IsValid reports whether v is a recognised [Vote] value.
+func (v Vote) IsValid() bool {
+	return v == VoteUp || v == VoteDown
+}
+
+// Decision is the verdict of a moderator on a comment.
+type Decision string
+
+const (
+	// DecisionApprove publishes the comment.
+	DecisionApprove Decision = "approve"
+
+	// DecisionReject soft-deletes the comment.
+	DecisionReject Decision = "reject"
+
+	// DecisionHide keeps the comment visible to its author only (shadow-hiding).
+	DecisionHide Decision = "hide"
+)
+
+// IsValid reports whether d is a recognised [Decision] value.
+func (d Decision) IsValid() bool {
+	return d == DecisionApprove || d == DecisionReject || d == DecisionHide
+}
+
+// Flag names a reason the [Filter] held a comment for review.
+type Flag string
+
+const (
+	// FlagBannedWord marks a body containing a banned word or phrase.
+	FlagBannedWord Flag = "banned_word"
+
+	// FlagLink marks a body linking outside the allowed hosts.
+	FlagLink Flag = "link"
+)
+
+// # Core Entities
+
+// Author is the public identity shown next to a comment.
+type Author struct {
+	ID          string `json:"id"`
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name"`
+	AvatarURL   string `json:"avatar_url"`
+}
+
+// Comment is a message on a comic or a chapter, or a reply to another comment.
+type Comment struct {
+	ID         string    `json:"id"`
+	Author     Author    `json:"author"`
+	ComicID    *string   `json:"comic_id"`   // Set for comic threads
+	ChapterID  *string   `json:"chapter_id"` // Set for chapter threads
+	ParentID   *string   `json:"parent_id"`  // nil for top-level comments
+	Depth      int       `json:"depth"`      // 0 for top-level comments
+	Body       string    `json:"body"`       // Replaced by DeletedBody once deleted
+	IsEdited   bool      `json:"is_edited"`
+	IsDeleted  bool      `json:"is_deleted"`
+	IsApproved bool      `json:"is_approved"`
+	Upvotes    int       `json:"upvotes"`
+	Downvotes  int       `json:"downvotes"`
+	ReplyCount int       `json:"reply_count"`
+	UserVote   *Vote     `json:"user_vote"` // Viewer's vote; nil when not voted or anonymous
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Score ranks the comment in the "top" order.
+func (c *Comment) Score() int64 {
+	return int64(c.Upvotes) - int64(c.Downvotes)
+}
+
+// Editable reports whether the author may still change the body at now.
+func (c *Comment) Editable(now time.Time) bool {
+	return !c.IsDeleted && now.Before(c.CreatedAt.Add(EditWindow))
+}
+
+/*
+ReplyPlacement returns where a reply to the comment is stored.
+
+Description: Replies nest one level below their parent until [MaxDepth];
+past it they become siblings of the parent, so the thread keeps going
+without getting any deeper.
+
+Returns:
+  - *string: Parent of the reply
+  - int: Depth of the reply
+*/
+func (c *Comment) ReplyPlacement() (*string, int) {
+	if c.Depth >= MaxDepth {
+		return c.ParentID, c.Depth
+	}
+	return &c.ID, c.Depth + 1
+}
+
+// VoteTally holds the vote counters of a comment as seen by the voter.
+type VoteTally struct {
+	Upvotes   int   `json:"upvotes"`
+	Downvotes int   `json:"downvotes"`
+	UserVote  *Vote `json:"user_vote"`
+}
+
+/*
+VoteDelta returns how the counters move when a vote changes.
+
+Parameters:
+  - previous: *Vote (nil when the user had not voted)
+  - next: *Vote (nil when the vote is removed)
+
+Returns:
+  - int: Change of the upvotes
+  - int: Change of the downvotes
+*/
+func VoteDelta(previous, next *Vote) (int, int) {
+	upvotes, downvotes := 0, 0
+	apply := func(vote *Vote, sign int) {
+		switch {
+		case vote == nil:
+		case *vote == VoteUp:
+			upvotes += sign
+		case *vote == VoteDown:
+			downvotes += sign
+		}
+	}
+
+	apply(previous, -1)
+	apply(next, 1)
+	return upvotes, downvotes
+}
+
+// HeldComment is an entry of the moderation queue.
+type HeldComment struct {
+	*Comment
+	Flags []Flag `json:"flags"` // Matches under the current filter; empty if the word list changed since
+}
+
+// Moderation records the decision of a moderator on one comment.
+type Moderation struct {
+	CommentID string
+	ActorID   string
+	Decision  Decision
+	Reason    string // Optional note kept in the audit log
+}
+
+// RateLimit caps how many comments one user may post within a window.
+type RateLimit struct {
+	Count  int
+	Window time.Duration
+}
+
+// PostingLimits apply to every new comment and reply; all of them must pass.
+var PostingLimits = []RateLimit{
+	{Count: 5, Window: time.Minute},
+	{Count: 60, Window: time.Hour},
+}
+
+// # Queries
+
+// ListQuery selects one page of a thread.
+type ListQuery struct {
+	ComicID   string // Top-level comments of a comic
+	ChapterID string // Top-level comments of a chapter
+	ParentID  string // Replies to a comment
+	ViewerID  string // Empty for anonymous callers
+	Sort      Sort
+	Before    *pagination.RankedCursor // nil starts at the first page; Score is unused for SortNew
+	Limit     int
+}
+
+// # Constraints
+
+const (
+	// MaxBodyLength is the maximum length of a comment body, in characters.
+	MaxBodyLength = 10000
+
+	// MaxDepth is the deepest nesting level of a reply; top-level comments are at 0.
+	MaxDepth = 4
+
+	// EditWindow is how long after posting the author may still edit a comment.
+	EditWindow = time.Hour
+
+	// DeletedBody replaces the body of deleted comments in responses.
+	DeletedBody = "[deleted]"
+
+	// MaxReasonLength is the maximum length of a moderation note, in characters.
+	MaxReasonLength = 500
+)
+
+// # Field Identifiers
+
+const (
+	FieldID       = "id"
+	FieldBody     = "body"
+	FieldVote     = "vote"
+	FieldSort     = "sort"
+	FieldBefore   = "before"
+	FieldDecision = "decision"
+	FieldReason   = "reason"
+)
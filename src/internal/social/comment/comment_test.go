@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comment_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taibuivan/yomira/internal/social/comment"
+)
+
+/*
+TestVoteDelta moves exactly one count per vote change and nothing when the
+vote stays the same.
+*/
+func TestVoteDelta(t *testing.T) {
+	up, down := comment.VoteUp, comment.VoteDown
+
+	cases := []struct {
+		name               string
+		previous, next     *comment.Vote
+		upvotes, downvotes int
+	}{
+		{"first upvote", nil, &up, 1, 0},
+		{"first downvote", nil, &down, 0, 1},
+		{"repeat upvote", &up, &up, 0, 0},
+		{"switch to down", &up, &down, -1, 1},
+		{"switch to up", &down, &up, 1, -1},
+		{"remove downvote", &down, nil, 0, -1},
+		{"remove missing vote", nil, nil, 0, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			upvotes, downvotes := comment.VoteDelta(tc.previous, tc.next)
+			assert.Equal(t, tc.upvotes, upvotes)
+			assert.Equal(t, tc.downvotes, downvotes)
+		})
+	}
+}
+
+/*
+TestComment_ReplyPlacement nests replies below their parent until the depth
+limit and keeps deeper replies at the limit.
+*/
+func TestComment_ReplyPlacement(t *testing.T) {
+	root := &comment.Comment{ID: "root"}
+	parent, depth := root.ReplyPlacement()
+	assert.Equal(t, "root", *parent)
+	assert.Equal(t, 1, depth)
+
+	grandparent := "p"
+	deepest := &comment.Comment{ID: "deep", ParentID: &grandparent, Depth: comment.MaxDepth}
+	parent, depth = deepest.ReplyPlacement()
+	assert.Equal(t, "p", *parent)
+	assert.Equal(t, comment.MaxDepth, depth)
+}
+
+/*
+TestComment_Editable closes the edit window after [comment.EditWindow] and
+for deleted comments.
+*/
+func TestComment_Editable(t *testing.T) {
+	posted := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	c := &comment.Comment{CreatedAt: posted}
+
+	assert.True(t, c.Editable(posted.Add(time.Minute)))
+	assert.False(t, c.Editable(posted.Add(comment.EditWindow)))
+
+	c.IsDeleted = true
+	assert.False(t, c.Editable(posted.Add(time.Minute)))
+}
+
+/*
+TestEnums_IsValid accepts only the documented sort orders, votes and decisions.
+*/
+func TestEnums_IsValid(t *testing.T) {
+	assert.True(t, comment.SortNew.IsValid())
+	assert.True(t, comment.SortTop.IsValid())
+	assert.False(t, comment.Sort("hot").IsValid())
+
+	assert.True(t, comment.VoteUp.IsValid())
+	assert.True(t, comment.VoteDown.IsValid())
+	assert.False(t, comment.Vote(0).IsValid())
+	assert.False(t, comment.Vote(2).IsValid())
+
+	assert.True(t, comment.DecisionApprove.IsValid())
+	assert.True(t, comment.DecisionReject.IsValid())
+	assert.True(t, comment.DecisionHide.IsValid())
+	assert.False(t, comment.Decision("delete").IsValid())
+}
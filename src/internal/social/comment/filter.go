@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comment
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// # Content Filter
+
+// linkPattern finds http(s) URLs and bare www. links in a body.
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"']+`)
+
+/*
+Filter decides which comments are held for moderator review.
+
+Description: Banned entries match whole words or phrases, ignoring case and
+punctuation, so "ass" does not hold "class". Links are allowed only when
+they point at one of the allowed hosts or a subdomain of it.
+*/
+type Filter struct {
+	bannedTerms  []string
+	allowedHosts []string
+}
+
+/*
+NewFilter builds a [Filter] from configuration values.
+
+Parameters:
+  - bannedWords: []string (Words or phrases; blank entries are ignored)
+  - allowedHosts: []string (Link hosts that never hold a comment)
+
+Returns:
+  - *Filter: Ready-to-use filter
+*/
+func NewFilter(bannedWords, allowedHosts []string) *Filter {
+	filter := &Filter{}
+
+	for _, word := range bannedWords {
+		if term := normalizeText(word); term != "" {
+			filter.bannedTerms = append(filter.bannedTerms, term)
+		}
+	}
+
+	for _, host := range allowedHosts {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			filter.allowedHosts = append(filter.allowedHosts, host)
+		}
+	}
+
+	return filter
+}
+
+/*
+Check returns why a body should be held for review.
+
+Parameters:
+  - body: string
+
+Returns:
+  - []Flag: Matched reasons; empty for a clean body
+*/
+func (filter *Filter) Check(body string) []Flag {
+	var flags []Flag
+
+	text := " " + normalizeText(body) + " "
+	for _, term := range filter.bannedTerms {
+		if strings.Contains(text, " "+term+" ") {
+			flags = append(flags, FlagBannedWord)
+			break
+		}
+	}
+
+	for _, link := range linkPattern.FindAllString(body, -1) {
+		if !filter.allowsLink(link) {
+			flags = append(flags, FlagLink)
+			break
+		}
+	}
+
+	return flags
+}
+
+// allowsLink reports whether a link points at an allowed host.
+func (filter *Filter) allowsLink(link string) bool {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	for _, allowed := range filter.allowedHosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeText lowercases text and reduces it to words separated by single spaces.
+func normalizeText(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comment_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taibuivan/yomira/internal/social/comment"
+)
+
+/*
+TestFilter_Check holds bodies with banned words or foreign links and lets
+everything else through.
+*/
+func TestFilter_Check(t *testing.T) {
+	filter := comment.NewFilter([]string{"Spoiler", "free coins", " "}, []string{"yomira.app"})
+
+	cases := []struct {
+		name  string
+		body  string
+		flags []comment.Flag
+	}{
+		{"clean", "Loved this chapter!", nil},
+		{"banned word ignores case and punctuation", "huge SPOILER: he lives", []comment.Flag{comment.FlagBannedWord}},
+		{"banned phrase", "get Free   coins here", []comment.Flag{comment.FlagBannedWord}},
+		{"partial word", "spoilers are fine", nil},
+		{"allowed host", "see https://yomira.app/comics/123", nil},
+		{"allowed subdomain", "see https://cdn.yomira.app/x.png", nil},
+		{"lookalike host", "see https://yomira.app.evil.example/x", []comment.Flag{comment.FlagLink}},
+		{"bare www link", "visit www.example.com now", []comment.Flag{comment.FlagLink}},
+		{"both", "spoiler at http://example.com", []comment.Flag{comment.FlagBannedWord, comment.FlagLink}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.flags, filter.Check(tc.body))
+		})
+	}
+}
+
+/*
+TestFilter_Empty holds every link when no host is allowed and no word when
+none is banned.
+*/
+func TestFilter_Empty(t *testing.T) {
+	filter := comment.NewFilter(nil, nil)
+
+	assert.Empty(t, filter.Check("anything goes"))
+	assert.Equal(t, []comment.Flag{comment.FlagLink}, filter.Check("https://yomira.app"))
+}
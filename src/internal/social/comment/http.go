@@ -0,0 +1,363 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+/*
+Package comment provides the HTTP interface for comment threads.
+
+# Routing Strategy
+
+  - Public (v1): Threads are readable by everyone; signed-in viewers also see
+    their own votes and held comments.
+  - Authenticated (v1): Posting, editing, deleting and voting require the
+    social:write scope for personal access tokens.
+  - Moderation (v1): The held-comment queue and decisions require the
+    moderator role and an interactive session.
+
+The handler translates between the web/JSON layer and the internal domain [Service].
+*/
+package comment
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/taibuivan/yomira/internal/platform/middleware"
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Handler Implementation
+
+// Handler implements the HTTP layer for comment threads.
+type Handler struct {
+	service *Service
+}
+
+// NewHandler constructs a new comment [Handler].
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes attaches comment endpoints to the root API router.
+// Threads span the /comics/{comicID}/..., /chapters/{id}/... and /comments/... prefixes;
+// the moderation queue lives under /admin/comments.
+func (handler *Handler) RegisterRoutes(api chi.Router) {
+
+	// Threads (optional authentication)
+	api.Get("/comics/{comicID}/comments", handler.listComicComments)
+	api.Get("/chapters/{id}/comments", handler.listChapterComments)
+	api.Get("/comments/{id}", handler.getComment)
+	api.Get("/comments/{id}/replies", handler.listReplies)
+
+	api.Group(func(user chi.Router) {
+		user.Use(middleware.RequireScope(sec.ScopeSocialWrite))
+
+		// Posting
+		user.Post("/comics/{comicID}/comments", handler.createComicComment)
+		user.Post("/chapters/{id}/comments", handler.createChapterComment)
+		user.Post("/comments/{id}/replies", handler.reply)
+
+		// Editing
+		user.Patch("/comments/{id}", handler.editComment)
+		user.Delete("/comments/{id}", handler.deleteComment)
+
+		// Voting
+		user.Post("/comments/{id}/vote", handler.voteComment)
+		user.Delete("/comments/{id}/vote", handler.unvoteComment)
+	})
+
+	// Moderation Queue
+	api.Group(func(moderator chi.Router) {
+		moderator.Use(middleware.RequireRole(sec.RoleModerator))
+		moderator.Use(middleware.RequireSession)
+		moderator.Get("/admin/comments", handler.listHeld)
+		moderator.Patch("/admin/comments/{id}", handler.moderateComment)
+	})
+}
+
+// # Request Payloads
+
+// bodyRequest defines the payload for posting or editing a comment.
+type bodyRequest struct {
+	Body string `json:"body"`
+}
+
+// voteRequest defines the payload for voting on a comment.
+type voteRequest struct {
+	Vote Vote `json:"vote"`
+}
+
+// # Thread Endpoints
+
+/*
+GET /api/v1/comics/{comicID}/comments.
+
+Description: Lists the top-level comments of a comic.
+
+Request:
+  - sort: string (new, top)
+  - before: string (next_before of the previous page)
+  - limit: int
+
+Response:
+  - 200: []Comment: Cursor-paginated comments
+  - 400: ErrValidation: Unknown sort or malformed cursor
+*/
+func (handler *Handler) listComicComments(writer http.ResponseWriter, request *http.Request) {
+	handler.respondThread(writer, request, ListQuery{ComicID: requestutil.ID(request, "comicID")})
+}
+
+/*
+GET /api/v1/chapters/{id}/comments.
+
+Description: Lists the top-level comments of a chapter.
+*/
+func (handler *Handler) listChapterComments(writer http.ResponseWriter, request *http.Request) {
+	handler.respondThread(writer, request, ListQuery{ChapterID: requestutil.ID(request, "id")})
+}
+
+/*
+GET /api/v1/comments/{id}/replies.
+
+Description: Lists the direct replies to a comment.
+*/
+func (handler *Handler) listReplies(writer http.ResponseWriter, request *http.Request) {
+	handler.respondThread(writer, request, ListQuery{ParentID: requestutil.ID(request, "id")})
+}
+
+// respondThread fills the viewer and sort of a thread query and writes the page.
+func (handler *Handler) respondThread(writer http.ResponseWriter, request *http.Request, query ListQuery) {
+	params := pagination.CursorFromRequest(request)
+
+	query.ViewerID = viewerID(request)
+	query.Sort = Sort(request.URL.Query().Get("sort"))
+
+	comments, next, err := handler.service.ListComments(request.Context(), query, params)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.CursorPaginated(writer, comments, pagination.NewCursorMeta(params.Limit, next))
+}
+
+/*
+GET /api/v1/comments/{id}.
+
+Response:
+  - 200: Comment: Success
+  - 404: ErrNotFound: Comment not found
+*/
+func (handler *Handler) getComment(writer http.ResponseWriter, request *http.Request) {
+	comment, err := handler.service.GetComment(request.Context(), requestutil.ID(request, "id"), viewerID(request))
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, comment)
+}
+
+// # Posting Endpoints
+
+/*
+POST /api/v1/comics/{comicID}/comments.
+
+Response:
+  - 201: Comment: The new comment (is_approved false when held for review)
+  - 400: ErrValidation: Empty or oversized body
+  - 404: ErrNotFound: Comic not found
+  - 429: ErrRateLimited: Posting too fast
+*/
+func (handler *Handler) createComicComment(writer http.ResponseWriter, request *http.Request) {
+	comicID := requestutil.ID(request, "comicID")
+	handler.respondPost(writer, request, func(comment *Comment) (*Comment, error) {
+		comment.ComicID = &comicID
+		return handler.service.CreateComment(request.Context(), comment)
+	})
+}
+
+/*
+POST /api/v1/chapters/{id}/comments.
+
+Response:
+  - 201: Comment: The new comment
+  - 400: ErrValidation: Empty or oversized body
+  - 404: ErrNotFound: Chapter not found
+*/
+func (handler *Handler) createChapterComment(writer http.ResponseWriter, request *http.Request) {
+	chapterID := requestutil.ID(request, "id")
+	handler.respondPost(writer, request, func(comment *Comment) (*Comment, error) {
+		comment.ChapterID = &chapterID
+		return handler.service.CreateComment(request.Context(), comment)
+	})
+}
+
+/*
+POST /api/v1/comments/{id}/replies.
+
+Response:
+  - 201: Comment: The new reply
+  - 400: ErrValidation: Empty body or deleted parent
+  - 404: ErrNotFound: Parent comment not found
+*/
+func (handler *Handler) reply(writer http.ResponseWriter, request *http.Request) {
+	parentID := requestutil.ID(request, "id")
+	handler.respondPost(writer, request, func(comment *Comment) (*Comment, error) {
+		return handler.service.Reply(request.Context(), parentID, comment)
+	})
+}
+
+// respondPost decodes the body of a new comment, hands it to post and writes the result.
+func (handler *Handler) respondPost(writer http.ResponseWriter, request *http.Request, post func(*Comment) (*Comment, error)) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input bodyRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	comment, err := post(&Comment{Author: Author{ID: userID}, Body: input.Body})
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Created(writer, comment)
+}
+
+// # Editing Endpoints
+
+/*
+PATCH /api/v1/comments/{id}.
+
+Response:
+  - 200: Comment: The edited comment
+  - 400: ErrValidation: Empty or oversized body
+  - 403: ErrForbidden: Not the author, or the edit window has closed
+  - 404: ErrNotFound: Comment not found or deleted
+*/
+func (handler *Handler) editComment(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input bodyRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	comment, err := handler.service.EditComment(request.Context(), requestutil.ID(request, "id"), userID, input.Body)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, comment)
+}
+
+/*
+DELETE /api/v1/comments/{id}.
+
+Description: Authors delete their own comments; moderators and admins may
+delete any comment, which counts as a rejection in the audit log.
+
+Response:
+  - 204: No Content
+  - 403: ErrForbidden: Not the author
+  - 404: ErrNotFound: Comment not found or already deleted
+*/
+func (handler *Handler) deleteComment(writer http.ResponseWriter, request *http.Request) {
+	claims, err := requestutil.RequiredClaims(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	moderator := sec.UserRole(claims.Role).AtLeast(sec.RoleModerator)
+	if err := handler.service.DeleteComment(request.Context(), requestutil.ID(request, "id"), claims.UserID, moderator); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+// # Voting Endpoints
+
+/*
+POST /api/v1/comments/{id}/vote.
+
+Request:
+  - body: { vote: 1 | -1 }
+
+Response:
+  - 200: VoteTally: Counters after the vote
+  - 400: ErrValidation: Vote other than 1 or -1
+  - 403: ErrForbidden: Own comment
+  - 404: ErrNotFound: Comment not found or deleted
+*/
+func (handler *Handler) voteComment(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input voteRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	tally, err := handler.service.VoteComment(request.Context(), requestutil.ID(request, "id"), userID, &input.Vote)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, tally)
+}
+
+/*
+DELETE /api/v1/comments/{id}/vote.
+
+Response:
+  - 200: VoteTally: Counters without the vote (also when there was none)
+  - 404: ErrNotFound: Comment not found or deleted
+*/
+func (handler *Handler) unvoteComment(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	tally, err := handler.service.VoteComment(request.Context(), requestutil.ID(request, "id"), userID, nil)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, tally)
+}
+
+// # Internal Helpers
+
+// viewerID returns the caller's user ID, or an empty string for anonymous callers.
+func viewerID(request *http.Request) string {
+	if claims := requestutil.Claims(request); claims != nil {
+		return claims.UserID
+	}
+	return ""
+}
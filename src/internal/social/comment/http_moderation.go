@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comment
+
+import (
+	"net/http"
+
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Request Payloads
+
+// moderationRequest defines the payload for a moderator decision.
+type moderationRequest struct {
+	Decision Decision `json:"decision"`
+	Reason   string   `json:"reason"`
+}
+
+// # Moderation Endpoints
+
+/*
+GET /api/v1/admin/comments.
+
+Description: Lists comments held by the filter, newest first.
+
+Request:
+  - before: string (next_before of the previous page)
+  - limit: int
+
+Response:
+  - 200: []HeldComment: Cursor-paginated queue
+  - 400: ErrValidation: Malformed cursor
+*/
+func (handler *Handler) listHeld(writer http.ResponseWriter, request *http.Request) {
+	params := pagination.CursorFromRequest(request)
+
+	held, next, err := handler.service.ListHeld(request.Context(), params)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.CursorPaginated(writer, held, pagination.NewCursorMeta(params.Limit, next))
+}
+
+/*
+PATCH /api/v1/admin/comments/{id}.
+
+Description: Approves, rejects or hides a comment. Works on queued and
+published comments alike.
+
+Request:
+  - body: { decision: "approve" | "reject" | "hide", reason?: string }
+
+Response:
+  - 200: Comment: The comment after the decision
+  - 400: ErrValidation: Unknown decision or oversized reason
+  - 404: ErrNotFound: Comment not found or deleted
+*/
+func (handler *Handler) moderateComment(writer http.ResponseWriter, request *http.Request) {
+	actorID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input moderationRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	comment, err := handler.service.Moderate(request.Context(), Moderation{
+		CommentID: requestutil.ID(request, "id"),
+		ActorID:   actorID,
+		Decision:  input.Decision,
+		Reason:    input.Reason,
+	})
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, comment)
+}
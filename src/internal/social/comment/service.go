@@ -0,0 +1,503 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comment
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+	"github.com/taibuivan/yomira/internal/social/notification"
+	"github.com/taibuivan/yomira/pkg/pagination"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # Service Layer
+
+// Notifier delivers in-app notifications to users.
+type Notifier interface {
+	Notify(context context.Context, notification *notification.Notification) error
+}
+
+// Service orchestrates comment threads, votes and moderation.
+type Service struct {
+	commentRepo CommentRepository
+	rateRepo    RateLimitRepository
+	filter      *Filter
+	notifier    Notifier
+	logger      *slog.Logger
+}
+
+// NewService constructs a new [Service] with its required repositories.
+func NewService(commentRepo CommentRepository, rateRepo RateLimitRepository, filter *Filter, notifier Notifier, logger *slog.Logger) *Service {
+	return &Service{
+		commentRepo: commentRepo,
+		rateRepo:    rateRepo,
+		filter:      filter,
+		notifier:    notifier,
+		logger:      logger,
+	}
+}
+
+// # Thread Lookups
+
+/*
+ListComments returns one page of a thread.
+
+Description: The cursor format depends on the sort order; a cursor issued
+for "new" is rejected under "top" and the other way round.
+
+Parameters:
+  - context: context.Context
+  - query: ListQuery (Thread, viewer and sort; Before and Limit are taken from params)
+  - params: pagination.CursorParams
+
+Returns:
+  - []*Comment: Page of comments
+  - string: Cursor of the next page; empty on the last page
+  - error: Validation errors or storage failures
+*/
+func (service *Service) ListComments(context context.Context, query ListQuery, params pagination.CursorParams) ([]*Comment, string, error) {
+	if query.Sort == "" {
+		query.Sort = SortNew
+	}
+	if !query.Sort.IsValid() {
+		return nil, "", apperr.ValidationError("Validation failed", apperr.FieldError{Field: FieldSort, Message: "must be one of: new, top"})
+	}
+
+	before, err := decodeCursor(query.Sort, params.Before)
+	if err != nil {
+		return nil, "", apperr.ValidationError("Validation failed", apperr.FieldError{Field: FieldBefore, Message: "is not a valid cursor"})
+	}
+	query.Before, query.Limit = before, params.Limit+1
+
+	comments, err := service.commentRepo.List(context, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, comment := range comments {
+		maskDeleted(comment)
+	}
+
+	if len(comments) <= params.Limit {
+		return comments, "", nil
+	}
+
+	comments = comments[:params.Limit]
+	last := comments[len(comments)-1]
+	next := pagination.Cursor{At: last.CreatedAt, ID: last.ID}
+	if query.Sort == SortTop {
+		return comments, pagination.RankedCursor{Score: last.Score(), Cursor: next}.Encode(), nil
+	}
+	return comments, next.Encode(), nil
+}
+
+// GetComment returns a single comment as seen by the viewer.
+func (service *Service) GetComment(context context.Context, id, viewerID string) (*Comment, error) {
+	comment, err := service.commentRepo.FindByID(context, id, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Held comments stay private to their author
+	if !comment.IsApproved && comment.Author.ID != viewerID {
+		return nil, apperr.NotFound("Comment")
+	}
+
+	maskDeleted(comment)
+	return comment, nil
+}
+
+// # Posting
+
+/*
+CreateComment starts a new top-level comment on a comic or a chapter.
+
+Parameters:
+  - context: context.Context
+  - comment: *Comment (Author.ID, Body and exactly one of ComicID and ChapterID)
+
+Returns:
+  - *Comment: The stored comment
+  - error: Validation errors or apperr.NotFound for missing content
+*/
+func (service *Service) CreateComment(context context.Context, comment *Comment) (*Comment, error) {
+	comment.ParentID, comment.Depth = nil, 0
+	return service.post(context, comment)
+}
+
+/*
+Reply answers an existing comment.
+
+Description: The reply joins the thread of its parent. Past [MaxDepth] it
+is attached to the parent's own parent instead, see [Comment.ReplyPlacement].
+The author of the answered comment is notified once the reply is visible:
+right away, or when a moderator approves a held reply, see [Service.Moderate].
+
+Parameters:
+  - context: context.Context
+  - parentID: string (UUID)
+  - reply: *Comment (Author.ID and Body)
+
+Returns:
+  - *Comment: The stored reply
+  - error: Validation errors for deleted parents, or apperr.NotFound
+*/
+func (service *Service) Reply(context context.Context, parentID string, reply *Comment) (*Comment, error) {
+	parent, err := service.GetComment(context, parentID, reply.Author.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if parent.IsDeleted {
+		return nil, apperr.ValidationError("Validation failed", apperr.FieldError{Field: FieldID, Message: "cannot reply to a deleted comment"})
+	}
+
+	reply.ComicID, reply.ChapterID = parent.ComicID, parent.ChapterID
+	reply.ParentID, reply.Depth = parent.ReplyPlacement()
+
+	stored, err := service.post(context, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	if stored.IsApproved && parent.Author.ID != stored.Author.ID {
+		service.notifyReply(context, parent, stored)
+	}
+
+	return stored, nil
+}
+
+/*
+post validates and stores a comment, then reads it back with its author.
+
+Description: Bodies flagged by the [Filter] are stored unapproved and wait
+in the moderation queue; the author sees them as usual meanwhile.
+*/
+func (service *Service) post(context context.Context, comment *Comment) (*Comment, error) {
+	comment.Body = strings.TrimSpace(comment.Body)
+	if err := validateBody(comment.Body); err != nil {
+		return nil, err
+	}
+
+	if err := service.checkRateLimits(context, comment.Author.ID); err != nil {
+		return nil, err
+	}
+
+	flags := service.filter.Check(comment.Body)
+
+	comment.ID = uuid.New()
+	comment.IsApproved = len(flags) == 0
+	comment.CreatedAt = time.Now().UTC()
+
+	if err := service.commentRepo.Create(context, comment); err != nil {
+		return nil, err
+	}
+
+	service.logger.Info("comment_created",
+		slog.String("comment_id", comment.ID),
+		slog.String("user_id", comment.Author.ID),
+		slog.Int("depth", comment.Depth),
+		slog.Any("flags", flags),
+	)
+
+	return service.commentRepo.FindByID(context, comment.ID, comment.Author.ID)
+}
+
+// # Editing
+
+/*
+EditComment replaces the body of a comment within the [EditWindow].
+
+Parameters:
+  - context: context.Context
+  - id: string (UUID)
+  - userID: string (Caller; must be the author)
+  - body: string
+
+Returns:
+  - *Comment: The edited comment
+  - error: Forbidden for other users or a closed edit window, validation errors, or apperr.NotFound
+*/
+func (service *Service) EditComment(context context.Context, id, userID, body string) (*Comment, error) {
+	comment, err := service.commentRepo.FindByID(context, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Author.ID != userID {
+		return nil, apperr.Forbidden("You can only edit your own comments.")
+	}
+
+	now := time.Now().UTC()
+	if !comment.Editable(now) {
+		return nil, apperr.Forbidden("This comment can no longer be edited.")
+	}
+
+	body = strings.TrimSpace(body)
+	if err := validateBody(body); err != nil {
+		return nil, err
+	}
+
+	flags := service.filter.Check(body)
+	if err := service.commentRepo.UpdateBody(context, id, body, len(flags) > 0, now); err != nil {
+		return nil, err
+	}
+
+	service.logger.Info("comment_edited", slog.String("comment_id", id), slog.String("user_id", userID), slog.Any("flags", flags))
+
+	return service.commentRepo.FindByID(context, id, userID)
+}
+
+/*
+DeleteComment soft-deletes a comment.
+
+Description: Moderators deleting someone else's comment reject it, which
+records the decision in the audit log.
+
+Parameters:
+  - context: context.Context
+  - id: string (UUID)
+  - userID: string (Caller)
+  - moderator: bool (Caller may delete any comment)
+
+Returns:
+  - error: Forbidden for other users' comments, or apperr.NotFound
+*/
+func (service *Service) DeleteComment(context context.Context, id, userID string, moderator bool) error {
+	comment, err := service.commentRepo.FindByID(context, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if comment.Author.ID != userID {
+		if !moderator {
+			return apperr.Forbidden("You can only delete your own comments.")
+		}
+		_, err := service.Moderate(context, Moderation{CommentID: id, ActorID: userID, Decision: DecisionReject})
+		return err
+	}
+
+	if err := service.commentRepo.SoftDelete(context, id); err != nil {
+		return err
+	}
+
+	service.logger.Info("comment_deleted", slog.String("comment_id", id), slog.String("user_id", userID))
+
+	return nil
+}
+
+// # Voting
+
+/*
+VoteComment records, changes or removes the vote of a user.
+
+Description: Requests are idempotent: sending the same vote twice, or
+removing a vote that does not exist, returns the current counters.
+
+Parameters:
+  - context: context.Context
+  - id: string (UUID)
+  - userID: string (UUID)
+  - vote: *Vote (nil removes the vote)
+
+Returns:
+  - *VoteTally: Counters after the change
+  - error: Forbidden on own comments, validation errors, or apperr.NotFound
+*/
+func (service *Service) VoteComment(context context.Context, id, userID string, vote *Vote) (*VoteTally, error) {
+	if vote != nil && !vote.IsValid() {
+		return nil, apperr.ValidationError("Validation failed", apperr.FieldError{Field: FieldVote, Message: "must be 1 or -1"})
+	}
+
+	comment, err := service.GetComment(context, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Author.ID == userID {
+		return nil, apperr.Forbidden("You cannot vote on your own comment.")
+	}
+
+	return service.commentRepo.SetVote(context, id, userID, vote)
+}
+
+// # Moderation
+
+/*
+ListHeld returns one page of the moderation queue.
+
+Parameters:
+  - context: context.Context
+  - params: pagination.CursorParams
+
+Returns:
+  - []*HeldComment: Held comments with the reasons they were flagged
+  - string: Cursor of the next page; empty on the last page
+  - error: Validation errors or storage failures
+*/
+func (service *Service) ListHeld(context context.Context, params pagination.CursorParams) ([]*HeldComment, string, error) {
+	before, err := pagination.DecodeCursor(params.Before)
+	if err != nil {
+		return nil, "", apperr.ValidationError("Validation failed", apperr.FieldError{Field: FieldBefore, Message: "is not a valid cursor"})
+	}
+
+	comments, err := service.commentRepo.ListHeld(context, before, params.Limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(comments) > params.Limit {
+		comments = comments[:params.Limit]
+		last := comments[len(comments)-1]
+		next = pagination.Cursor{At: last.CreatedAt, ID: last.ID}.Encode()
+	}
+
+	held := make([]*HeldComment, len(comments))
+	for index, comment := range comments {
+		held[index] = &HeldComment{Comment: comment, Flags: service.filter.Check(comment.Body)}
+	}
+
+	return held, next, nil
+}
+
+/*
+Moderate applies a moderator decision to a comment.
+
+Description: Approving publishes the comment, hiding keeps it visible to
+its author only and rejecting soft-deletes it. Every decision is written
+to the audit log together with the optional reason. Approving a held reply
+notifies the author of the comment it is attached to.
+
+Parameters:
+  - context: context.Context
+  - moderation: Moderation
+
+Returns:
+  - *Comment: The comment after the decision
+  - error: Validation errors, or apperr.NotFound for missing or deleted comments
+*/
+func (service *Service) Moderate(context context.Context, moderation Moderation) (*Comment, error) {
+	moderation.Reason = strings.TrimSpace(moderation.Reason)
+
+	validator := &validate.Validator{}
+	validator.Custom(FieldDecision, !moderation.Decision.IsValid(), "must be one of: approve, reject, hide")
+	validator.MaxLen(FieldReason, moderation.Reason, MaxReasonLength)
+	if err := validator.Err(); err != nil {
+		return nil, err
+	}
+
+	published, err := service.commentRepo.Moderate(context, moderation)
+	if err != nil {
+		return nil, err
+	}
+
+	service.logger.Info("comment_moderated",
+		slog.String("comment_id", moderation.CommentID),
+		slog.String("actor_id", moderation.ActorID),
+		slog.String("decision", string(moderation.Decision)),
+	)
+
+	comment, err := service.commentRepo.FindByID(context, moderation.CommentID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if published && comment.ParentID != nil {
+		service.notifyApprovedReply(context, comment)
+	}
+
+	maskDeleted(comment)
+	return comment, nil
+}
+
+// # Internal Helpers
+
+// notifyReply tells the author of a comment about a reply; failures are logged, the reply stands.
+func (service *Service) notifyReply(context context.Context, parent, reply *Comment) {
+	name := reply.Author.DisplayName
+	if name == "" {
+		name = reply.Author.Username
+	}
+
+	err := service.notifier.Notify(context, &notification.Notification{
+		UserID:     parent.Author.ID,
+		Kind:       notification.KindCommentReply,
+		Title:      name + " replied to your comment",
+		Body:       notification.Excerpt(reply.Body),
+		EntityType: notification.EntityComment,
+		EntityID:   reply.ID,
+	})
+	if err != nil {
+		service.logger.Error("comment_reply_notification_failed",
+			slog.String("comment_id", reply.ID),
+			slog.String("user_id", parent.Author.ID),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// notifyApprovedReply announces a held reply once a moderator publishes it.
+func (service *Service) notifyApprovedReply(context context.Context, reply *Comment) {
+	parent, err := service.commentRepo.FindByID(context, *reply.ParentID, "")
+	if err != nil {
+		service.logger.Error("comment_reply_notification_failed",
+			slog.String("comment_id", reply.ID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	if !parent.IsDeleted && parent.Author.ID != reply.Author.ID {
+		service.notifyReply(context, parent, reply)
+	}
+}
+
+// checkRateLimits enforces [PostingLimits]; it fails open so a Redis outage does not block posting.
+func (service *Service) checkRateLimits(context context.Context, userID string) error {
+	for _, limit := range PostingLimits {
+		wait, err := service.rateRepo.Hit(context, userID, limit)
+		if err != nil {
+			service.logger.Error("comment_rate_limit_check_failed", slog.String("user_id", userID), slog.Any("error", err))
+			return nil
+		}
+		if wait > 0 {
+			return apperr.RateLimited(int(math.Ceil(wait.Seconds())))
+		}
+	}
+	return nil
+}
+
+// validateBody enforces the length limits of a trimmed comment body.
+func validateBody(body string) error {
+	validator := &validate.Validator{}
+	validator.Required(FieldBody, body).MaxLen(FieldBody, body, MaxBodyLength)
+	return validator.Err()
+}
+
+// decodeCursor parses the cursor format of the sort order.
+func decodeCursor(sort Sort, raw string) (*pagination.RankedCursor, error) {
+	if sort == SortTop {
+		return pagination.DecodeRankedCursor(raw)
+	}
+
+	cursor, err := pagination.DecodeCursor(raw)
+	if err != nil || cursor == nil {
+		return nil, err
+	}
+	return &pagination.RankedCursor{Cursor: *cursor}, nil
+}
+
+// maskDeleted hides the body of a deleted comment.
+func maskDeleted(comment *Comment) {
+	if comment.IsDeleted {
+		comment.Body = DeletedBody
+	}
+}
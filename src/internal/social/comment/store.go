@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comment
+
+import (
+	"context"
+	"time"
+
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Comment Data Access
+
+// CommentRepository defines the data access contract for comments and votes.
+type CommentRepository interface {
+
+	/*
+		List returns one page of a thread.
+
+		Description: Unapproved comments are only returned to their author.
+		Deleted comments are kept while they still have replies.
+
+		Parameters:
+		  - context: context.Context
+		  - query: ListQuery
+
+		Returns:
+		  - []*Comment: Comments in the requested order
+		  - error: Database retrieval failures
+	*/
+	List(context context.Context, query ListQuery) ([]*Comment, error)
+
+	/*
+		FindByID returns a comment with its author and the viewer's vote.
+
+		Parameters:
+		  - context: context.Context
+		  - id: string (UUID)
+		  - viewerID: string (Empty for anonymous callers)
+
+		Returns:
+		  - *Comment: The comment, deleted or not
+		  - error: apperr.NotFound if missing
+	*/
+	FindByID(context context.Context, id, viewerID string) (*Comment, error)
+
+	/*
+		Create persists a new comment.
+
+		Parameters:
+		  - context: context.Context
+		  - comment: *Comment (Author.ID and exactly one of ComicID and ChapterID set)
+
+		Returns:
+		  - error: apperr.NotFound if the comic or chapter is missing, or storage failures
+	*/
+	Create(context context.Context, comment *Comment) error
+
+	/*
+		UpdateBody replaces the body of a live comment.
+
+		Description: A held body sends the comment back to the moderation
+		queue; a clean body keeps its current approval.
+
+		Parameters:
+		  - context: context.Context
+		  - id: string (UUID)
+		  - body: string
+		  - held: bool (The filter flagged the new body)
+		  - updatedAt: time.Time
+
+		Returns:
+		  - error: apperr.NotFound if missing or deleted
+	*/
+	UpdateBody(context context.Context, id, body string, held bool, updatedAt time.Time) error
+
+	/*
+		SoftDelete marks a comment as deleted.
+
+		Parameters:
+		  - context: context.Context
+		  - id: string (UUID)
+
+		Returns:
+		  - error: apperr.NotFound if missing or already deleted
+	*/
+	SoftDelete(context context.Context, id string) error
+
+	/*
+		SetVote records the vote of a user and adjusts the counters.
+
+		Description: Voting the same way twice changes nothing; switching
+		sides moves one count from one counter to the other.
+
+		Parameters:
+		  - context: context.Context
+		  - commentID: string (UUID)
+		  - userID: string (UUID)
+		  - vote: *Vote (nil removes the vote)
+
+		Returns:
+		  - *VoteTally: Counters after the change
+		  - error: apperr.NotFound if the comment is missing or deleted
+	*/
+	SetVote(context context.Context, commentID, userID string, vote *Vote) (*VoteTally, error)
+
+	/*
+		ListHeld returns one page of the moderation queue.
+
+		Description: Held comments are unapproved, live and not reviewed yet;
+		hidden comments have been reviewed and never come back.
+
+		Parameters:
+		  - context: context.Context
+		  - before: *pagination.Cursor (nil for the first page)
+		  - limit: int
+
+		Returns:
+		  - []*Comment: Newest held comments first
+		  - error: Database retrieval failures
+	*/
+	ListHeld(context context.Context, before *pagination.Cursor, limit int) ([]*Comment, error)
+
+	/*
+		Moderate applies a moderator decision and writes its audit log entry.
+
+		Parameters:
+		  - context: context.Context
+		  - moderation: Moderation
+
+		Returns:
+		  - bool: True when the decision published a comment that was held
+		  - error: apperr.NotFound if the comment is missing or deleted
+	*/
+	Moderate(context context.Context, moderation Moderation) (bool, error)
+}
+
+// # Rate Limiting
+
+// RateLimitRepository counts the posts of each user per window.
+type RateLimitRepository interface {
+
+	/*
+		Hit counts a post against one limit of a user.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string (UUID)
+		  - limit: RateLimit
+
+		Returns:
+		  - time.Duration: Remaining wait when the limit is exceeded; zero otherwise
+		  - error: Execution errors
+	*/
+	Hit(context context.Context, userID string, limit RateLimit) (time.Duration, error)
+}
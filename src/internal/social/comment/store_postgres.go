@@ -0,0 +1,518 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/pkg/pagination"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # PostgreSQL Repositories
+
+// commentRepository implements the [CommentRepository] interface using pgx.
+type commentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCommentRepository constructs a PostgreSQL backed comment store.
+func NewCommentRepository(pool *pgxpool.Pool) CommentRepository {
+	return &commentRepository{pool: pool}
+}
+
+// commentProjection selects a comment with its author, reply count and the vote of viewer $1.
+var commentProjection = fmt.Sprintf(`
+	SELECT c.%[1]s, c.%[2]s, c.%[3]s, c.%[4]s, c.%[5]s, c.%[6]s, c.%[7]s, c.%[8]s,
+		c.%[9]s, c.%[10]s, c.%[11]s, c.%[12]s,
+		a.%[13]s, a.%[14]s, a.%[15]s, a.%[16]s,
+		(SELECT COUNT(*) FROM %[17]s r WHERE r.%[4]s = c.%[1]s AND r.%[8]s AND NOT r.%[7]s),
+		v.%[18]s
+	FROM %[17]s c
+	JOIN %[19]s a ON a.%[13]s = c.%[20]s
+	LEFT JOIN %[21]s v ON v.%[22]s = c.%[1]s AND v.%[23]s = $1`,
+	schema.SocialComment.ID,            // 1
+	schema.SocialComment.ComicID,       // 2
+	schema.SocialComment.ChapterID,     // 3
+	schema.SocialComment.ParentID,      // 4
+	schema.SocialComment.Depth,         // 5
+	schema.SocialComment.Body,          // 6
+	schema.SocialComment.IsDeleted,     // 7
+	schema.SocialComment.IsApproved,    // 8
+	schema.SocialComment.Upvotes,       // 9
+	schema.SocialComment.Downvotes,     // 10
+	schema.SocialComment.CreatedAt,     // 11
+	schema.SocialComment.UpdatedAt,     // 12
+	schema.UserAccount.ID,              // 13
+	schema.UserAccount.Username,        // 14
+	schema.UserAccount.DisplayName,     // 15
+	schema.UserAccount.AvatarURL,       // 16
+	schema.SocialComment.Table,         // 17
+	schema.SocialCommentVote.Vote,      // 18
+	schema.UserAccount.Table,           // 19
+	schema.SocialComment.UserID,        // 20
+	schema.SocialCommentVote.Table,     // 21
+	schema.SocialCommentVote.CommentID, // 22
+	schema.SocialCommentVote.UserID,    // 23
+)
+
+// scanComment hydrates a row selected by [commentProjection].
+func scanComment(row pgx.Row) (*Comment, error) {
+	comment := &Comment{}
+	err := row.Scan(
+		&comment.ID,
+		&comment.ComicID,
+		&comment.ChapterID,
+		&comment.ParentID,
+		&comment.Depth,
+		&comment.Body,
+		&comment.IsDeleted,
+		&comment.IsApproved,
+		&comment.Upvotes,
+		&comment.Downvotes,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+		&comment.Author.ID,
+		&comment.Author.Username,
+		&comment.Author.DisplayName,
+		&comment.Author.AvatarURL,
+		&comment.ReplyCount,
+		&comment.UserVote,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Votes and deletion leave updatedat alone, so any change is an edit
+	comment.IsEdited = comment.UpdatedAt.After(comment.CreatedAt)
+	return comment, nil
+}
+
+// liveComic and liveChapter match the comment target $10 while it is not deleted.
+var (
+	liveComic = fmt.Sprintf(`SELECT 1 FROM %s WHERE %s = $10 AND %s IS NULL`,
+		schema.CoreComic.Table, schema.CoreComic.ID, schema.CoreComic.DeletedAt)
+	liveChapter = fmt.Sprintf(`SELECT 1 FROM %s WHERE %s = $10 AND %s IS NULL`,
+		schema.CoreChapter.Table, schema.CoreChapter.ID, schema.CoreChapter.DeletedAt)
+)
+
+// # Comment Repository Implementation
+
+/*
+List returns one page of a thread.
+
+Description: The thread is picked by the first non-empty ID of the query.
+Keyset pagination compares the sort key, creation time and ID as one row
+value, matching the ORDER BY so pages never overlap.
+*/
+func (repository *commentRepository) List(context context.Context, query ListQuery) ([]*Comment, error) {
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(commentProjection)
+
+	args := []any{query.ViewerID}
+	argID := 2
+
+	// Thread selection
+	switch {
+	case query.ParentID != "":
+		queryBuilder.WriteString(fmt.Sprintf(" WHERE c.%s = $%d", schema.SocialComment.ParentID, argID))
+		args = append(args, query.ParentID)
+	case query.ChapterID != "":
+		queryBuilder.WriteString(fmt.Sprintf(" WHERE c.%s = $%d AND c.%s IS NULL", schema.SocialComment.ChapterID, argID, schema.SocialComment.ParentID))
+		args = append(args, query.ChapterID)
+	default:
+		queryBuilder.WriteString(fmt.Sprintf(" WHERE c.%s = $%d AND c.%s IS NULL", schema.SocialComment.ComicID, argID, schema.SocialComment.ParentID))
+		args = append(args, query.ComicID)
+	}
+	argID++
+
+	// Held comments are only shown to their author
+	queryBuilder.WriteString(fmt.Sprintf(" AND (c.%s OR c.%s = $1)", schema.SocialComment.IsApproved, schema.SocialComment.UserID))
+
+	// Deleted comments only stay while they anchor live replies
+	queryBuilder.WriteString(fmt.Sprintf(" AND (NOT c.%[1]s OR EXISTS (SELECT 1 FROM %[2]s r WHERE r.%[3]s = c.%[4]s AND NOT r.%[1]s))",
+		schema.SocialComment.IsDeleted, schema.SocialComment.Table, schema.SocialComment.ParentID, schema.SocialComment.ID))
+
+	score := fmt.Sprintf("(c.%s - c.%s)", schema.SocialComment.Upvotes, schema.SocialComment.Downvotes)
+
+	// Keyset pagination
+	if query.Before != nil {
+		if query.Sort == SortTop {
+			queryBuilder.WriteString(fmt.Sprintf(" AND (%s, c.%s, c.%s) < ($%d, $%d, $%d)",
+				score, schema.SocialComment.CreatedAt, schema.SocialComment.ID, argID, argID+1, argID+2))
+			args = append(args, query.Before.Score, query.Before.At, query.Before.ID)
+			argID += 3
+		} else {
+			queryBuilder.WriteString(fmt.Sprintf(" AND (c.%s, c.%s) < ($%d, $%d)",
+				schema.SocialComment.CreatedAt, schema.SocialComment.ID, argID, argID+1))
+			args = append(args, query.Before.At, query.Before.ID)
+			argID += 2
+		}
+	}
+
+	// Ordering
+	if query.Sort == SortTop {
+		queryBuilder.WriteString(fmt.Sprintf(" ORDER BY %s DESC,", score))
+	} else {
+		queryBuilder.WriteString(" ORDER BY")
+	}
+	queryBuilder.WriteString(fmt.Sprintf(" c.%s DESC, c.%s DESC LIMIT $%d",
+		schema.SocialComment.CreatedAt, schema.SocialComment.ID, argID))
+	args = append(args, query.Limit)
+
+	rows, err := repository.pool.Query(context, queryBuilder.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list comments: %w", err)
+	}
+	defer rows.Close()
+
+	comments := make([]*Comment, 0, query.Limit)
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("postgres: failed to scan comment: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: failed to iterate comments: %w", err)
+	}
+
+	return comments, nil
+}
+
+// FindByID returns a comment with its author and the viewer's vote.
+func (repository *commentRepository) FindByID(context context.Context, id, viewerID string) (*Comment, error) {
+	query := commentProjection + fmt.Sprintf(" WHERE c.%s = $2", schema.SocialComment.ID)
+
+	comment, err := scanComment(repository.pool.QueryRow(context, query, viewerID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("Comment")
+		}
+		return nil, fmt.Errorf("postgres: failed to find comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+/*
+Create persists a new comment.
+
+Description: The insert only happens while the comic or chapter is live,
+so a thread can never be started on deleted content.
+*/
+func (repository *commentRepository) Create(context context.Context, comment *Comment) error {
+
+	// Target resolution
+	target, targetID, resource := liveComic, comment.ComicID, "Comic"
+	if comment.ChapterID != nil {
+		target, targetID, resource = liveChapter, comment.ChapterID, "Chapter"
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s, %[6]s, %[7]s, %[8]s, %[9]s, %[10]s, %[11]s, %[12]s, %[13]s, %[14]s)
+		SELECT $1, $2, $3, $4, $5, $6, $7, FALSE, $8, 0, 0, $9, $9
+		WHERE EXISTS (%[15]s)
+	`,
+		schema.SocialComment.Table,      // 1
+		schema.SocialComment.ID,         // 2
+		schema.SocialComment.UserID,     // 3
+		schema.SocialComment.ComicID,    // 4
+		schema.SocialComment.ChapterID,  // 5
+		schema.SocialComment.ParentID,   // 6
+		schema.SocialComment.Depth,      // 7
+		schema.SocialComment.Body,       // 8
+		schema.SocialComment.IsDeleted,  // 9
+		schema.SocialComment.IsApproved, // 10
+		schema.SocialComment.Upvotes,    // 11
+		schema.SocialComment.Downvotes,  // 12
+		schema.SocialComment.CreatedAt,  // 13
+		schema.SocialComment.UpdatedAt,  // 14
+		target,                          // 15
+	)
+
+	tag, err := repository.pool.Exec(context, query,
+		comment.ID,
+		comment.Author.ID,
+		comment.ComicID,
+		comment.ChapterID,
+		comment.ParentID,
+		comment.Depth,
+		comment.Body,
+		comment.IsApproved,
+		comment.CreatedAt,
+		*targetID,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to create comment: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.NotFound(resource)
+	}
+
+	return nil
+}
+
+// UpdateBody replaces the body of a live comment; a held body returns it to the moderation queue.
+func (repository *commentRepository) UpdateBody(context context.Context, id, body string, held bool, updatedAt time.Time) error {
+	query := fmt.Sprintf(`
+		UPDATE %[1]s
+		SET %[2]s = $2, %[3]s = $4,
+			%[4]s = %[4]s AND NOT $3,
+			%[5]s = CASE WHEN $3 THEN NULL ELSE %[5]s END
+		WHERE %[6]s = $1 AND NOT %[7]s
+	`,
+		schema.SocialComment.Table,      // 1
+		schema.SocialComment.Body,       // 2
+		schema.SocialComment.UpdatedAt,  // 3
+		schema.SocialComment.IsApproved, // 4
+		schema.SocialComment.ReviewedAt, // 5
+		schema.SocialComment.ID,         // 6
+		schema.SocialComment.IsDeleted,  // 7
+	)
+
+	tag, err := repository.pool.Exec(context, query, id, body, held, updatedAt)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to update comment: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.NotFound("Comment")
+	}
+
+	return nil
+}
+
+// SoftDelete marks a comment as deleted; updatedat is kept so the comment does not read as edited.
+func (repository *commentRepository) SoftDelete(context context.Context, id string) error {
+	query := fmt.Sprintf(`UPDATE %s SET %s = TRUE WHERE %s = $1 AND NOT %s`,
+		schema.SocialComment.Table, schema.SocialComment.IsDeleted,
+		schema.SocialComment.ID, schema.SocialComment.IsDeleted)
+
+	tag, err := repository.pool.Exec(context, query, id)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to delete comment: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.NotFound("Comment")
+	}
+
+	return nil
+}
+
+// # Vote Management
+
+/*
+SetVote records the vote of a user and adjusts the counters.
+
+Description: The comment row is locked first, so concurrent votes on the
+same comment apply their deltas one after another and a repeated request
+sees the vote it already stored.
+*/
+func (repository *commentRepository) SetVote(context context.Context, commentID, userID string, vote *Vote) (*VoteTally, error) {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Serialise counter updates per comment
+	lockQuery := fmt.Sprintf(`SELECT %s, %s FROM %s WHERE %s = $1 AND NOT %s FOR UPDATE`,
+		schema.SocialComment.Upvotes, schema.SocialComment.Downvotes,
+		schema.SocialComment.Table, schema.SocialComment.ID, schema.SocialComment.IsDeleted)
+
+	tally := &VoteTally{}
+	if err := transaction.QueryRow(context, lockQuery, commentID).Scan(&tally.Upvotes, &tally.Downvotes); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("Comment")
+		}
+		return nil, fmt.Errorf("postgres: failed to lock comment: %w", err)
+	}
+
+	previousQuery := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 AND %s = $2`,
+		schema.SocialCommentVote.Vote, schema.SocialCommentVote.Table,
+		schema.SocialCommentVote.CommentID, schema.SocialCommentVote.UserID)
+
+	var previous *Vote
+	if err := transaction.QueryRow(context, previousQuery, commentID, userID).Scan(&previous); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("postgres: failed to find previous vote: %w", err)
+	}
+
+	// Repeated requests leave everything as it is
+	upvotes, downvotes := VoteDelta(previous, vote)
+	if upvotes == 0 && downvotes == 0 {
+		tally.UserVote = previous
+		return tally, nil
+	}
+
+	if vote == nil {
+		deleteQuery := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1 AND %s = $2`,
+			schema.SocialCommentVote.Table, schema.SocialCommentVote.CommentID, schema.SocialCommentVote.UserID)
+
+		if _, err := transaction.Exec(context, deleteQuery, commentID, userID); err != nil {
+			return nil, fmt.Errorf("postgres: failed to delete vote: %w", err)
+		}
+	} else {
+		upsertQuery := fmt.Sprintf(`
+			INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s) VALUES ($1, $2, $3)
+			ON CONFLICT (%[2]s, %[3]s) DO UPDATE SET %[4]s = EXCLUDED.%[4]s
+		`,
+			schema.SocialCommentVote.Table,     // 1
+			schema.SocialCommentVote.UserID,    // 2
+			schema.SocialCommentVote.CommentID, // 3
+			schema.SocialCommentVote.Vote,      // 4
+		)
+
+		if _, err := transaction.Exec(context, upsertQuery, userID, commentID, int(*vote)); err != nil {
+			return nil, fmt.Errorf("postgres: failed to upsert vote: %w", err)
+		}
+	}
+
+	counterQuery := fmt.Sprintf(`
+		UPDATE %[1]s SET %[2]s = %[2]s + $2, %[3]s = %[3]s + $3
+		WHERE %[4]s = $1
+		RETURNING %[2]s, %[3]s
+	`,
+		schema.SocialComment.Table,     // 1
+		schema.SocialComment.Upvotes,   // 2
+		schema.SocialComment.Downvotes, // 3
+		schema.SocialComment.ID,        // 4
+	)
+
+	if err := transaction.QueryRow(context, counterQuery, commentID, upvotes, downvotes).Scan(&tally.Upvotes, &tally.Downvotes); err != nil {
+		return nil, fmt.Errorf("postgres: failed to adjust vote counters: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return nil, fmt.Errorf("postgres: failed to commit vote transaction: %w", err)
+	}
+
+	tally.UserVote = vote
+	return tally, nil
+}
+
+// # Moderation
+
+// ListHeld returns one page of the moderation queue, newest first.
+func (repository *commentRepository) ListHeld(context context.Context, before *pagination.Cursor, limit int) ([]*Comment, error) {
+	query := commentProjection + fmt.Sprintf(`
+		WHERE NOT c.%[1]s AND NOT c.%[2]s AND c.%[3]s IS NULL
+			AND ($2::timestamptz IS NULL OR (c.%[4]s, c.%[5]s) < ($2, $3))
+		ORDER BY c.%[4]s DESC, c.%[5]s DESC
+		LIMIT $4
+	`,
+		schema.SocialComment.IsApproved, // 1
+		schema.SocialComment.IsDeleted,  // 2
+		schema.SocialComment.ReviewedAt, // 3
+		schema.SocialComment.CreatedAt,  // 4
+		schema.SocialComment.ID,         // 5
+	)
+
+	var beforeAt *time.Time
+	var beforeID string
+	if before != nil {
+		beforeAt, beforeID = &before.At, before.ID
+	}
+
+	rows, err := repository.pool.Query(context, query, "", beforeAt, beforeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list held comments: %w", err)
+	}
+	defer rows.Close()
+
+	comments := make([]*Comment, 0, limit)
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("postgres: failed to scan held comment: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: failed to iterate held comments: %w", err)
+	}
+
+	return comments, nil
+}
+
+/*
+Moderate applies a moderator decision and writes its audit log entry.
+
+Description: The comment row is locked so the before snapshot in the audit
+log is exactly the state the decision replaced, and only one of concurrent
+approvals reports the comment as newly published.
+*/
+func (repository *commentRepository) Moderate(context context.Context, moderation Moderation) (bool, error) {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return false, fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	lockQuery := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 AND NOT %s FOR UPDATE`,
+		schema.SocialComment.IsApproved, schema.SocialComment.Table,
+		schema.SocialComment.ID, schema.SocialComment.IsDeleted)
+
+	var wasApproved bool
+	if err := transaction.QueryRow(context, lockQuery, moderation.CommentID).Scan(&wasApproved); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, apperr.NotFound("Comment")
+		}
+		return false, fmt.Errorf("postgres: failed to lock comment: %w", err)
+	}
+
+	// Rejection deletes the comment but keeps its approval for the record
+	approved, deleted := wasApproved, false
+	switch moderation.Decision {
+	case DecisionApprove:
+		approved = true
+	case DecisionHide:
+		approved = false
+	case DecisionReject:
+		deleted = true
+	}
+
+	updateQuery := fmt.Sprintf(`UPDATE %s SET %s = $2, %s = $3, %s = NOW() WHERE %s = $1`,
+		schema.SocialComment.Table, schema.SocialComment.IsApproved, schema.SocialComment.IsDeleted,
+		schema.SocialComment.ReviewedAt, schema.SocialComment.ID)
+
+	if _, err := transaction.Exec(context, updateQuery, moderation.CommentID, approved, deleted); err != nil {
+		return false, fmt.Errorf("postgres: failed to moderate comment: %w", err)
+	}
+
+	// Audit trail
+	auditQuery := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES ($1, $2, $3, 'comment', $4, $5, $6, NOW())
+	`,
+		schema.SystemAuditLog.Table,
+		schema.SystemAuditLog.ID, schema.SystemAuditLog.ActorID, schema.SystemAuditLog.Action,
+		schema.SystemAuditLog.EntityType, schema.SystemAuditLog.EntityID,
+		schema.SystemAuditLog.Before, schema.SystemAuditLog.After, schema.SystemAuditLog.CreatedAt,
+	)
+
+	before := map[string]any{"is_approved": wasApproved, "is_deleted": false}
+	after := map[string]any{"is_approved": approved, "is_deleted": deleted, "reason": moderation.Reason}
+
+	action := "comment." + string(moderation.Decision)
+	if _, err := transaction.Exec(context, auditQuery, uuid.New(), moderation.ActorID, action, moderation.CommentID, before, after); err != nil {
+		return false, fmt.Errorf("postgres: failed to write audit log: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return false, fmt.Errorf("postgres: failed to commit moderation: %w", err)
+	}
+
+	return !wasApproved && approved && !deleted, nil
+}
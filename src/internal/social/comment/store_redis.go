@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package comment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/taibuivan/yomira/internal/platform/constants"
+)
+
+// # Rate Limit Repository
+
+// RedisRateLimitRepository implements [RateLimitRepository] using Redis.
+type RedisRateLimitRepository struct {
+	client *redis.Client
+}
+
+// NewRateLimitRepository creates a new Redis-backed [RateLimitRepository].
+func NewRateLimitRepository(client *redis.Client) *RedisRateLimitRepository {
+	return &RedisRateLimitRepository{client: client}
+}
+
+/*
+Hit counts a post against one limit of a user.
+
+Description: Fixed windows keyed by user and window length. The first post
+starts the window; later posts do not extend it.
+
+Parameters:
+  - context: context.Context
+  - userID: string (UUID)
+  - limit: RateLimit
+
+Returns:
+  - time.Duration: Remaining wait when the limit is exceeded; zero otherwise
+  - error: Execution errors
+*/
+func (repository *RedisRateLimitRepository) Hit(context context.Context, userID string, limit RateLimit) (time.Duration, error) {
+	key := fmt.Sprintf("%s%s:%d", constants.RedisPrefixCommentRate, userID, int64(limit.Window.Seconds()))
+
+	posts, err := repository.client.Incr(context, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("redis_comment_rate_incr_failed: %w", err)
+	}
+
+	if posts == 1 {
+		if err := repository.client.Expire(context, key, limit.Window).Err(); err != nil {
+			return 0, fmt.Errorf("redis_comment_rate_expire_failed: %w", err)
+		}
+	}
+
+	if posts <= int64(limit.Count) {
+		return 0, nil
+	}
+
+	remaining, err := repository.client.PTTL(context, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("redis_comment_rate_ttl_failed: %w", err)
+	}
+
+	// A key left without expiry must not block the user forever
+	if remaining <= 0 {
+		if err := repository.client.Expire(context, key, limit.Window).Err(); err != nil {
+			return 0, fmt.Errorf("redis_comment_rate_expire_failed: %w", err)
+		}
+		remaining = limit.Window
+	}
+
+	return remaining, nil
+}
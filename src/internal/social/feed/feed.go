@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+/*
+Package feed implements the personal activity feed.
+
+Core Responsibility:
+
+  - Pull model: The feed is assembled at read time from the source tables;
+    nothing is written when a chapter, list or rating is created (ADR-05).
+  - Sources: New chapters of comics on the caller's shelf or released by the
+    groups they follow, new public lists and ratings of the users they follow.
+  - Ordering: Events are ordered by the time they became visible (the
+    publish time for chapters) with the ID as a tie-breaker, and the
+    merged feed is paginated on that composite key without gaps.
+*/
+package feed
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Domain Enums
+
+// EventType classifies a feed event.
+type EventType string
+
+const (
+	// EventChapterPublished announces a chapter of a shelved comic or of a followed group.
+	EventChapterPublished EventType = "chapter_published"
+
+	// EventListCreated announces a public list created by a followed user.
+	EventListCreated EventType = "list_created"
+
+	// EventComicRated announces a rating given by a followed user.
+	EventComicRated EventType = "comic_rated"
+)
+
+// IsValid reports whether t is a recognised [EventType] value.
+func (t EventType) IsValid() bool {
+	switch t {
+	case EventChapterPublished, EventListCreated, EventComicRated:
+		return true
+	}
+	return false
+}
+
+// eventTypeValues lists every [EventType] for validation messages.
+var eventTypeValues = []string{
+	string(EventChapterPublished),
+	string(EventListCreated),
+	string(EventComicRated),
+}
+
+/*
+ParseTypes reads the event type filter from query values.
+
+Description: Accepts repeated parameters and comma-separated values alike
+("type=a&type=b" or "type=a,b"). Blank segments and duplicates are dropped;
+unknown values are kept so the service can reject them.
+
+Parameters:
+  - values: []string (Raw query values)
+
+Returns:
+  - []EventType: Requested types in order of appearance; empty for every type
+*/
+func ParseTypes(values []string) []EventType {
+	var types []EventType
+	seen := make(map[EventType]bool)
+
+	for _, value := range values {
+		for _, segment := range strings.Split(value, ",") {
+			eventType := EventType(strings.TrimSpace(segment))
+			if eventType == "" || seen[eventType] {
+				continue
+			}
+			seen[eventType] = true
+			types = append(types, eventType)
+		}
+	}
+	return types
+}
+
+// Entity types a feed event can link to.
+const (
+	EntityChapter = "chapter"
+	EntityList    = "list"
+	EntityComic   = "comic"
+)
+
+// # Core Entities
+
+// Actor is the followed user behind an event.
+type Actor struct {
+	ID          string  `json:"id"`
+	Username    string  `json:"username"`
+	DisplayName *string `json:"display_name"`
+	AvatarURL   *string `json:"avatar_url"`
+}
+
+// Comic is the comic an event refers to.
+type Comic struct {
+	ID       string  `json:"id"`
+	Title    string  `json:"title"`
+	CoverURL *string `json:"cover_url"`
+}
+
+/*
+Event is one entry of the activity feed.
+
+Description: The ID is the ID of the source row (chapter, list or rating)
+and, together with CreatedAt, forms the pagination key. Actor is nil for
+chapter releases; exactly one of Chapter, List and Rating is set, matching
+the type.
+*/
+type Event struct {
+	ID         string         `json:"id"`
+	Type       EventType      `json:"type"`
+	Actor      *Actor         `json:"actor"`
+	EntityType string         `json:"entity_type"` // Deep-link target, see the Entity constants
+	EntityID   string         `json:"entity_id"`
+	Comic      *Comic         `json:"comic,omitempty"`
+	Chapter    *ChapterDetail `json:"chapter,omitempty"`
+	List       *ListDetail    `json:"list,omitempty"`
+	Rating     *RatingDetail  `json:"rating,omitempty"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// ChapterDetail describes a published chapter.
+type ChapterDetail struct {
+	Number    float64 `json:"number"`
+	Title     *string `json:"title"`
+	Language  string  `json:"language"`
+	GroupID   *string `json:"group_id"`
+	GroupName *string `json:"group_name"`
+}
+
+// ListDetail describes a newly created list.
+type ListDetail struct {
+	Name string `json:"name"`
+}
+
+// RatingDetail describes a rating; the score is the current one.
+type RatingDetail struct {
+	Score int `json:"score"`
+}
+
+// # Queries
+
+// ListQuery selects one page of a feed.
+type ListQuery struct {
+	UserID string
+	Types  []EventType        // Empty for every type
+	Before *pagination.Cursor // Last event already seen; nil starts at the newest
+	Limit  int
+}
+
+// Includes reports whether the query selects events of the given type.
+func (query ListQuery) Includes(eventType EventType) bool {
+	return len(query.Types) == 0 || slices.Contains(query.Types, eventType)
+}
+
+// # Field Identifiers
+
+const (
+	FieldType   = "type"
+	FieldBefore = "before"
+)
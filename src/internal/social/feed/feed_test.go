@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package feed_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taibuivan/yomira/internal/social/feed"
+)
+
+/*
+TestParseTypes accepts repeated and comma-separated filters, dropping blanks
+and duplicates while keeping unknown values for validation.
+*/
+func TestParseTypes(t *testing.T) {
+	cases := []struct {
+		name   string
+		values []string
+		want   []feed.EventType
+	}{
+		{"absent", nil, nil},
+		{"blank", []string{""}, nil},
+		{"single", []string{"comic_rated"}, []feed.EventType{feed.EventComicRated}},
+		{"comma separated", []string{"chapter_published, list_created"}, []feed.EventType{feed.EventChapterPublished, feed.EventListCreated}},
+		{"repeated", []string{"list_created", "comic_rated,list_created"}, []feed.EventType{feed.EventListCreated, feed.EventComicRated}},
+		{"unknown kept", []string{"comic_followed,,"}, []feed.EventType{"comic_followed"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, feed.ParseTypes(tc.values))
+		})
+	}
+}
+
+/*
+TestListQuery_Includes selects every source without a filter and only the
+requested ones otherwise.
+*/
+func TestListQuery_Includes(t *testing.T) {
+	all := feed.ListQuery{}
+	assert.True(t, all.Includes(feed.EventChapterPublished))
+	assert.True(t, all.Includes(feed.EventListCreated))
+	assert.True(t, all.Includes(feed.EventComicRated))
+
+	ratings := feed.ListQuery{Types: []feed.EventType{feed.EventComicRated}}
+	assert.True(t, ratings.Includes(feed.EventComicRated))
+	assert.False(t, ratings.Includes(feed.EventChapterPublished))
+	assert.False(t, ratings.Includes(feed.EventListCreated))
+}
+
+// TestEventType_IsValid accepts the documented types only.
+func TestEventType_IsValid(t *testing.T) {
+	assert.True(t, feed.EventChapterPublished.IsValid())
+	assert.True(t, feed.EventListCreated.IsValid())
+	assert.True(t, feed.EventComicRated.IsValid())
+	assert.False(t, feed.EventType("user_followed").IsValid())
+	assert.False(t, feed.EventType("").IsValid())
+}
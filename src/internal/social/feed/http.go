@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package feed
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/taibuivan/yomira/internal/platform/middleware"
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Handler Implementation
+
+// Handler implements the HTTP layer for the activity feed.
+type Handler struct {
+	service *Service
+}
+
+// NewHandler constructs a new feed [Handler].
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes attaches the feed endpoint to the root API router,
+// under the /me/... namespace shared with the account domain.
+func (handler *Handler) RegisterRoutes(api chi.Router) {
+	api.Group(func(user chi.Router) {
+		user.Use(middleware.RequireAuth)
+		user.Use(middleware.RequireScope(sec.ScopeProfileRead))
+
+		user.Get("/me/feed", handler.listFeed)
+	})
+}
+
+// # Feed Endpoints
+
+/*
+GET /api/v1/me/feed.
+
+Description: Lists recent activity around the caller, newest first: new
+chapters of shelved comics and followed groups, and new public lists and
+ratings of followed users.
+
+Request:
+  - type: string (chapter_published | list_created | comic_rated, repeatable or comma-separated, optional)
+  - before: string (next_before of the previous page)
+  - limit: int
+
+Response:
+  - 200: []Event: Cursor-paginated feed
+  - 400: ErrValidation: Unknown type or malformed cursor
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) listFeed(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	query := ListQuery{UserID: userID, Types: ParseTypes(request.URL.Query()[FieldType])}
+	params := pagination.CursorFromRequest(request)
+
+	events, next, err := handler.service.ListFeed(request.Context(), query, params)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.CursorPaginated(writer, events, pagination.NewCursorMeta(params.Limit, next))
+}
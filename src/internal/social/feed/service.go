@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package feed
+
+import (
+	"context"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Service Layer
+
+// Service assembles activity feeds.
+type Service struct {
+	feedRepo FeedRepository
+}
+
+// NewService constructs a new [Service] with its required repository.
+func NewService(feedRepo FeedRepository) *Service {
+	return &Service{feedRepo: feedRepo}
+}
+
+/*
+ListFeed returns one page of the caller's activity feed, newest first.
+
+Description: The cursor holds the time and ID of the last event of the
+previous page. Events are keyed by the time they became visible, so new
+activity, including scheduled chapters going live, never shifts later pages.
+
+Parameters:
+  - context: context.Context
+  - query: ListQuery (UserID and Types; Before and Limit are taken from params)
+  - params: pagination.CursorParams
+
+Returns:
+  - []*Event: Page of events
+  - string: Cursor of the next page; empty on the last page
+  - error: Validation errors or storage failures
+*/
+func (service *Service) ListFeed(context context.Context, query ListQuery, params pagination.CursorParams) ([]*Event, string, error) {
+	validator := &validate.Validator{}
+	for _, eventType := range query.Types {
+		validator.OneOf(FieldType, string(eventType), eventTypeValues...)
+	}
+	if err := validator.Err(); err != nil {
+		return nil, "", err
+	}
+
+	before, err := pagination.DecodeCursor(params.Before)
+	if err != nil {
+		return nil, "", apperr.ValidationError("Validation failed", apperr.FieldError{Field: FieldBefore, Message: "is not a valid cursor"})
+	}
+	query.Before, query.Limit = before, params.Limit+1
+
+	events, err := service.feedRepo.List(context, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(events) <= params.Limit {
+		return events, "", nil
+	}
+
+	events = events[:params.Limit]
+	last := events[len(events)-1]
+	return events, pagination.Cursor{At: last.CreatedAt, ID: last.ID}.Encode(), nil
+}
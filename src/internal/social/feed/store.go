@@ -0,0 +1,28 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package feed
+
+import "context"
+
+// # Feed Data Access
+
+// FeedRepository defines the data access contract for activity feeds.
+type FeedRepository interface {
+
+	/*
+		List returns one page of a feed, newest first.
+
+		Description: Events are merged from their source tables at read
+		time and ordered by their UUIDv7 IDs.
+
+		Parameters:
+		  - context: context.Context
+		  - query: ListQuery (UserID, Types, Before and Limit)
+
+		Returns:
+		  - []*Event: Matching events
+		  - error: Database retrieval failures
+	*/
+	List(context context.Context, query ListQuery) ([]*Event, error)
+}
@@ -0,0 +1,324 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package feed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/taibuivan/yomira/internal/library"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+)
+
+// # PostgreSQL Repository
+
+// feedRepository implements the [FeedRepository] interface using pgx.
+type feedRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewFeedRepository constructs a PostgreSQL backed feed store.
+func NewFeedRepository(pool *pgxpool.Pool) FeedRepository {
+	return &feedRepository{pool: pool}
+}
+
+/*
+List returns one page of a feed, newest first.
+
+Description: Each selected source contributes its own newest rows below
+the cursor, capped at the page size, and the outer query merges them.
+Every branch projects the same columns, NULL where a source has nothing
+to say, so the union scans into a single [feedRow].
+
+Parameters (shared by every branch):
+  - $1: Caller
+  - $2, $3: Cursor time and ID of the last event seen, or NULL
+  - $4: Page size
+*/
+func (repository *feedRepository) List(context context.Context, query ListQuery) ([]*Event, error) {
+	var beforeAt, beforeID any
+	if query.Before != nil {
+		beforeAt, beforeID = query.Before.At, query.Before.ID
+	}
+	args := []any{query.UserID, beforeAt, beforeID, query.Limit}
+
+	var branches []string
+	if query.Includes(EventChapterPublished) {
+		args = append(args, library.StatusDropped)
+		branches = append(branches, chapterBranch(len(args)))
+	}
+	if query.Includes(EventListCreated) {
+		args = append(args, library.VisibilityPublic)
+		branches = append(branches, listBranch(len(args)))
+	}
+	if query.Includes(EventComicRated) {
+		branches = append(branches, ratingBranch())
+	}
+
+	statement := fmt.Sprintf(`
+		SELECT * FROM (%s) feed
+		ORDER BY createdat DESC, id DESC
+		LIMIT $4
+	`, strings.Join(branches, " UNION ALL "))
+
+	rows, err := repository.pool.Query(context, statement, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list feed: %w", err)
+	}
+
+	events, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*Event, error) {
+		var scanned feedRow
+		err := row.Scan(
+			&scanned.ID, &scanned.Type, &scanned.CreatedAt,
+			&scanned.ActorID, &scanned.ActorUsername, &scanned.ActorDisplayName, &scanned.ActorAvatarURL,
+			&scanned.ComicID, &scanned.ComicTitle, &scanned.ComicCoverURL,
+			&scanned.ChapterNumber, &scanned.ChapterTitle, &scanned.Language, &scanned.GroupID, &scanned.GroupName,
+			&scanned.ListName, &scanned.Score,
+		)
+		return scanned.event(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to scan feed event: %w", err)
+	}
+
+	return events, nil
+}
+
+// # Sources
+
+/*
+chapterBranch selects published chapters of comics on the caller's shelf
+or released by a group they follow.
+
+Description: Dropped entries are skipped. A chapter matching both sources
+is selected once. Scheduled chapters stay hidden until their PublishedAt,
+which is also their feed time, so they enter at the top when released.
+
+Parameters:
+  - droppedArg: int (Placeholder of the dropped reading status)
+*/
+func chapterBranch(droppedArg int) string {
+	return fmt.Sprintf(`(
+		SELECT ch.%[1]s AS id, '%[2]s' AS type, ch.%[3]s AS createdat,
+			NULL::text, NULL::text, NULL::text, NULL::text,
+			c.%[4]s, c.%[5]s, c.%[6]s,
+			ch.%[7]s::float8, ch.%[8]s, COALESCE(l.%[9]s, ''), ch.%[10]s, g.%[11]s,
+			NULL::text, NULL::int
+		FROM %[12]s ch
+		JOIN %[13]s c ON c.%[4]s = ch.%[14]s AND c.%[15]s IS NULL
+		LEFT JOIN %[16]s l ON l.%[17]s = ch.%[18]s
+		LEFT JOIN %[19]s g ON g.%[20]s = ch.%[10]s
+		WHERE ch.%[21]s IS NULL AND ch.%[3]s <= NOW()
+			AND (
+				ch.%[14]s IN (SELECT %[22]s FROM %[23]s WHERE %[24]s = $1 AND %[25]s <> $%[26]d)
+				OR ch.%[10]s IN (SELECT %[27]s FROM %[28]s WHERE %[29]s = $1)
+			)
+			AND ($2::timestamptz IS NULL OR (ch.%[3]s, ch.%[1]s) < ($2, $3))
+		ORDER BY ch.%[3]s DESC, ch.%[1]s DESC
+		LIMIT $4
+	)`,
+		schema.CoreChapter.ID,                // 1
+		EventChapterPublished,                // 2
+		schema.CoreChapter.PublishedAt,       // 3
+		schema.CoreComic.ID,                  // 4
+		schema.CoreComic.Title,               // 5
+		schema.CoreComic.CoverURL,            // 6
+		schema.CoreChapter.Number,            // 7
+		schema.CoreChapter.Title,             // 8
+		schema.RefLanguage.Code,              // 9
+		schema.CoreChapter.ScanlationGroupID, // 10
+		schema.CoreGroup.Name,                // 11
+		schema.CoreChapter.Table,             // 12
+		schema.CoreComic.Table,               // 13
+		schema.CoreChapter.ComicID,           // 14
+		schema.CoreComic.DeletedAt,           // 15
+		schema.RefLanguage.Table,             // 16
+		schema.RefLanguage.ID,                // 17
+		schema.CoreChapter.LanguageID,        // 18
+		schema.CoreGroup.Table,               // 19
+		schema.CoreGroup.ID,                  // 20
+		schema.CoreChapter.DeletedAt,         // 21
+		schema.LibraryEntry.ComicID,          // 22
+		schema.LibraryEntry.Table,            // 23
+		schema.LibraryEntry.UserID,           // 24
+		schema.LibraryEntry.ReadingStatus,    // 25
+		droppedArg,                           // 26
+		schema.CoreFollow.GroupID,            // 27
+		schema.CoreFollow.Table,              // 28
+		schema.CoreFollow.UserID,             // 29
+	)
+}
+
+/*
+listBranch selects public lists created by users the caller follows.
+
+Description: Unlisted and private lists never surface, nor do lists of
+deleted accounts.
+
+Parameters:
+  - visibilityArg: int (Placeholder of the public visibility)
+*/
+func listBranch(visibilityArg int) string {
+	return fmt.Sprintf(`(
+		SELECT li.%[1]s AS id, '%[2]s' AS type, li.%[3]s AS createdat,
+			a.%[4]s, a.%[5]s, a.%[6]s, a.%[7]s,
+			NULL::text, NULL::text, NULL::text,
+			NULL::float8, NULL::text, NULL::text, NULL::text, NULL::text,
+			li.%[8]s, NULL::int
+		FROM %[9]s li
+		JOIN %[10]s a ON a.%[4]s = li.%[11]s AND a.%[12]s IS NULL
+		WHERE li.%[13]s IS NULL AND li.%[14]s = $%[15]d
+			AND li.%[11]s IN (SELECT %[16]s FROM %[17]s WHERE %[18]s = $1)
+			AND ($2::timestamptz IS NULL OR (li.%[3]s, li.%[1]s) < ($2, $3))
+		ORDER BY li.%[3]s DESC, li.%[1]s DESC
+		LIMIT $4
+	)`,
+		schema.LibraryCustomList.ID,         // 1
+		EventListCreated,                    // 2
+		schema.LibraryCustomList.CreatedAt,  // 3
+		schema.UserAccount.ID,               // 4
+		schema.UserAccount.Username,         // 5
+		schema.UserAccount.DisplayName,      // 6
+		schema.UserAccount.AvatarURL,        // 7
+		schema.LibraryCustomList.Name,       // 8
+		schema.LibraryCustomList.Table,      // 9
+		schema.UserAccount.Table,            // 10
+		schema.LibraryCustomList.UserID,     // 11
+		schema.UserAccount.DeletedAt,        // 12
+		schema.LibraryCustomList.DeletedAt,  // 13
+		schema.LibraryCustomList.Visibility, // 14
+		visibilityArg,                       // 15
+		schema.UserFollow.FollowingID,       // 16
+		schema.UserFollow.Table,             // 17
+		schema.UserFollow.FollowerID,        // 18
+	)
+}
+
+/*
+ratingBranch selects ratings given by users the caller follows.
+
+Description: A rating keeps its ID and creation time when the score
+changes, so it stays at its original position and shows the current score.
+*/
+func ratingBranch() string {
+	return fmt.Sprintf(`(
+		SELECT r.%[1]s AS id, '%[2]s' AS type, r.%[3]s AS createdat,
+			a.%[4]s, a.%[5]s, a.%[6]s, a.%[7]s,
+			c.%[8]s, c.%[9]s, c.%[10]s,
+			NULL::float8, NULL::text, NULL::text, NULL::text, NULL::text,
+			NULL::text, r.%[11]s::int
+		FROM %[12]s r
+		JOIN %[13]s a ON a.%[4]s = r.%[14]s AND a.%[15]s IS NULL
+		JOIN %[16]s c ON c.%[8]s = r.%[17]s AND c.%[18]s IS NULL
+		WHERE r.%[14]s IN (SELECT %[19]s FROM %[20]s WHERE %[21]s = $1)
+			AND ($2::timestamptz IS NULL OR (r.%[3]s, r.%[1]s) < ($2, $3))
+		ORDER BY r.%[3]s DESC, r.%[1]s DESC
+		LIMIT $4
+	)`,
+		schema.SocialComicRating.ID,        // 1
+		EventComicRated,                    // 2
+		schema.SocialComicRating.CreatedAt, // 3
+		schema.UserAccount.ID,              // 4
+		schema.UserAccount.Username,        // 5
+		schema.UserAccount.DisplayName,     // 6
+		schema.UserAccount.AvatarURL,       // 7
+		schema.CoreComic.ID,                // 8
+		schema.CoreComic.Title,             // 9
+		schema.CoreComic.CoverURL,          // 10
+		schema.SocialComicRating.Score,     // 11
+		schema.SocialComicRating.Table,     // 12
+		schema.UserAccount.Table,           // 13
+		schema.SocialComicRating.UserID,    // 14
+		schema.UserAccount.DeletedAt,       // 15
+		schema.CoreComic.Table,             // 16
+		schema.SocialComicRating.ComicID,   // 17
+		schema.CoreComic.DeletedAt,         // 18
+		schema.UserFollow.FollowingID,      // 19
+		schema.UserFollow.Table,            // 20
+		schema.UserFollow.FollowerID,       // 21
+	)
+}
+
+// # Row Mapping
+
+// feedRow is one row of the merged feed, with every source column nullable.
+type feedRow struct {
+	ID        string
+	Type      EventType
+	CreatedAt time.Time
+
+	ActorID          *string
+	ActorUsername    *string
+	ActorDisplayName *string
+	ActorAvatarURL   *string
+
+	ComicID       *string
+	ComicTitle    *string
+	ComicCoverURL *string
+
+	ChapterNumber *float64
+	ChapterTitle  *string
+	Language      *string
+	GroupID       *string
+	GroupName     *string
+
+	ListName *string
+	Score    *int
+}
+
+// event shapes the row into an [Event] according to its type.
+func (row feedRow) event() *Event {
+	event := &Event{ID: row.ID, Type: row.Type, CreatedAt: row.CreatedAt}
+
+	if row.ActorID != nil {
+		event.Actor = &Actor{
+			ID:          *row.ActorID,
+			Username:    deref(row.ActorUsername),
+			DisplayName: row.ActorDisplayName,
+			AvatarURL:   row.ActorAvatarURL,
+		}
+	}
+
+	if row.ComicID != nil {
+		event.Comic = &Comic{ID: *row.ComicID, Title: deref(row.ComicTitle), CoverURL: row.ComicCoverURL}
+	}
+
+	switch row.Type {
+	case EventChapterPublished:
+		event.EntityType, event.EntityID = EntityChapter, row.ID
+		event.Chapter = &ChapterDetail{
+			Title:     row.ChapterTitle,
+			Language:  deref(row.Language),
+			GroupID:   row.GroupID,
+			GroupName: row.GroupName,
+		}
+		if row.ChapterNumber != nil {
+			event.Chapter.Number = *row.ChapterNumber
+		}
+
+	case EventListCreated:
+		event.EntityType, event.EntityID = EntityList, row.ID
+		event.List = &ListDetail{Name: deref(row.ListName)}
+
+	case EventComicRated:
+		event.EntityType, event.EntityID = EntityComic, deref(row.ComicID)
+		if row.Score != nil {
+			event.Rating = &RatingDetail{Score: *row.Score}
+		}
+	}
+
+	return event
+}
+
+// deref returns the string behind a nullable column, empty for NULL.
+func deref(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package notification
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/middleware"
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Handler Implementation
+
+// Handler implements the HTTP layer for the notification center.
+type Handler struct {
+	service *Service
+	hub     *Hub
+}
+
+// NewHandler constructs a new notification [Handler].
+func NewHandler(service *Service, hub *Hub) *Handler {
+	return &Handler{service: service, hub: hub}
+}
+
+// RegisterRoutes attaches inbox endpoints to the root API router.
+// All of them live under the /me/... namespace shared with the account domain.
+func (handler *Handler) RegisterRoutes(api chi.Router) {
+	api.Group(func(user chi.Router) {
+		user.Use(middleware.RequireAuth)
+		user.Use(middleware.RequireScopeByMethod(sec.ScopeProfileRead, sec.ScopeProfileWrite))
+
+		// Inbox
+		user.Get("/me/notifications", handler.listNotifications)
+		user.Get("/me/notifications/unread-count", handler.unreadCount)
+		user.Get("/me/notifications/stream", handler.stream)
+
+		// Read state
+		user.Patch("/me/notifications/read-all", handler.markAllRead)
+		user.Patch("/me/notifications/{id}/read", handler.markRead)
+		user.Delete("/me/notifications/{id}", handler.deleteNotification)
+	})
+}
+
+// # Inbox Endpoints
+
+/*
+GET /api/v1/me/notifications.
+
+Description: Lists the caller's notifications, newest first.
+
+Request:
+  - type: string (new_chapter | comment_reply | group_invite | report_resolved, optional)
+  - is_read: bool (optional)
+  - before: string (next_before of the previous page)
+  - limit: int
+
+Response:
+  - 200: []Notification: Cursor-paginated inbox
+  - 400: ErrValidation: Unknown type, malformed is_read or cursor
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) listNotifications(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	query := ListQuery{UserID: userID, Kind: Kind(request.URL.Query().Get(FieldType))}
+
+	if raw := request.URL.Query().Get(FieldIsRead); raw != "" {
+		isRead, err := strconv.ParseBool(raw)
+		if err != nil {
+			respond.Error(writer, request, apperr.ValidationError("Validation failed", apperr.FieldError{Field: FieldIsRead, Message: "must be true or false"}))
+			return
+		}
+		query.IsRead = &isRead
+	}
+
+	params := pagination.CursorFromRequest(request)
+
+	notifications, next, err := handler.service.ListNotifications(request.Context(), query, params)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.CursorPaginated(writer, notifications, pagination.NewCursorMeta(params.Limit, next))
+}
+
+/*
+GET /api/v1/me/notifications/unread-count.
+
+Description: Returns the number for the header badge. Cheap enough to poll.
+
+Response:
+  - 200: {"count": int}
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) unreadCount(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	count, err := handler.service.UnreadCount(request.Context(), userID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, map[string]int{"count": count})
+}
+
+// # Read State Endpoints
+
+/*
+PATCH /api/v1/me/notifications/read-all.
+
+Description: Marks every notification of the caller as read.
+
+Response:
+  - 200: {"marked_count": int}: Notifications that were unread
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) markAllRead(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	marked, err := handler.service.MarkAllRead(request.Context(), userID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, map[string]int{"marked_count": marked})
+}
+
+/*
+PATCH /api/v1/me/notifications/{id}/read.
+
+Description: Marks one notification as read. Idempotent.
+
+Response:
+  - 204: No Content
+  - 401: ErrUnauthorized: Authentication required
+  - 404: ErrNotFound: Notification not found in the caller's inbox
+*/
+func (handler *Handler) markRead(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.service.MarkRead(request.Context(), userID, requestutil.ID(request, "id")); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+/*
+DELETE /api/v1/me/notifications/{id}.
+
+Description: Removes one notification from the caller's inbox.
+
+Response:
+  - 204: No Content
+  - 401: ErrUnauthorized: Authentication required
+  - 404: ErrNotFound: Notification not found in the caller's inbox
+*/
+func (handler *Handler) deleteNotification(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.service.DeleteNotification(request.Context(), userID, requestutil.ID(request, "id")); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
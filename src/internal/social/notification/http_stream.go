@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/ctxutil"
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+)
+
+// StreamPath is the full path of the live stream, exempt from the global request timeout.
+const StreamPath = "/api/v1/me/notifications/stream"
+
+// # Live Stream Endpoint
+
+/*
+GET /api/v1/me/notifications/stream.
+
+Description: Server-Sent Events stream of the caller's inbox. Sends the
+unread count on connect, then a "notification" event for every new
+notification and an "unread_count" event whenever the count changes.
+Comment heartbeats keep idle connections open. The stream closes when the
+access token expires or after [StreamMaxLifetime]; clients reconnect with
+a fresh token.
+
+Response:
+  - 200: text/event-stream
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) stream(writer http.ResponseWriter, request *http.Request) {
+	claims, err := requestutil.RequiredClaims(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	// The server read and write timeouts would cut the stream; deadlines are set per write instead
+	controller := http.NewResponseController(writer)
+	if err := controller.SetReadDeadline(time.Time{}); err != nil {
+		respond.Error(writer, request, apperr.Internal(err))
+		return
+	}
+
+	subscription := handler.hub.Subscribe(claims.UserID)
+	defer handler.hub.Unsubscribe(subscription)
+
+	header := writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Accel-Buffering", "no")
+	writer.WriteHeader(http.StatusOK)
+
+	events := &eventWriter{writer: writer, controller: controller}
+	if err := events.retry(StreamRetry); err != nil {
+		return
+	}
+	if err := handler.sendCount(request, events, claims.UserID); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(StreamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	lifetime := time.NewTimer(streamLifetime(claims))
+	defer lifetime.Stop()
+
+	for {
+		select {
+		case <-request.Context().Done():
+			return
+
+		case <-lifetime.C:
+			return
+
+		case <-heartbeat.C:
+			if err := events.comment("heartbeat"); err != nil {
+				return
+			}
+
+		case update, ok := <-subscription.Updates():
+			if !ok {
+				return
+			}
+
+			if update.Notification != nil {
+				if err := events.send(string(EventNotification), update.Notification); err != nil {
+					return
+				}
+			}
+			if err := handler.sendCount(request, events, claims.UserID); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// sendCount writes the current unread count; lookup failures skip the event without closing the stream.
+func (handler *Handler) sendCount(request *http.Request, events *eventWriter, userID string) error {
+	count, err := handler.service.UnreadCount(request.Context(), userID)
+	if err != nil {
+		ctxutil.GetLogger(request.Context()).Error("notification_stream_count_failed", slog.Any("error", err))
+		return nil
+	}
+
+	return events.send(string(EventUnreadCount), map[string]int{"count": count})
+}
+
+// streamLifetime caps a stream at [StreamMaxLifetime] and at the expiry of its access token.
+func streamLifetime(claims *sec.AuthClaims) time.Duration {
+	lifetime := StreamMaxLifetime
+	if claims.ExpiresAt != nil {
+		if untilExpiry := time.Until(claims.ExpiresAt.Time); untilExpiry < lifetime {
+			lifetime = max(untilExpiry, 0)
+		}
+	}
+	return lifetime
+}
+
+// # Event Encoding
+
+// eventWriter writes Server-Sent Events, flushing and bounding every write.
+type eventWriter struct {
+	writer     http.ResponseWriter
+	controller *http.ResponseController
+}
+
+// send writes one named event with a JSON payload.
+func (events *eventWriter) send(name string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return events.write(fmt.Sprintf("event: %s\ndata: %s\n\n", name, data))
+}
+
+// comment writes a comment line, ignored by clients.
+func (events *eventWriter) comment(text string) error {
+	return events.write(": " + text + "\n\n")
+}
+
+// retry sets the reconnect delay of EventSource clients.
+func (events *eventWriter) retry(delay time.Duration) error {
+	return events.write(fmt.Sprintf("retry: %d\n\n", delay.Milliseconds()))
+}
+
+// write sends a frame within [StreamWriteTimeout] so a stalled client ends the stream.
+func (events *eventWriter) write(frame string) error {
+	if err := events.controller.SetWriteDeadline(time.Now().Add(StreamWriteTimeout)); err != nil {
+		return err
+	}
+	if _, err := events.writer.Write([]byte(frame)); err != nil {
+		return err
+	}
+	return events.controller.Flush()
+}
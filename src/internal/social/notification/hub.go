@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// # Live Hub
+
+/*
+Hub relays broadcast inbox changes to the streams open on this replica.
+
+Description: Each replica holds a single Redis subscription, whatever the
+number of open streams, and routes every event to the local subscribers
+of its recipients. Delivery never blocks: a stream whose buffer is full
+misses the update and catches up with the next one, since the inbox
+itself stays authoritative.
+*/
+type Hub struct {
+	eventRepo EventRepository
+	logger    *slog.Logger
+
+	mu          sync.Mutex
+	subscribers map[string]map[*Subscription]struct{}
+	closed      bool
+}
+
+// Subscription is one open stream of a user.
+type Subscription struct {
+	userID  string
+	updates chan Update
+}
+
+// Updates returns the channel of updates; it is closed when the hub shuts down.
+func (subscription *Subscription) Updates() <-chan Update {
+	return subscription.updates
+}
+
+// NewHub constructs a new [Hub].
+func NewHub(eventRepo EventRepository, logger *slog.Logger) *Hub {
+	return &Hub{
+		eventRepo:   eventRepo,
+		logger:      logger,
+		subscribers: make(map[string]map[*Subscription]struct{}),
+	}
+}
+
+/*
+Run listens for broadcast events until the context ends, then closes every
+subscription so open streams finish before the server shuts down.
+
+Parameters:
+  - context: context.Context (Application lifetime)
+*/
+func (hub *Hub) Run(context context.Context) {
+	defer hub.close()
+
+	for {
+		err := hub.eventRepo.Listen(context, hub.dispatch)
+		if context.Err() != nil {
+			return
+		}
+		if err != nil {
+			hub.logger.Error("notification_hub_listen_failed", slog.Any("error", err))
+		}
+
+		select {
+		case <-context.Done():
+			return
+		case <-time.After(HubReconnectDelay):
+		}
+	}
+}
+
+/*
+Subscribe opens a subscription for a user.
+
+Description: After shutdown the returned subscription is already closed.
+
+Parameters:
+  - userID: string
+
+Returns:
+  - *Subscription: Must be released with [Hub.Unsubscribe]
+*/
+func (hub *Hub) Subscribe(userID string) *Subscription {
+	subscription := &Subscription{userID: userID, updates: make(chan Update, StreamBufferSize)}
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if hub.closed {
+		close(subscription.updates)
+		return subscription
+	}
+
+	if hub.subscribers[userID] == nil {
+		hub.subscribers[userID] = make(map[*Subscription]struct{})
+	}
+	hub.subscribers[userID][subscription] = struct{}{}
+
+	return subscription
+}
+
+// Unsubscribe releases a subscription.
+func (hub *Hub) Unsubscribe(subscription *Subscription) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	streams := hub.subscribers[subscription.userID]
+	if _, ok := streams[subscription]; !ok {
+		return
+	}
+
+	delete(streams, subscription)
+	if len(streams) == 0 {
+		delete(hub.subscribers, subscription.userID)
+	}
+	close(subscription.updates)
+}
+
+// dispatch routes an event to the local subscribers of its recipients.
+func (hub *Hub) dispatch(event Event) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	for _, recipient := range event.Recipients {
+		streams := hub.subscribers[recipient.UserID]
+		if len(streams) == 0 {
+			continue
+		}
+
+		update := Update{}
+		if event.Type == EventNotification && event.Notification != nil {
+			notification := *event.Notification
+			notification.ID, notification.UserID = recipient.NotificationID, recipient.UserID
+			update.Notification = &notification
+		}
+
+		for subscription := range streams {
+			select {
+			case subscription.updates <- update:
+			default:
+				hub.logger.Warn("notification_stream_lagging", slog.String("user_id", recipient.UserID))
+			}
+		}
+	}
+}
+
+// close ends every subscription and refuses new ones.
+func (hub *Hub) close() {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	hub.closed = true
+	for userID, streams := range hub.subscribers {
+		for subscription := range streams {
+			close(subscription.updates)
+		}
+		delete(hub.subscribers, userID)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package notification_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taibuivan/yomira/internal/social/notification"
+)
+
+// channelEvents replays events pushed on a channel, standing in for Redis pub/sub.
+type channelEvents struct {
+	events chan notification.Event
+}
+
+func (repository *channelEvents) Publish(_ context.Context, event notification.Event) error {
+	repository.events <- event
+	return nil
+}
+
+func (repository *channelEvents) Listen(ctx context.Context, handle func(notification.Event)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-repository.events:
+			handle(event)
+		}
+	}
+}
+
+// receive waits briefly for the next update of a subscription.
+func receive(t *testing.T, subscription *notification.Subscription) notification.Update {
+	t.Helper()
+
+	select {
+	case update, ok := <-subscription.Updates():
+		require.True(t, ok, "subscription closed")
+		return update
+	case <-time.After(time.Second):
+		t.Fatal("no update received")
+		return notification.Update{}
+	}
+}
+
+/*
+TestHub_Dispatch delivers fan-out events to every stream of a recipient with
+the recipient's own notification ID, and nothing to other users.
+*/
+func TestHub_Dispatch(t *testing.T) {
+	repository := &channelEvents{events: make(chan notification.Event)}
+	hub := notification.NewHub(repository, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go hub.Run(ctx)
+
+	firstTab, secondTab := hub.Subscribe("alice"), hub.Subscribe("alice")
+	other := hub.Subscribe("bob")
+
+	require.NoError(t, repository.Publish(ctx, notification.Event{
+		Type:         notification.EventNotification,
+		Notification: &notification.Notification{Kind: notification.KindNewChapter, Title: "Chapter 12 is out!"},
+		Recipients: []notification.Recipient{
+			{UserID: "alice", NotificationID: "n-alice"},
+			{UserID: "carol", NotificationID: "n-carol"},
+		},
+	}))
+
+	for _, subscription := range []*notification.Subscription{firstTab, secondTab} {
+		update := receive(t, subscription)
+		require.NotNil(t, update.Notification)
+		assert.Equal(t, "n-alice", update.Notification.ID)
+		assert.Equal(t, "alice", update.Notification.UserID)
+		assert.Equal(t, "Chapter 12 is out!", update.Notification.Title)
+	}
+
+	require.NoError(t, repository.Publish(ctx, notification.Event{
+		Type:       notification.EventUnreadCount,
+		Recipients: []notification.Recipient{{UserID: "bob"}},
+	}))
+
+	assert.Nil(t, receive(t, other).Notification)
+	assert.Empty(t, firstTab.Updates())
+}
+
+/*
+TestHub_Close ends open subscriptions when the application stops and hands
+out closed subscriptions afterwards.
+*/
+func TestHub_Close(t *testing.T) {
+	repository := &channelEvents{events: make(chan notification.Event)}
+	hub := notification.NewHub(repository, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		hub.Run(ctx)
+		close(done)
+	}()
+
+	subscription := hub.Subscribe("alice")
+	hub.Unsubscribe(hub.Subscribe("alice"))
+
+	cancel()
+	<-done
+
+	_, ok := <-subscription.Updates()
+	assert.False(t, ok)
+
+	_, ok = <-hub.Subscribe("alice").Updates()
+	assert.False(t, ok)
+
+	// Releasing after shutdown is a no-op
+	hub.Unsubscribe(subscription)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/system/batch"
+)
+
+// # Background Jobs
+
+/*
+FanoutJob announces newly published chapters in the inboxes of their readers.
+
+Description: Sweeps the chapters published since the previous successful
+run, including scheduled chapters whose PublishedAt has passed, and
+delivers one [KindNewChapter] notification to every reader who shelved
+the comic and has not dropped it. Runs next to the library "has new"
+sweep and uses the same window.
+*/
+type FanoutJob struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+// NewFanoutJob constructs a new [FanoutJob].
+func NewFanoutJob(service *Service, logger *slog.Logger) *FanoutJob {
+	return &FanoutJob{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Definition describes the job for the batch scheduler.
+func (job *FanoutJob) Definition() batch.Job {
+	return batch.Job{
+		Key:         FanoutJobKey,
+		Description: "Notify readers about newly published chapters of shelved comics",
+		Cron:        FanoutCron,
+		Timeout:     FanoutTimeout,
+		Handler: func(context context.Context, execution batch.Execution) (batch.Result, error) {
+			notified, chapters, err := job.Sweep(context, execution.PreviousSuccessAt)
+			return batch.Result{
+				RowsAffected: int64(notified),
+				Meta:         map[string]any{"chapters": chapters, "notifications_created": notified},
+			}, err
+		},
+	}
+}
+
+/*
+Sweep notifies the readers of every chapter published since the given watermark.
+
+Parameters:
+  - context: context.Context
+  - since: *time.Time (Start of the previous successful sweep; nil = first run)
+
+Returns:
+  - int: Number of notifications created
+  - int: Number of chapters announced
+  - error: Repository level errors
+*/
+func (job *FanoutJob) Sweep(context context.Context, since *time.Time) (int, int, error) {
+	until := time.Now().UTC()
+
+	// Window resolution with overlap for late commits
+	from := until.Add(-FanoutLookback)
+	if since != nil {
+		from = since.Add(-FanoutOverlap)
+	}
+
+	notified, chapters, err := job.service.FanOutReleases(context, from, until)
+	if err != nil {
+		return notified, chapters, err
+	}
+
+	if notified > 0 {
+		job.logger.Info("notification_fanout_finished",
+			slog.Int("chapters", chapters),
+			slog.Int("notifications_created", notified),
+		)
+	}
+
+	return notified, chapters, nil
+}
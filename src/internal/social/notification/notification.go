@@ -0,0 +1,242 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+/*
+Package notification implements the in-app notification center.
+
+Core Responsibility:
+
+  - Inbox: Typed notifications per user, listed newest first with cursor
+    pagination, marked read one by one or all at once.
+  - Fan-out: A batch job notifies every reader who shelved a comic when one
+    of its chapters is published, in keyset batches so popular comics never
+    load all their followers at once.
+  - Badge: Unread counts are cached in Redis and adjusted on every write, so
+    polling the header badge does not touch PostgreSQL.
+  - Live: Every inbox change is published on a Redis channel; each API
+    replica relays the changes of its connected users over Server-Sent
+    Events through its [Hub].
+
+Other domains deliver single notifications through [Service.Notify].
+*/
+package notification
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Domain Enums
+
+// Kind classifies a notification.
+type Kind string
+
+const (
+	// KindNewChapter announces a chapter of a comic on the user's shelf.
+	KindNewChapter Kind = "new_chapter"
+
+	// KindCommentReply announces a reply to one of the user's comments.
+	KindCommentReply Kind = "comment_reply"
+
+	// KindGroupInvite announces that the user was added to a scanlation group.
+	KindGroupInvite Kind = "group_invite"
+
+	// KindReportResolved announces the outcome of a report the user filed.
+	KindReportResolved Kind = "report_resolved"
+)
+
+// IsValid reports whether k is a recognised [Kind] value.
+func (k Kind) IsValid() bool {
+	switch k {
+	case KindNewChapter, KindCommentReply, KindGroupInvite, KindReportResolved:
+		return true
+	}
+	return false
+}
+
+// Entity types a notification can link to.
+const (
+	EntityChapter = "chapter"
+	EntityComment = "comment"
+	EntityGroup   = "group"
+	EntityReport  = "report"
+)
+
+// # Core Entities
+
+// Notification is one entry of a user's inbox.
+type Notification struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"-"`
+	Kind       Kind      `json:"type"`
+	Title      string    `json:"title"`
+	Body       string    `json:"body"`
+	EntityType string    `json:"entity_type"` // Deep-link target, see the Entity constants
+	EntityID   string    `json:"entity_id"`
+	IsRead     bool      `json:"is_read"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Release is a newly published chapter to announce to the comic's readers.
+type Release struct {
+	ChapterID    string
+	ComicID      string
+	ComicTitle   string
+	Number       float64
+	ChapterTitle string
+	PublishedAt  time.Time
+}
+
+/*
+Message renders the notification announcing the release.
+
+Returns:
+  - *Notification: Template without ID and recipient
+*/
+func (release Release) Message() *Notification {
+	number := strconv.FormatFloat(release.Number, 'f', -1, 64)
+
+	return &Notification{
+		Kind:       KindNewChapter,
+		Title:      release.ComicTitle + " — Chapter " + number + " is out!",
+		Body:       release.ChapterTitle,
+		EntityType: EntityChapter,
+		EntityID:   release.ChapterID,
+		CreatedAt:  release.PublishedAt,
+	}
+}
+
+// Excerpt shortens text for a notification body, cutting on a word boundary when possible.
+func Excerpt(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= MaxExcerptLength {
+		return text
+	}
+
+	runes := []rune(text)[:MaxExcerptLength]
+	cut := string(runes)
+	if index := strings.LastIndex(cut, " "); index > MaxExcerptLength/2 {
+		cut = cut[:index]
+	}
+	return cut + "…"
+}
+
+// Recipient pairs a user with the notification stored for them.
+type Recipient struct {
+	UserID         string `json:"user_id"`
+	NotificationID string `json:"notification_id,omitempty"` // Empty for count changes
+}
+
+// # Live Events
+
+// EventType classifies an inbox change broadcast between replicas.
+type EventType string
+
+const (
+	// EventNotification announces new notifications.
+	EventNotification EventType = "notification"
+
+	// EventUnreadCount announces that unread counts changed without a new notification.
+	EventUnreadCount EventType = "unread_count"
+)
+
+/*
+Event is an inbox change published to every API replica.
+
+Description: A fan-out batch travels as one event; Notification is the
+shared template and each recipient carries the ID of their own copy.
+*/
+type Event struct {
+	Type         EventType     `json:"type"`
+	Notification *Notification `json:"notification,omitempty"`
+	Recipients   []Recipient   `json:"recipients"`
+}
+
+// Update is what a [Subscription] receives for its user.
+type Update struct {
+	Notification *Notification // nil when only the unread count changed
+}
+
+// # Queries
+
+// ListQuery selects one page of an inbox.
+type ListQuery struct {
+	UserID string
+	Kind   Kind  // Empty for every kind
+	IsRead *bool // nil for read and unread notifications
+	Before *pagination.Cursor
+	Limit  int
+}
+
+// # Constraints
+
+const (
+	// MaxExcerptLength is the longest quoted text in a notification body, in characters.
+	MaxExcerptLength = 200
+
+	// UnreadCountTTL bounds how long a cached unread count lives; a miss recounts
+	// from the partial index, which also heals any drift.
+	UnreadCountTTL = 10 * time.Minute
+)
+
+// # Fan-out Job
+
+const (
+	// FanoutJobKey identifies the new-chapter fan-out in the batch registry.
+	FanoutJobKey = "notifications.new_chapter"
+
+	// FanoutCron is the default schedule of the fan-out job.
+	FanoutCron = "* * * * *"
+
+	// FanoutTimeout bounds a single fan-out run.
+	FanoutTimeout = 10 * time.Minute
+
+	// FanoutOverlap re-scans the tail of the previous window so chapters
+	// committed late are not missed; recipients already notified are skipped.
+	FanoutOverlap = 5 * time.Minute
+
+	// FanoutLookback is the window scanned on the first run after startup.
+	FanoutLookback = 24 * time.Hour
+
+	// FanoutBatchSize caps the recipients written per statement.
+	FanoutBatchSize = 1000
+)
+
+// # Live Stream
+
+const (
+	// StreamHeartbeatInterval keeps idle streams alive through proxies that
+	// drop silent connections, and detects gone clients between events.
+	StreamHeartbeatInterval = 20 * time.Second
+
+	// StreamWriteTimeout bounds every single write to a stream; the server-wide
+	// WriteTimeout is lifted for streams.
+	StreamWriteTimeout = 10 * time.Second
+
+	// StreamMaxLifetime closes streams periodically so clients reconnect and
+	// present a fresh token; streams also end when their access token expires.
+	StreamMaxLifetime = time.Hour
+
+	// StreamRetry is the reconnect delay suggested to EventSource clients.
+	StreamRetry = 5 * time.Second
+
+	// StreamBufferSize is the number of updates buffered per stream; updates
+	// for a stream that falls further behind are dropped.
+	StreamBufferSize = 16
+
+	// HubReconnectDelay is the pause before a replica re-subscribes after the
+	// Redis subscription failed.
+	HubReconnectDelay = 5 * time.Second
+)
+
+// # Field Identifiers
+
+const (
+	FieldType   = "type"
+	FieldIsRead = "is_read"
+	FieldBefore = "before"
+)
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package notification_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taibuivan/yomira/internal/social/notification"
+)
+
+/*
+TestRelease_Message renders fractional chapter numbers without trailing
+zeros and links the notification to the chapter.
+*/
+func TestRelease_Message(t *testing.T) {
+	publishedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name   string
+		number float64
+		title  string
+	}{
+		{"whole chapter", 12, "Solo Leveling — Chapter 12 is out!"},
+		{"extra chapter", 12.5, "Solo Leveling — Chapter 12.5 is out!"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			message := notification.Release{
+				ChapterID:    "chapter-1",
+				ComicID:      "comic-1",
+				ComicTitle:   "Solo Leveling",
+				Number:       tc.number,
+				ChapterTitle: "The Return",
+				PublishedAt:  publishedAt,
+			}.Message()
+
+			assert.Equal(t, notification.KindNewChapter, message.Kind)
+			assert.Equal(t, tc.title, message.Title)
+			assert.Equal(t, "The Return", message.Body)
+			assert.Equal(t, notification.EntityChapter, message.EntityType)
+			assert.Equal(t, "chapter-1", message.EntityID)
+			assert.Equal(t, publishedAt, message.CreatedAt)
+		})
+	}
+}
+
+/*
+TestExcerpt keeps short texts, collapses whitespace and cuts long texts on
+a word boundary.
+*/
+func TestExcerpt(t *testing.T) {
+	assert.Equal(t, "short reply", notification.Excerpt("  short \n\n reply "))
+
+	long := strings.Repeat("word ", 100)
+	excerpt := notification.Excerpt(long)
+
+	assert.True(t, strings.HasSuffix(excerpt, "word…"))
+	assert.LessOrEqual(t, utf8.RuneCountInString(excerpt), notification.MaxExcerptLength+1)
+
+	unbroken := strings.Repeat("a", 300)
+	assert.Equal(t, strings.Repeat("a", notification.MaxExcerptLength)+"…", notification.Excerpt(unbroken))
+}
+
+/*
+TestKind_IsValid accepts the known kinds only.
+*/
+func TestKind_IsValid(t *testing.T) {
+	for _, kind := range []notification.Kind{
+		notification.KindNewChapter, notification.KindCommentReply,
+		notification.KindGroupInvite, notification.KindReportResolved,
+	} {
+		assert.True(t, kind.IsValid(), kind)
+	}
+
+	assert.False(t, notification.Kind("").IsValid())
+	assert.False(t, notification.Kind("mention").IsValid())
+}
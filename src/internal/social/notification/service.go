@@ -0,0 +1,294 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/pkg/pagination"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # Service Layer
+
+// Service orchestrates inboxes, unread counters and the new-chapter fan-out.
+type Service struct {
+	notificationRepo NotificationRepository
+	counterRepo      UnreadCounterRepository
+	eventRepo        EventRepository
+	logger           *slog.Logger
+}
+
+// NewService constructs a new [Service] with its required repositories.
+func NewService(notificationRepo NotificationRepository, counterRepo UnreadCounterRepository, eventRepo EventRepository, logger *slog.Logger) *Service {
+	return &Service{
+		notificationRepo: notificationRepo,
+		counterRepo:      counterRepo,
+		eventRepo:        eventRepo,
+		logger:           logger,
+	}
+}
+
+// # Delivery
+
+/*
+Notify stores a notification in the inbox of notification.UserID.
+
+Description: Used by other domains for one-off events such as replies and
+group invites. The unread counter and the live streams are updated
+best-effort; a failure only leaves the badge stale until the cached count
+expires or the client reloads the inbox.
+
+Parameters:
+  - context: context.Context
+  - notification: *Notification (UserID, Kind, texts and entity)
+
+Returns:
+  - error: Storage failures
+*/
+func (service *Service) Notify(context context.Context, notification *Notification) error {
+	notification.ID = uuid.New()
+	notification.IsRead = false
+	if notification.CreatedAt.IsZero() {
+		notification.CreatedAt = time.Now().UTC()
+	}
+
+	if err := service.notificationRepo.Create(context, notification); err != nil {
+		return err
+	}
+
+	recipient := Recipient{UserID: notification.UserID, NotificationID: notification.ID}
+	service.adjustCounters(context, 1, recipient.UserID)
+	service.publish(context, Event{Type: EventNotification, Notification: notification, Recipients: []Recipient{recipient}})
+	return nil
+}
+
+/*
+FanOutReleases notifies the readers of every chapter published in a window.
+
+Description: Readers are paged by user ID in [FanoutBatchSize] batches and
+written with one statement per batch, so a comic with a large following
+never holds all its readers in memory. Readers notified in an earlier run
+are skipped by the repository.
+
+Parameters:
+  - context: context.Context
+  - from: time.Time (Exclusive)
+  - until: time.Time (Inclusive)
+
+Returns:
+  - int: Notifications created
+  - int: Chapters announced
+  - error: Storage failures
+*/
+func (service *Service) FanOutReleases(context context.Context, from, until time.Time) (int, int, error) {
+	releases, err := service.notificationRepo.ListReleases(context, from, until)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var notified int
+	for _, release := range releases {
+		message := release.Message()
+
+		after := ""
+		for {
+			readers, err := service.notificationRepo.ListReaders(context, release.ComicID, after, FanoutBatchSize)
+			if err != nil {
+				return notified, len(releases), err
+			}
+			if len(readers) == 0 {
+				break
+			}
+
+			recipients, err := service.notificationRepo.CreateBatch(context, message, readers)
+			if err != nil {
+				return notified, len(releases), err
+			}
+
+			if len(recipients) > 0 {
+				userIDs := make([]string, len(recipients))
+				for index, recipient := range recipients {
+					userIDs[index] = recipient.UserID
+				}
+
+				service.adjustCounters(context, 1, userIDs...)
+				service.publish(context, Event{Type: EventNotification, Notification: message, Recipients: recipients})
+				notified += len(recipients)
+			}
+
+			if len(readers) < FanoutBatchSize {
+				break
+			}
+			after = readers[len(readers)-1]
+		}
+	}
+
+	return notified, len(releases), nil
+}
+
+// # Inbox
+
+/*
+ListNotifications returns one page of the caller's inbox, newest first.
+
+Parameters:
+  - context: context.Context
+  - query: ListQuery (UserID and filters; Before and Limit are taken from params)
+  - params: pagination.CursorParams
+
+Returns:
+  - []*Notification: Page of notifications
+  - string: Cursor of the next page; empty on the last page
+  - error: Validation errors or storage failures
+*/
+func (service *Service) ListNotifications(context context.Context, query ListQuery, params pagination.CursorParams) ([]*Notification, string, error) {
+	if query.Kind != "" && !query.Kind.IsValid() {
+		return nil, "", apperr.ValidationError("Validation failed", apperr.FieldError{
+			Field:   FieldType,
+			Message: "must be one of: new_chapter, comment_reply, group_invite, report_resolved",
+		})
+	}
+
+	before, err := pagination.DecodeCursor(params.Before)
+	if err != nil {
+		return nil, "", apperr.ValidationError("Validation failed", apperr.FieldError{Field: FieldBefore, Message: "is not a valid cursor"})
+	}
+	query.Before, query.Limit = before, params.Limit+1
+
+	notifications, err := service.notificationRepo.List(context, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(notifications) <= params.Limit {
+		return notifications, "", nil
+	}
+
+	notifications = notifications[:params.Limit]
+	last := notifications[len(notifications)-1]
+	return notifications, pagination.Cursor{At: last.CreatedAt, ID: last.ID}.Encode(), nil
+}
+
+/*
+UnreadCount returns the number of unread notifications of a user.
+
+Description: Served from Redis. On a miss, or when Redis is unavailable,
+the count is taken from PostgreSQL and cached again.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - int: Unread notifications
+  - error: Storage failures
+*/
+func (service *Service) UnreadCount(context context.Context, userID string) (int, error) {
+	count, found, err := service.counterRepo.Get(context, userID)
+	if err != nil {
+		service.logger.Error("notification_unread_cache_failed", slog.String("user_id", userID), slog.Any("error", err))
+	}
+	if found {
+		return count, nil
+	}
+
+	count, err = service.notificationRepo.CountUnread(context, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := service.counterRepo.Set(context, userID, count); err != nil {
+		service.logger.Error("notification_unread_cache_failed", slog.String("user_id", userID), slog.Any("error", err))
+	}
+
+	return count, nil
+}
+
+// MarkRead marks one notification of the caller as read.
+func (service *Service) MarkRead(context context.Context, userID, id string) error {
+	wasUnread, err := service.notificationRepo.MarkRead(context, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if wasUnread {
+		service.adjustCounters(context, -1, userID)
+		service.publishCount(context, userID)
+	}
+	return nil
+}
+
+/*
+MarkAllRead marks every notification of the caller as read.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - int: Notifications that were unread
+  - error: Storage failures
+*/
+func (service *Service) MarkAllRead(context context.Context, userID string) (int, error) {
+	marked, err := service.notificationRepo.MarkAllRead(context, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := service.counterRepo.Set(context, userID, 0); err != nil {
+		service.logger.Error("notification_unread_cache_failed", slog.String("user_id", userID), slog.Any("error", err))
+	}
+
+	if marked > 0 {
+		service.publishCount(context, userID)
+	}
+	return marked, nil
+}
+
+// DeleteNotification removes one notification from the caller's inbox.
+func (service *Service) DeleteNotification(context context.Context, userID, id string) error {
+	wasUnread, err := service.notificationRepo.Delete(context, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if wasUnread {
+		service.adjustCounters(context, -1, userID)
+		service.publishCount(context, userID)
+	}
+	return nil
+}
+
+// # Internal Helpers
+
+// adjustCounters updates cached unread counts; failures are logged because the cache heals on expiry.
+func (service *Service) adjustCounters(context context.Context, delta int, userIDs ...string) {
+	if err := service.counterRepo.Add(context, delta, userIDs...); err != nil {
+		service.logger.Error("notification_unread_cache_failed",
+			slog.Int("users", len(userIDs)),
+			slog.Int("delta", delta),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// publish broadcasts an inbox change to the live streams; failures are logged because the inbox stays authoritative.
+func (service *Service) publish(context context.Context, event Event) {
+	if err := service.eventRepo.Publish(context, event); err != nil {
+		service.logger.Error("notification_publish_failed",
+			slog.String("type", string(event.Type)),
+			slog.Int("recipients", len(event.Recipients)),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// publishCount tells the live streams of a user that the unread count changed.
+func (service *Service) publishCount(context context.Context, userID string) {
+	service.publish(context, Event{Type: EventUnreadCount, Recipients: []Recipient{{UserID: userID}}})
+}
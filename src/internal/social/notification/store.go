@@ -0,0 +1,223 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package notification
+
+import (
+	"context"
+	"time"
+)
+
+// # Notification Data Access
+
+// NotificationRepository defines the data access contract for inboxes.
+type NotificationRepository interface {
+
+	/*
+		List returns one page of an inbox, newest first.
+
+		Parameters:
+		  - context: context.Context
+		  - query: ListQuery
+
+		Returns:
+		  - []*Notification: Matching notifications
+		  - error: Database retrieval failures
+	*/
+	List(context context.Context, query ListQuery) ([]*Notification, error)
+
+	/*
+		Create stores a single notification.
+
+		Parameters:
+		  - context: context.Context
+		  - notification: *Notification (ID and UserID set)
+
+		Returns:
+		  - error: Storage failures
+	*/
+	Create(context context.Context, notification *Notification) error
+
+	/*
+		CreateBatch stores the same notification for many recipients.
+
+		Description: Recipients who already received a notification of the
+		same kind for the same entity are skipped, so re-running a fan-out
+		never duplicates entries.
+
+		Parameters:
+		  - context: context.Context
+		  - template: *Notification (Kind, texts, entity and CreatedAt)
+		  - userIDs: []string
+
+		Returns:
+		  - []Recipient: Recipients that were actually notified, with their notification IDs
+		  - error: Storage failures
+	*/
+	CreateBatch(context context.Context, template *Notification, userIDs []string) ([]Recipient, error)
+
+	/*
+		MarkRead marks one notification of a user as read.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string (Owner)
+		  - id: string (UUID)
+
+		Returns:
+		  - bool: True if the notification was unread
+		  - error: apperr.NotFound if missing or owned by someone else
+	*/
+	MarkRead(context context.Context, userID, id string) (bool, error)
+
+	/*
+		MarkAllRead marks every unread notification of a user as read.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - int: Number of notifications changed
+		  - error: Storage failures
+	*/
+	MarkAllRead(context context.Context, userID string) (int, error)
+
+	/*
+		Delete removes one notification of a user.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string (Owner)
+		  - id: string (UUID)
+
+		Returns:
+		  - bool: True if the notification was unread
+		  - error: apperr.NotFound if missing or owned by someone else
+	*/
+	Delete(context context.Context, userID, id string) (bool, error)
+
+	/*
+		CountUnread counts the unread notifications of a user.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - int: Unread notifications
+		  - error: Database retrieval failures
+	*/
+	CountUnread(context context.Context, userID string) (int, error)
+
+	/*
+		ListReleases returns the chapters published within a window.
+
+		Parameters:
+		  - context: context.Context
+		  - from: time.Time (Exclusive lower bound)
+		  - until: time.Time (Inclusive upper bound)
+
+		Returns:
+		  - []Release: Chapters of live comics, oldest first
+		  - error: Database retrieval failures
+	*/
+	ListReleases(context context.Context, from, until time.Time) ([]Release, error)
+
+	/*
+		ListReaders returns one batch of users who shelved a comic.
+
+		Description: Dropped entries are skipped. Batches are ordered by
+		user ID; pass the last ID of a batch to fetch the next one.
+
+		Parameters:
+		  - context: context.Context
+		  - comicID: string (UUID)
+		  - afterUserID: string (Empty for the first batch)
+		  - limit: int
+
+		Returns:
+		  - []string: User IDs
+		  - error: Database retrieval failures
+	*/
+	ListReaders(context context.Context, comicID, afterUserID string, limit int) ([]string, error)
+}
+
+// # Unread Counters
+
+// UnreadCounterRepository caches the unread count of each inbox.
+type UnreadCounterRepository interface {
+
+	/*
+		Get returns the cached unread count of a user.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - int: Cached count
+		  - bool: False on a cache miss
+		  - error: Connectivity errors
+	*/
+	Get(context context.Context, userID string) (int, bool, error)
+
+	/*
+		Set caches the unread count of a user for [UnreadCountTTL].
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - count: int
+
+		Returns:
+		  - error: Connectivity errors
+	*/
+	Set(context context.Context, userID string, count int) error
+
+	/*
+		Add adjusts the cached counts of several users.
+
+		Description: Only counts that are cached are adjusted, and never
+		below zero; a missing count is rebuilt on the next read instead.
+
+		Parameters:
+		  - context: context.Context
+		  - delta: int
+		  - userIDs: ...string
+
+		Returns:
+		  - error: Connectivity errors
+	*/
+	Add(context context.Context, delta int, userIDs ...string) error
+}
+
+// # Live Events
+
+// EventRepository broadcasts inbox changes between API replicas.
+type EventRepository interface {
+
+	/*
+		Publish broadcasts an event to every replica.
+
+		Parameters:
+		  - context: context.Context
+		  - event: Event
+
+		Returns:
+		  - error: Connectivity errors
+	*/
+	Publish(context context.Context, event Event) error
+
+	/*
+		Listen delivers broadcast events until the context ends.
+
+		Parameters:
+		  - context: context.Context
+		  - handle: func(Event) (Called sequentially)
+
+		Returns:
+		  - error: Subscription failures; nil once the context ends
+	*/
+	Listen(context context.Context, handle func(Event)) error
+}
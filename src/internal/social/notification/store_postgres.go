@@ -0,0 +1,343 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/taibuivan/yomira/internal/library"
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # PostgreSQL Repositories
+
+// notificationRepository implements the [NotificationRepository] interface using pgx.
+type notificationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewNotificationRepository constructs a PostgreSQL backed notification store.
+func NewNotificationRepository(pool *pgxpool.Pool) NotificationRepository {
+	return &notificationRepository{pool: pool}
+}
+
+// notificationProjection selects the columns hydrated by [scanNotification].
+var notificationProjection = fmt.Sprintf(`
+	SELECT %s, %s, %s, %s, COALESCE(%s, ''), COALESCE(%s, ''), COALESCE(%s, ''), %s, %s
+	FROM %s`,
+	schema.SocialNotification.ID, schema.SocialNotification.UserID, schema.SocialNotification.Type,
+	schema.SocialNotification.Title, schema.SocialNotification.Body,
+	schema.SocialNotification.EntityType, schema.SocialNotification.EntityID,
+	schema.SocialNotification.IsRead, schema.SocialNotification.CreatedAt,
+	schema.SocialNotification.Table,
+)
+
+// scanNotification hydrates a row selected by [notificationProjection].
+func scanNotification(row pgx.Row) (*Notification, error) {
+	notification := &Notification{}
+	err := row.Scan(
+		&notification.ID,
+		&notification.UserID,
+		&notification.Kind,
+		&notification.Title,
+		&notification.Body,
+		&notification.EntityType,
+		&notification.EntityID,
+		&notification.IsRead,
+		&notification.CreatedAt,
+	)
+	return notification, err
+}
+
+// # Inbox Implementation
+
+// List returns one page of an inbox, newest first.
+func (repository *notificationRepository) List(context context.Context, query ListQuery) ([]*Notification, error) {
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(notificationProjection)
+	queryBuilder.WriteString(fmt.Sprintf(" WHERE %s = $1", schema.SocialNotification.UserID))
+
+	args := []any{query.UserID}
+	argID := 2
+
+	// Filters
+	if query.Kind != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" AND %s = $%d", schema.SocialNotification.Type, argID))
+		args = append(args, query.Kind)
+		argID++
+	}
+
+	if query.IsRead != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND %s = $%d", schema.SocialNotification.IsRead, argID))
+		args = append(args, *query.IsRead)
+		argID++
+	}
+
+	// Keyset pagination
+	if query.Before != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND (%s, %s) < ($%d, $%d)",
+			schema.SocialNotification.CreatedAt, schema.SocialNotification.ID, argID, argID+1))
+		args = append(args, query.Before.At, query.Before.ID)
+		argID += 2
+	}
+
+	queryBuilder.WriteString(fmt.Sprintf(" ORDER BY %s DESC, %s DESC LIMIT $%d",
+		schema.SocialNotification.CreatedAt, schema.SocialNotification.ID, argID))
+	args = append(args, query.Limit)
+
+	rows, err := repository.pool.Query(context, queryBuilder.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list notifications: %w", err)
+	}
+
+	notifications, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*Notification, error) {
+		return scanNotification(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to scan notification: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// Create stores a single notification.
+func (repository *notificationRepository) Create(context context.Context, notification *Notification) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, FALSE, $8)
+	`,
+		schema.SocialNotification.Table,
+		schema.SocialNotification.ID, schema.SocialNotification.UserID, schema.SocialNotification.Type,
+		schema.SocialNotification.Title, schema.SocialNotification.Body,
+		schema.SocialNotification.EntityType, schema.SocialNotification.EntityID,
+		schema.SocialNotification.IsRead, schema.SocialNotification.CreatedAt,
+	)
+
+	_, err := repository.pool.Exec(context, query,
+		notification.ID,
+		notification.UserID,
+		notification.Kind,
+		notification.Title,
+		notification.Body,
+		notification.EntityType,
+		notification.EntityID,
+		notification.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to create notification: %w", err)
+	}
+
+	return nil
+}
+
+/*
+CreateBatch stores the same notification for many recipients.
+
+Description: IDs are generated here, one UUIDv7 per recipient, and the rows
+are written with a single INSERT ... SELECT FROM unnest. The NOT EXISTS
+guard makes overlapping fan-out windows harmless.
+*/
+func (repository *notificationRepository) CreateBatch(context context.Context, template *Notification, userIDs []string) ([]Recipient, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(userIDs))
+	for index := range ids {
+		ids[index] = uuid.New()
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s, %[6]s, %[7]s, %[8]s, %[9]s, %[10]s)
+		SELECT r.id, r.userid, $3, $4, NULLIF($5, ''), $6, $7, FALSE, $8
+		FROM unnest($1::text[], $2::text[]) AS r(id, userid)
+		WHERE NOT EXISTS (
+			SELECT 1 FROM %[1]s n
+			WHERE n.%[3]s = r.userid AND n.%[4]s = $3 AND n.%[7]s = $6 AND n.%[8]s = $7
+		)
+		RETURNING %[3]s, %[2]s
+	`,
+		schema.SocialNotification.Table,      // 1
+		schema.SocialNotification.ID,         // 2
+		schema.SocialNotification.UserID,     // 3
+		schema.SocialNotification.Type,       // 4
+		schema.SocialNotification.Title,      // 5
+		schema.SocialNotification.Body,       // 6
+		schema.SocialNotification.EntityType, // 7
+		schema.SocialNotification.EntityID,   // 8
+		schema.SocialNotification.IsRead,     // 9
+		schema.SocialNotification.CreatedAt,  // 10
+	)
+
+	rows, err := repository.pool.Query(context, query,
+		ids, userIDs,
+		template.Kind, template.Title, template.Body,
+		template.EntityType, template.EntityID, template.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to create notifications: %w", err)
+	}
+
+	notified, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Recipient, error) {
+		var recipient Recipient
+		err := row.Scan(&recipient.UserID, &recipient.NotificationID)
+		return recipient, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to collect notified users: %w", err)
+	}
+
+	return notified, nil
+}
+
+// MarkRead marks one notification of a user as read.
+func (repository *notificationRepository) MarkRead(context context.Context, userID, id string) (bool, error) {
+	query := fmt.Sprintf(`
+		UPDATE %[1]s n SET %[2]s = TRUE
+		FROM (SELECT %[2]s FROM %[1]s WHERE %[3]s = $1 AND %[4]s = $2 FOR UPDATE) previous
+		WHERE n.%[3]s = $1
+		RETURNING NOT previous.%[2]s
+	`,
+		schema.SocialNotification.Table,  // 1
+		schema.SocialNotification.IsRead, // 2
+		schema.SocialNotification.ID,     // 3
+		schema.SocialNotification.UserID, // 4
+	)
+
+	var wasUnread bool
+	if err := repository.pool.QueryRow(context, query, id, userID).Scan(&wasUnread); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, apperr.NotFound("Notification")
+		}
+		return false, fmt.Errorf("postgres: failed to mark notification read: %w", err)
+	}
+
+	return wasUnread, nil
+}
+
+// MarkAllRead marks every unread notification of a user as read.
+func (repository *notificationRepository) MarkAllRead(context context.Context, userID string) (int, error) {
+	query := fmt.Sprintf(`UPDATE %s SET %s = TRUE WHERE %s = $1 AND NOT %s`,
+		schema.SocialNotification.Table, schema.SocialNotification.IsRead,
+		schema.SocialNotification.UserID, schema.SocialNotification.IsRead)
+
+	tag, err := repository.pool.Exec(context, query, userID)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to mark notifications read: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// Delete removes one notification of a user.
+func (repository *notificationRepository) Delete(context context.Context, userID, id string) (bool, error) {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1 AND %s = $2 RETURNING NOT %s`,
+		schema.SocialNotification.Table, schema.SocialNotification.ID,
+		schema.SocialNotification.UserID, schema.SocialNotification.IsRead)
+
+	var wasUnread bool
+	if err := repository.pool.QueryRow(context, query, id, userID).Scan(&wasUnread); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, apperr.NotFound("Notification")
+		}
+		return false, fmt.Errorf("postgres: failed to delete notification: %w", err)
+	}
+
+	return wasUnread, nil
+}
+
+// CountUnread counts the unread notifications of a user using the partial unread index.
+func (repository *notificationRepository) CountUnread(context context.Context, userID string) (int, error) {
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s = $1 AND NOT %s`,
+		schema.SocialNotification.Table, schema.SocialNotification.UserID, schema.SocialNotification.IsRead)
+
+	var count int
+	if err := repository.pool.QueryRow(context, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("postgres: failed to count unread notifications: %w", err)
+	}
+
+	return count, nil
+}
+
+// # Fan-out Implementation
+
+/*
+ListReleases returns the chapters published within a window.
+
+Description: Only the publication time counts, so editing an old chapter
+never announces it again. Scheduled chapters surface on the first run
+after their PublishedAt.
+*/
+func (repository *notificationRepository) ListReleases(context context.Context, from, until time.Time) ([]Release, error) {
+	query := fmt.Sprintf(`
+		SELECT ch.%[1]s, ch.%[2]s, c.%[3]s, ch.%[4]s, COALESCE(ch.%[5]s, ''), ch.%[6]s
+		FROM %[7]s ch
+		JOIN %[8]s c ON c.%[9]s = ch.%[2]s AND c.%[10]s IS NULL
+		WHERE ch.%[11]s IS NULL AND ch.%[6]s > $1 AND ch.%[6]s <= $2
+		ORDER BY ch.%[6]s, ch.%[1]s
+	`,
+		schema.CoreChapter.ID,          // 1
+		schema.CoreChapter.ComicID,     // 2
+		schema.CoreComic.Title,         // 3
+		schema.CoreChapter.Number,      // 4
+		schema.CoreChapter.Title,       // 5
+		schema.CoreChapter.PublishedAt, // 6
+		schema.CoreChapter.Table,       // 7
+		schema.CoreComic.Table,         // 8
+		schema.CoreComic.ID,            // 9
+		schema.CoreComic.DeletedAt,     // 10
+		schema.CoreChapter.DeletedAt,   // 11
+	)
+
+	rows, err := repository.pool.Query(context, query, from, until)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list releases: %w", err)
+	}
+
+	releases, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Release, error) {
+		var release Release
+		err := row.Scan(&release.ChapterID, &release.ComicID, &release.ComicTitle, &release.Number, &release.ChapterTitle, &release.PublishedAt)
+		return release, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to scan release: %w", err)
+	}
+
+	return releases, nil
+}
+
+// ListReaders returns one batch of users who shelved a comic, ordered by user ID.
+func (repository *notificationRepository) ListReaders(context context.Context, comicID, afterUserID string, limit int) ([]string, error) {
+	query := fmt.Sprintf(`
+		SELECT %[1]s FROM %[2]s
+		WHERE %[3]s = $1 AND %[4]s <> $2 AND %[1]s > $3
+		ORDER BY %[1]s
+		LIMIT $4
+	`,
+		schema.LibraryEntry.UserID,        // 1
+		schema.LibraryEntry.Table,         // 2
+		schema.LibraryEntry.ComicID,       // 3
+		schema.LibraryEntry.ReadingStatus, // 4
+	)
+
+	rows, err := repository.pool.Query(context, query, comicID, library.StatusDropped, afterUserID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list readers: %w", err)
+	}
+
+	readers, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to collect readers: %w", err)
+	}
+
+	return readers, nil
+}
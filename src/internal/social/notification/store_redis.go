@@ -0,0 +1,192 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/taibuivan/yomira/internal/platform/constants"
+)
+
+// # Unread Counter Repository
+
+// adjustScript adds ARGV[1] to every cached count in KEYS, skipping missing
+// keys and clamping at zero without touching the TTL.
+var adjustScript = redis.NewScript(`
+for _, key in ipairs(KEYS) do
+	if redis.call("EXISTS", key) == 1 then
+		if redis.call("INCRBY", key, ARGV[1]) < 0 then
+			redis.call("SET", key, 0, "KEEPTTL")
+		end
+	end
+end
+return 0
+`)
+
+// RedisUnreadCounterRepository implements [UnreadCounterRepository] using Redis.
+type RedisUnreadCounterRepository struct {
+	client *redis.Client
+}
+
+// NewUnreadCounterRepository creates a new Redis-backed [UnreadCounterRepository].
+func NewUnreadCounterRepository(client *redis.Client) *RedisUnreadCounterRepository {
+	return &RedisUnreadCounterRepository{client: client}
+}
+
+/*
+Get returns the cached unread count of a user.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - int: Cached count
+  - bool: False on a cache miss
+  - error: Execution errors
+*/
+func (repository *RedisUnreadCounterRepository) Get(context context.Context, userID string) (int, bool, error) {
+	count, err := repository.client.Get(context, unreadKey(userID)).Int()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("redis_unread_get_failed: %w", err)
+	}
+
+	return count, true, nil
+}
+
+/*
+Set caches the unread count of a user for [UnreadCountTTL].
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - count: int
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *RedisUnreadCounterRepository) Set(context context.Context, userID string, count int) error {
+	if err := repository.client.Set(context, unreadKey(userID), count, UnreadCountTTL).Err(); err != nil {
+		return fmt.Errorf("redis_unread_set_failed: %w", err)
+	}
+	return nil
+}
+
+/*
+Add adjusts the cached counts of several users in one round trip.
+
+Parameters:
+  - context: context.Context
+  - delta: int
+  - userIDs: ...string
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *RedisUnreadCounterRepository) Add(context context.Context, delta int, userIDs ...string) error {
+	if len(userIDs) == 0 || delta == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(userIDs))
+	for index, userID := range userIDs {
+		keys[index] = unreadKey(userID)
+	}
+
+	if err := adjustScript.Run(context, repository.client, keys, delta).Err(); err != nil {
+		return fmt.Errorf("redis_unread_add_failed: %w", err)
+	}
+	return nil
+}
+
+// unreadKey returns the cache key of a user's unread count.
+func unreadKey(userID string) string {
+	return constants.RedisPrefixUnreadNotifications + userID
+}
+
+// # Event Repository
+
+// RedisEventRepository implements [EventRepository] using Redis pub/sub.
+type RedisEventRepository struct {
+	client *redis.Client
+}
+
+// NewEventRepository creates a new Redis-backed [EventRepository].
+func NewEventRepository(client *redis.Client) *RedisEventRepository {
+	return &RedisEventRepository{client: client}
+}
+
+/*
+Publish broadcasts an event on the notification channel.
+
+Parameters:
+  - context: context.Context
+  - event: Event
+
+Returns:
+  - error: Encoding or execution errors
+*/
+func (repository *RedisEventRepository) Publish(context context.Context, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("redis_notification_encode_failed: %w", err)
+	}
+
+	if err := repository.client.Publish(context, constants.RedisChannelNotifications, payload).Err(); err != nil {
+		return fmt.Errorf("redis_notification_publish_failed: %w", err)
+	}
+	return nil
+}
+
+/*
+Listen delivers events of the notification channel until the context ends.
+
+Description: The client re-subscribes on its own after connection drops;
+events published meanwhile are lost, which only delays live updates.
+Payloads that do not decode are skipped.
+
+Parameters:
+  - context: context.Context
+  - handle: func(Event)
+
+Returns:
+  - error: Subscription failures; nil once the context ends
+*/
+func (repository *RedisEventRepository) Listen(context context.Context, handle func(Event)) error {
+	subscription := repository.client.Subscribe(context, constants.RedisChannelNotifications)
+	defer subscription.Close()
+
+	// Wait for the confirmation so a dead Redis surfaces as an error
+	if _, err := subscription.Receive(context); err != nil {
+		if context.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("redis_notification_subscribe_failed: %w", err)
+	}
+
+	messages := subscription.Channel()
+	for {
+		select {
+		case <-context.Done():
+			return nil
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			var event Event
+			if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+				continue
+			}
+			handle(event)
+		}
+	}
+}
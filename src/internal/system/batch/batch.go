@@ -0,0 +1,193 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+/*
+Package batch runs registered background jobs on cron-style schedules.
+
+Jobs are plain Go functions registered at startup by their owning domain.
+Every API replica runs the scheduler, but a Redis lock guarantees that a
+given job executes on at most one replica at a time.
+
+Core Responsibility:
+
+  - Registry: Holds the [Job] definitions contributed by domains.
+  - Scheduling: Evaluates [Schedule] cron expressions, with persisted admin overrides.
+  - Execution: Runs jobs under the application context with timeouts and cancellation.
+  - History: Persists every execution as a [Run] record for the admin console.
+*/
+package batch
+
+import (
+	"context"
+	"time"
+)
+
+// # Domain Enums
+
+// RunStatus describes where a job execution stands in its lifecycle.
+type RunStatus string
+
+const (
+	// StatusQueued indicates the run was accepted but has not started yet.
+	StatusQueued RunStatus = "queued"
+
+	// StatusRunning indicates the job handler is executing.
+	StatusRunning RunStatus = "running"
+
+	// StatusDone indicates the job finished successfully.
+	StatusDone RunStatus = "done"
+
+	// StatusFailed indicates the job returned an error or timed out.
+	StatusFailed RunStatus = "failed"
+
+	// StatusCancelled indicates the run was cancelled by an admin or by shutdown.
+	StatusCancelled RunStatus = "cancelled"
+)
+
+// IsValid reports whether s is a recognised [RunStatus] value.
+func (s RunStatus) IsValid() bool {
+	switch s {
+	case
+		StatusQueued,
+		StatusRunning,
+		StatusDone,
+		StatusFailed,
+		StatusCancelled:
+		return true
+	}
+	return false
+}
+
+// IsActive reports whether the run has not reached a terminal state yet.
+func (s RunStatus) IsActive() bool {
+	return s == StatusQueued || s == StatusRunning
+}
+
+// Trigger identifies what started a run.
+type Trigger string
+
+const (
+	// TriggerScheduler marks runs started by the cron schedule.
+	TriggerScheduler Trigger = "scheduler"
+
+	// TriggerAdmin marks runs started manually from the admin console.
+	TriggerAdmin Trigger = "admin"
+)
+
+// # Job Definitions
+
+// JobFunc executes one run of a job.
+//
+// Implementations must honour context cancellation; the context carries the job
+// timeout, admin cancellation and application shutdown.
+type JobFunc func(context context.Context, execution Execution) (Result, error)
+
+// Job describes a background task contributed by a domain.
+type Job struct {
+	Key         string        // Stable identifier, e.g. "library.hasnew"
+	Description string        // Human readable summary for the admin console
+	Cron        string        // Default schedule (5-field cron or '@' descriptor)
+	Timeout     time.Duration // Upper bound for a single run; zero = DefaultJobTimeout
+	Handler     JobFunc
+}
+
+// Execution carries the inputs of a single run to its [JobFunc].
+type Execution struct {
+	RunID   string
+	Trigger Trigger
+	Params  map[string]any // Admin supplied parameters (nil for scheduled runs)
+
+	// PreviousSuccessAt is when the last successful run of the job started,
+	// letting incremental jobs resume from a persisted watermark.
+	PreviousSuccessAt *time.Time
+}
+
+// Result reports what a run achieved.
+type Result struct {
+	RowsAffected int64
+	Meta         map[string]any // Job specific statistics
+}
+
+// # Run Entities
+
+// Run is the persisted record of one job execution.
+type Run struct {
+	ID                string         `json:"id"`
+	JobKey            string         `json:"job_key"`
+	Status            RunStatus      `json:"status"`
+	TriggeredBy       Trigger        `json:"triggered_by"`
+	TriggeredByUserID *string        `json:"triggered_by_user_id"`
+	StartedAt         *time.Time     `json:"started_at"`
+	FinishedAt        *time.Time     `json:"finished_at"`
+	DurationMs        *int64         `json:"duration_ms"`
+	RowsAffected      *int64         `json:"rows_affected"`
+	LastError         *string        `json:"last_error"`
+	Meta              map[string]any `json:"meta"`
+	CreatedAt         time.Time      `json:"created_at"`
+}
+
+// ScheduleEntry is the effective schedule of a registered job.
+type ScheduleEntry struct {
+	JobKey      string     `json:"job_key"`
+	Description string     `json:"description"`
+	Cron        string     `json:"cron"`
+	IsEnabled   bool       `json:"is_enabled"`
+	LastRun     *Run       `json:"last_run"`
+	NextRunAt   *time.Time `json:"next_run_at"`
+}
+
+// ScheduleOverride is an admin change to a job's default schedule.
+type ScheduleOverride struct {
+	JobKey    string
+	Cron      string
+	IsEnabled bool
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+// # Search & Filtering
+
+// RunFilter holds the parameters for a filtered run history query.
+type RunFilter struct {
+	JobKey      string
+	Status      RunStatus
+	TriggeredBy Trigger
+	From        *time.Time
+	To          *time.Time
+}
+
+// # Constraints
+
+const (
+	// DefaultJobTimeout bounds a run when the job does not declare its own timeout.
+	DefaultJobTimeout = 30 * time.Minute
+
+	// LockTTL is the lifetime of a job lock between heartbeats. A crashed replica
+	// releases its jobs after at most this long.
+	LockTTL = time.Minute
+
+	// HeartbeatInterval is how often a running job refreshes its lock and polls for cancellation.
+	HeartbeatInterval = 10 * time.Second
+
+	// SlotClaimTTL keeps scheduled activation claims long enough to cover clock skew between replicas.
+	SlotClaimTTL = 10 * time.Minute
+
+	// DefaultHistoryWindow is how far back the run history reaches without an explicit 'from'.
+	DefaultHistoryWindow = 7 * 24 * time.Hour
+
+	// MaxErrorLength truncates stored error messages.
+	MaxErrorLength = 2000
+)
+
+// # Field Identifiers
+
+// Global field names for validation and dynamic query mapping.
+const (
+	FieldJobKey      = "job_key"
+	FieldStatus      = "status"
+	FieldTriggeredBy = "triggered_by"
+	FieldFrom        = "from"
+	FieldTo          = "to"
+	FieldCron        = "cron"
+	FieldIsEnabled   = "is_enabled"
+)
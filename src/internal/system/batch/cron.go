@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package batch
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// # Cron Expressions
+
+// cronField describes the bounds of one position in a 5-field cron expression.
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [5]cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7}, // 0 and 7 are both Sunday
+}
+
+// cronDescriptors maps the supported shorthand expressions to their 5-field form.
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronSearchLimit bounds [Schedule.Next] so impossible dates (e.g. 30 February) terminate.
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+/*
+Schedule is a parsed 5-field cron expression evaluated in UTC.
+
+Description: Supports '*', single values, ranges ('1-5'), lists ('1,15')
+and steps ('0-30/5', '5/15'). When both day of month and day of week are
+restricted, a day matches if either does, as in classic cron.
+*/
+type Schedule struct {
+	expression string
+	fields     [5]uint64 // Bitset of allowed values per field
+	domAny     bool
+	dowAny     bool
+}
+
+/*
+ParseCron parses a 5-field cron expression or one of the '@' descriptors.
+
+Parameters:
+  - expression: string (e.g. "30 2 * * *" or "@hourly")
+
+Returns:
+  - *Schedule: The parsed schedule
+  - error: Syntax or range errors
+*/
+func ParseCron(expression string) (*Schedule, error) {
+	normalised := strings.TrimSpace(expression)
+	if descriptor, ok := cronDescriptors[strings.ToLower(normalised)]; ok {
+		normalised = descriptor
+	}
+
+	parts := strings.Fields(normalised)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("cron: expected %d fields, got %d", len(cronFields), len(parts))
+	}
+
+	schedule := &Schedule{expression: strings.TrimSpace(expression)}
+	for index, part := range parts {
+		bits, err := parseCronField(part, cronFields[index])
+		if err != nil {
+			return nil, err
+		}
+		schedule.fields[index] = bits
+	}
+
+	// Sunday folding (7 -> 0)
+	if schedule.fields[4]&(1<<7) != 0 {
+		schedule.fields[4] = (schedule.fields[4] &^ (1 << 7)) | 1
+	}
+
+	schedule.domAny = parts[2] == "*"
+	schedule.dowAny = parts[4] == "*"
+
+	return schedule, nil
+}
+
+// String returns the expression the schedule was parsed from.
+func (schedule *Schedule) String() string {
+	return schedule.expression
+}
+
+/*
+Next returns the first matching minute strictly after the given time.
+
+Parameters:
+  - after: time.Time
+
+Returns:
+  - time.Time: Next activation in UTC (zero if none within five years)
+*/
+func (schedule *Schedule) Next(after time.Time) time.Time {
+	current := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := current.Add(cronSearchLimit)
+
+	for current.Before(limit) {
+		switch {
+		case !schedule.has(3, int(current.Month())):
+			current = time.Date(current.Year(), current.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !schedule.matchesDay(current):
+			current = time.Date(current.Year(), current.Month(), current.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !schedule.has(1, current.Hour()):
+			current = current.Truncate(time.Hour).Add(time.Hour)
+		case !schedule.has(0, current.Minute()):
+			current = current.Add(time.Minute)
+		default:
+			return current
+		}
+	}
+
+	return time.Time{}
+}
+
+// # Internal Helpers
+
+// has reports whether value is allowed in the field at index.
+func (schedule *Schedule) has(index, value int) bool {
+	return schedule.fields[index]&(1<<uint(value)) != 0
+}
+
+// matchesDay applies the cron day-of-month / day-of-week rule.
+func (schedule *Schedule) matchesDay(moment time.Time) bool {
+	domMatch := schedule.has(2, moment.Day())
+	dowMatch := schedule.has(4, int(moment.Weekday()))
+
+	if schedule.domAny || schedule.dowAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// parseCronField converts one comma-separated field into a bitset.
+func parseCronField(raw string, field cronField) (uint64, error) {
+	var bits uint64
+
+	for _, item := range strings.Split(raw, ",") {
+
+		// Step extraction
+		rangePart, step, stepped := item, 1, false
+		if slash := strings.IndexByte(item, '/'); slash >= 0 {
+			parsed, err := strconv.Atoi(item[slash+1:])
+			if err != nil || parsed < 1 {
+				return 0, fmt.Errorf("cron: invalid step %q in %s field", item, field.name)
+			}
+			rangePart, step, stepped = item[:slash], parsed, true
+		}
+
+		// Range resolution
+		low, high := field.min, field.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if low, err = parseCronValue(bounds[0], field); err != nil {
+				return 0, err
+			}
+			if high, err = parseCronValue(bounds[1], field); err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("cron: invalid range %q in %s field", rangePart, field.name)
+			}
+		default:
+			value, err := parseCronValue(rangePart, field)
+			if err != nil {
+				return 0, err
+			}
+			low = value
+			if !stepped {
+				high = value // "5" is a single value, "5/10" runs to the field maximum
+			}
+		}
+
+		for value := low; value <= high; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+
+	return bits, nil
+}
+
+// parseCronValue parses a single number and checks it against the field bounds.
+func parseCronValue(raw string, field cronField) (int, error) {
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("cron: invalid value %q in %s field", raw, field.name)
+	}
+	if value < field.min || value > field.max {
+		return 0, fmt.Errorf("cron: %s value %d out of range %d-%d", field.name, value, field.min, field.max)
+	}
+	return value, nil
+}
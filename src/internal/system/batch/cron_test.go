@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package batch_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taibuivan/yomira/internal/system/batch"
+)
+
+/*
+TestParseCron_Invalid checks that malformed expressions are rejected.
+*/
+func TestParseCron_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{"empty", ""},
+		{"too_few_fields", "* * * *"},
+		{"too_many_fields", "0 * * * * *"},
+		{"minute_out_of_range", "60 * * * *"},
+		{"day_of_month_zero", "0 0 0 * *"},
+		{"reversed_range", "0 10-5 * * *"},
+		{"zero_step", "*/0 * * * *"},
+		{"letters", "a * * * *"},
+		{"unknown_descriptor", "@sometimes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := batch.ParseCron(tt.expression)
+			assert.Error(t, err)
+		})
+	}
+}
+
+/*
+TestSchedule_Next verifies activation times for common expressions.
+*/
+func TestSchedule_Next(t *testing.T) {
+	base := time.Date(2026, time.February, 22, 10, 7, 30, 0, time.UTC) // Sunday
+
+	tests := []struct {
+		name       string
+		expression string
+		after      time.Time
+		expected   time.Time
+	}{
+		{"every_minute", "* * * * *", base, time.Date(2026, 2, 22, 10, 8, 0, 0, time.UTC)},
+		{"every_15_minutes", "*/15 * * * *", base, time.Date(2026, 2, 22, 10, 15, 0, 0, time.UTC)},
+		{"top_of_hour", "0 * * * *", base, time.Date(2026, 2, 22, 11, 0, 0, 0, time.UTC)},
+		{"every_6_hours", "0 */6 * * *", base, time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)},
+		{"daily_descriptor", "@daily", base, time.Date(2026, 2, 23, 0, 0, 0, 0, time.UTC)},
+		{"list_and_range", "5,35 9-11 * * *", base, time.Date(2026, 2, 22, 10, 35, 0, 0, time.UTC)},
+		{"first_of_month", "30 0 1 * *", base, time.Date(2026, 3, 1, 0, 30, 0, 0, time.UTC)},
+		{"weekly_sunday_as_7", "0 3 * * 7", base, time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC)},
+		{"weekday_only", "0 9 * * 1-5", base, time.Date(2026, 2, 23, 9, 0, 0, 0, time.UTC)},
+		{"offset_step", "5/20 * * * *", base, time.Date(2026, 2, 22, 10, 25, 0, 0, time.UTC)},
+		{"strictly_after_exact_match", "0 * * * *", time.Date(2026, 2, 22, 11, 0, 0, 0, time.UTC), time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)},
+		{"leap_day", "0 0 29 2 *", base, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := batch.ParseCron(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule.Next(tt.after))
+		})
+	}
+}
+
+/*
+TestSchedule_Next_DayOfMonthOrWeek checks the classic cron rule where a
+restricted day of month and day of week match if either does.
+*/
+func TestSchedule_Next_DayOfMonthOrWeek(t *testing.T) {
+	schedule, err := batch.ParseCron("0 0 15 * 1") // 15th or any Monday
+	require.NoError(t, err)
+
+	after := time.Date(2026, time.February, 10, 12, 0, 0, 0, time.UTC) // Tuesday
+
+	// 1. The 15th comes before the next Monday
+	first := schedule.Next(after)
+	assert.Equal(t, time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC), first)
+
+	// 2. Then Monday the 16th
+	assert.Equal(t, time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC), schedule.Next(first))
+}
+
+/*
+TestSchedule_Next_Impossible ensures impossible dates terminate with a zero time.
+*/
+func TestSchedule_Next_Impossible(t *testing.T) {
+	schedule, err := batch.ParseCron("0 0 30 2 *")
+	require.NoError(t, err)
+
+	assert.True(t, schedule.Next(time.Now()).IsZero())
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package batch
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/taibuivan/yomira/internal/platform/middleware"
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Handler Implementation
+
+// Handler implements the HTTP layer for the batch admin console.
+type Handler struct {
+	service *Service
+}
+
+// NewHandler constructs a new batch [Handler].
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes attaches the admin-only batch endpoints to the root API router.
+func (handler *Handler) RegisterRoutes(api chi.Router) {
+	api.Group(func(admin chi.Router) {
+		admin.Use(middleware.RequireRole(sec.RoleAdmin))
+		admin.Use(middleware.RequireSession)
+
+		// Run history and control
+		admin.Get("/admin/batch/jobs", handler.listRuns)
+		admin.Get("/admin/batch/jobs/{jobKey}", handler.listJobRuns)
+		admin.Post("/admin/batch/jobs/{jobKey}/run", handler.triggerJob)
+		admin.Post("/admin/batch/jobs/{jobKey}/cancel", handler.cancelJob)
+
+		// Schedule
+		admin.Get("/admin/batch/schedule", handler.listSchedule)
+		admin.Patch("/admin/batch/schedule/{jobKey}", handler.updateSchedule)
+	})
+}
+
+// # Run Endpoints
+
+/*
+GET /api/v1/admin/batch/jobs.
+
+Description: Lists batch job runs across all jobs, newest first.
+
+Request:
+  - job_key: string
+  - status: string (queued, running, done, failed, cancelled)
+  - triggered_by: string (scheduler, admin)
+  - from: string (RFC 3339, default 7 days ago)
+  - to: string (RFC 3339)
+  - limit: int
+  - page: int
+
+Response:
+  - 200: []Run: Paginated runs
+  - 400: Validation: Invalid filter values
+  - 403: ErrForbidden: Admin role required
+*/
+func (handler *Handler) listRuns(writer http.ResponseWriter, request *http.Request) {
+	filter, err := parseRunFilter(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	handler.respondRuns(writer, request, filter)
+}
+
+/*
+GET /api/v1/admin/batch/jobs/{jobKey}.
+
+Description: Lists the run history of a single job.
+
+Request:
+  - jobKey: string
+  - status: string
+  - limit: int
+  - page: int
+
+Response:
+  - 200: []Run: Paginated runs
+  - 403: ErrForbidden: Admin role required
+*/
+func (handler *Handler) listJobRuns(writer http.ResponseWriter, request *http.Request) {
+	filter, err := parseRunFilter(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+	filter.JobKey = requestutil.Param(request, "jobKey")
+
+	handler.respondRuns(writer, request, filter)
+}
+
+// triggerRequest defines the inbound JSON schema for a manual run.
+type triggerRequest struct {
+	Params map[string]any `json:"params"`
+}
+
+/*
+POST /api/v1/admin/batch/jobs/{jobKey}/run.
+
+Description: Starts a run of a registered job immediately.
+
+Request:
+  - jobKey: string
+  - body: triggerRequest (JSON, optional)
+
+Response:
+  - 202: Run: The accepted run in 'queued' state
+  - 403: ErrForbidden: Admin role required
+  - 404: ErrNotFound: Unknown job key
+  - 409: ErrConflict: This job is already running
+*/
+func (handler *Handler) triggerJob(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	// The body is optional for jobs without parameters
+	var input triggerRequest
+	if request.ContentLength > 0 {
+		if err := requestutil.DecodeJSON(request, &input); err != nil {
+			respond.Error(writer, request, err)
+			return
+		}
+	}
+
+	run, err := handler.service.TriggerJob(request.Context(), requestutil.Param(request, "jobKey"), userID, input.Params)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Accepted(writer, run)
+}
+
+/*
+POST /api/v1/admin/batch/jobs/{jobKey}/cancel.
+
+Description: Cancels the queued or running run of a job.
+
+Request:
+  - jobKey: string
+
+Response:
+  - 200: Run: The run with status 'cancelled'
+  - 403: ErrForbidden: Admin role required
+  - 404: ErrNotFound: Unknown job or no active run
+*/
+func (handler *Handler) cancelJob(writer http.ResponseWriter, request *http.Request) {
+	run, err := handler.service.CancelJob(request.Context(), requestutil.Param(request, "jobKey"))
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, run)
+}
+
+// # Schedule Endpoints
+
+/*
+GET /api/v1/admin/batch/schedule.
+
+Description: Lists every registered job with its effective schedule,
+last run and next activation.
+
+Response:
+  - 200: []ScheduleEntry: Success
+  - 403: ErrForbidden: Admin role required
+*/
+func (handler *Handler) listSchedule(writer http.ResponseWriter, request *http.Request) {
+	entries, err := handler.service.ListSchedule(request.Context())
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, entries)
+}
+
+// scheduleRequest defines the inbound JSON schema for a schedule change.
+type scheduleRequest struct {
+	IsEnabled *bool   `json:"is_enabled"`
+	Cron      *string `json:"cron"` // null = keep current
+}
+
+/*
+PATCH /api/v1/admin/batch/schedule/{jobKey}.
+
+Description: Enables, disables or reschedules a job. Every replica applies
+the change on its next scheduler tick.
+
+Request:
+  - jobKey: string
+  - body: scheduleRequest (JSON)
+
+Response:
+  - 200: ScheduleEntry: Updated schedule
+  - 400: Validation: Invalid cron expression
+  - 403: ErrForbidden: Admin role required
+  - 404: ErrNotFound: Unknown job key
+*/
+func (handler *Handler) updateSchedule(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input scheduleRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	entry, err := handler.service.UpdateSchedule(request.Context(), requestutil.Param(request, "jobKey"), userID, ScheduleInput{
+		IsEnabled: input.IsEnabled,
+		Cron:      input.Cron,
+	})
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, entry)
+}
+
+// # Internal Helpers
+
+// respondRuns writes a paginated run listing for the given filter.
+func (handler *Handler) respondRuns(writer http.ResponseWriter, request *http.Request, filter RunFilter) {
+	paginationParams := pagination.FromRequest(request)
+
+	runs, total, err := handler.service.ListRuns(request.Context(), filter, paginationParams.Limit, paginationParams.Offset())
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Paginated(writer, runs, pagination.NewMeta(paginationParams.Page, paginationParams.Limit, total))
+}
+
+// parseRunFilter maps query parameters onto a [RunFilter].
+func parseRunFilter(request *http.Request) (RunFilter, error) {
+	query := request.URL.Query()
+
+	filter := RunFilter{
+		JobKey:      query.Get(FieldJobKey),
+		Status:      RunStatus(query.Get(FieldStatus)),
+		TriggeredBy: Trigger(query.Get(FieldTriggeredBy)),
+	}
+
+	// Time window parsing
+	for field, target := range map[string]**time.Time{FieldFrom: &filter.From, FieldTo: &filter.To} {
+		raw := query.Get(field)
+		if raw == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return filter, validate.RequiredError(field, "Must be an RFC 3339 timestamp")
+		}
+		*target = &parsed
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// Cancellation causes recorded on run contexts.
+var (
+	errCancelRequested = errors.New("cancelled by admin")
+	errLockLost        = errors.New("job lock lost")
+)
+
+// persistTimeout bounds run record writes made after the run context is done.
+const persistTimeout = 10 * time.Second
+
+// # Scheduler Loop
+
+/*
+Start runs the scheduler until the context is cancelled.
+
+Description: The loop wakes on every minute boundary, reloads the admin
+overrides (so schedule changes apply without a restart) and launches every
+job whose next activation has passed. The context is also the parent of
+every run, so cancelling it stops in-flight jobs.
+
+Parameters:
+  - context: context.Context (Application lifecycle)
+*/
+func (service *Service) Start(context context.Context) {
+	service.mu.Lock()
+	service.root = context
+	service.mu.Unlock()
+
+	service.logger.Info("batch_scheduler_started", slog.Int("jobs", len(service.order)))
+
+	previous := time.Now().UTC().Truncate(time.Minute)
+	for {
+		timer := time.NewTimer(time.Until(previous.Add(time.Minute)))
+
+		select {
+		case <-context.Done():
+			timer.Stop()
+			service.logger.Info("batch_scheduler_stopped")
+			return
+		case <-timer.C:
+		}
+
+		now := time.Now().UTC()
+		service.tick(context, previous, now)
+		previous = now.Truncate(time.Minute)
+	}
+}
+
+// Wait blocks until every run started by this replica has persisted its outcome.
+func (service *Service) Wait() {
+	service.waitGroup.Wait()
+}
+
+// tick launches every enabled job with an activation in (previous, now].
+func (service *Service) tick(context context.Context, previous, now time.Time) {
+	entries, err := service.effectiveSchedule(context)
+	if err != nil {
+		service.logger.Error("batch_schedule_load_failed", slog.Any("error", err))
+		return
+	}
+
+	for _, entry := range entries {
+		if !entry.IsEnabled {
+			continue
+		}
+
+		schedule, err := ParseCron(entry.Cron)
+		if err != nil {
+			service.logger.Error("batch_schedule_invalid", slog.String("job_key", entry.JobKey), slog.Any("error", err))
+			continue
+		}
+
+		slot := schedule.Next(previous)
+		if slot.IsZero() || slot.After(now) {
+			continue
+		}
+
+		// One replica per activation
+		claimed, err := service.lockRepo.ClaimSlot(context, entry.JobKey, slot, SlotClaimTTL)
+		if err != nil {
+			service.logger.Error("batch_slot_claim_failed", slog.String("job_key", entry.JobKey), slog.Any("error", err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		job, err := service.job(entry.JobKey)
+		if err != nil {
+			continue
+		}
+
+		if _, err := service.launch(context, job, TriggerScheduler, nil, nil); err != nil {
+			// A long run still holding the lock simply skips this activation
+			level := slog.LevelError
+			if appErr := apperr.As(err); appErr != nil && appErr.Code == "CONFLICT" {
+				level = slog.LevelInfo
+			}
+			service.logger.Log(context, level, "batch_job_skipped", slog.String("job_key", entry.JobKey), slog.Any("error", err))
+		}
+	}
+}
+
+// # Run Execution
+
+// launch takes the job lock, persists a queued run and executes it in the background.
+func (service *Service) launch(context context.Context, job *Job, trigger Trigger, userID *string, params map[string]any) (*Run, error) {
+	service.mu.Lock()
+	root := service.root
+	service.mu.Unlock()
+
+	if root == nil || root.Err() != nil {
+		return nil, apperr.ServiceUnavailable("Batch scheduler is not running")
+	}
+
+	// Cross-replica exclusion
+	runID := uuid.New()
+	acquired, err := service.lockRepo.Acquire(context, job.Key, runID, LockTTL)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, apperr.Conflict("This job is already running")
+	}
+
+	// Records left active by a crashed replica
+	if abandoned, err := service.runRepo.AbandonActive(context, job.Key, "abandoned: runner lost its lock"); err != nil {
+		service.logger.Warn("batch_abandon_failed", slog.String("job_key", job.Key), slog.Any("error", err))
+	} else if abandoned > 0 {
+		service.logger.Warn("batch_runs_abandoned", slog.String("job_key", job.Key), slog.Int("count", abandoned))
+	}
+
+	run := &Run{
+		ID:                runID,
+		JobKey:            job.Key,
+		Status:            StatusQueued,
+		TriggeredBy:       trigger,
+		TriggeredByUserID: userID,
+	}
+
+	execution := Execution{RunID: runID, Trigger: trigger, Params: params}
+	execution.PreviousSuccessAt, err = service.runRepo.LastSuccessAt(context, job.Key)
+	if err == nil {
+		err = service.runRepo.Create(context, run)
+	}
+	if err != nil {
+		_ = service.lockRepo.Release(context, job.Key, runID)
+		return nil, err
+	}
+
+	// Run context derived from the application, not the request
+	runContext, cancel := newRunContext(root)
+
+	service.mu.Lock()
+	service.active[runID] = cancel
+	service.mu.Unlock()
+
+	snapshot := *run
+
+	service.waitGroup.Add(1)
+	go service.execute(runContext, cancel, job, run, execution)
+
+	return &snapshot, nil
+}
+
+// execute runs the job handler and persists its outcome.
+func (service *Service) execute(runContext context.Context, cancel context.CancelCauseFunc, job *Job, run *Run, execution Execution) {
+	defer service.waitGroup.Done()
+	defer func() {
+		cancel(nil)
+
+		service.mu.Lock()
+		delete(service.active, run.ID)
+		service.mu.Unlock()
+
+		releaseContext, stop := service.persistContext(runContext)
+		defer stop()
+		if err := service.lockRepo.Release(releaseContext, job.Key, run.ID); err != nil {
+			service.logger.Warn("batch_lock_release_failed", slog.String("job_key", job.Key), slog.Any("error", err))
+		}
+	}()
+
+	// Transition to running
+	startedAt := time.Now().UTC()
+	run.Status = StatusRunning
+	run.StartedAt = &startedAt
+	service.persist(runContext, run)
+
+	go service.heartbeat(runContext, cancel, job.Key, run.ID)
+
+	// Handler invocation under the job timeout
+	jobContext, stop := context.WithTimeout(runContext, job.Timeout)
+	result, err := invoke(jobContext, job.Handler, execution)
+	stop()
+
+	// Outcome classification
+	finishedAt := time.Now().UTC()
+	durationMs := finishedAt.Sub(startedAt).Milliseconds()
+	run.FinishedAt = &finishedAt
+	run.DurationMs = &durationMs
+	run.RowsAffected = &result.RowsAffected
+	run.Meta = result.Meta
+
+	switch {
+	case err == nil:
+		run.Status = StatusDone
+	case errors.Is(context.Cause(runContext), errCancelRequested):
+		run.Status = StatusCancelled
+		run.LastError = truncateError(errCancelRequested)
+	case runContext.Err() != nil && !errors.Is(context.Cause(runContext), errLockLost):
+		run.Status = StatusCancelled
+		run.LastError = truncateError(fmt.Errorf("application shutdown: %w", err))
+	default:
+		run.Status = StatusFailed
+		run.LastError = truncateError(err)
+	}
+
+	service.persist(runContext, run)
+
+	level := slog.LevelInfo
+	if run.Status == StatusFailed {
+		level = slog.LevelError
+	}
+	service.logger.Log(runContext, level, "batch_job_finished",
+		slog.String("job_key", job.Key),
+		slog.String("run_id", run.ID),
+		slog.String("status", string(run.Status)),
+		slog.Int64("duration_ms", durationMs),
+		slog.Int64("rows_affected", result.RowsAffected),
+	)
+}
+
+// heartbeat keeps the job lock alive and relays cross-replica cancellation.
+func (service *Service) heartbeat(runContext context.Context, cancel context.CancelCauseFunc, jobKey, runID string) {
+	ticker := time.NewTicker(HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-runContext.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Lock ownership
+		owned, err := service.lockRepo.Refresh(runContext, jobKey, runID, LockTTL)
+		if err == nil && !owned {
+			service.logger.Error("batch_lock_lost", slog.String("job_key", jobKey), slog.String("run_id", runID))
+			cancel(errLockLost)
+			return
+		}
+
+		// Admin cancellation from another replica
+		if requested, err := service.lockRepo.IsCancelRequested(runContext, runID); err == nil && requested {
+			cancel(errCancelRequested)
+			return
+		}
+	}
+}
+
+// persist writes the run record, surviving the cancellation of the run context.
+func (service *Service) persist(runContext context.Context, run *Run) {
+	persistContext, stop := service.persistContext(runContext)
+	defer stop()
+
+	if err := service.runRepo.Update(persistContext, run); err != nil {
+		service.logger.Error("batch_run_persist_failed", slog.String("run_id", run.ID), slog.Any("error", err))
+	}
+}
+
+// persistContext detaches from the run's cancellation while keeping a deadline.
+func (service *Service) persistContext(runContext context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(runContext), persistTimeout)
+}
+
+// invoke calls the handler and converts a panic into a run failure.
+func invoke(jobContext context.Context, handler JobFunc, execution Execution) (result Result, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("panic: %v", recovered)
+		}
+	}()
+	return handler(jobContext, execution)
+}
+
+// truncateError converts an error to a bounded string for storage.
+func truncateError(err error) *string {
+	message := err.Error()
+	if len(message) > MaxErrorLength {
+		message = message[:MaxErrorLength]
+	}
+	return &message
+}
+
+// newRunContext derives a cancellable run context from the application context.
+func newRunContext(root context.Context) (context.Context, context.CancelCauseFunc) {
+	return context.WithCancelCause(root)
+}
@@ -0,0 +1,384 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+)
+
+// # Service Layer
+
+// Service owns the job registry, the scheduler loop and the admin operations on runs.
+type Service struct {
+	runRepo      RunRepository
+	scheduleRepo ScheduleRepository
+	lockRepo     LockRepository
+	logger       *slog.Logger
+
+	mu        sync.Mutex
+	jobs      map[string]*Job
+	order     []string                           // Registration order for stable listings
+	active    map[string]context.CancelCauseFunc // Local runs keyed by run ID
+	root      context.Context                    // Application context, set by Start
+	waitGroup sync.WaitGroup
+}
+
+// NewService constructs a new [Service] with its required repositories.
+func NewService(runRepo RunRepository, scheduleRepo ScheduleRepository, lockRepo LockRepository, logger *slog.Logger) *Service {
+	return &Service{
+		runRepo:      runRepo,
+		scheduleRepo: scheduleRepo,
+		lockRepo:     lockRepo,
+		logger:       logger,
+		jobs:         make(map[string]*Job),
+		active:       make(map[string]context.CancelCauseFunc),
+	}
+}
+
+/*
+Register adds a job to the registry. It must be called before [Service.Start].
+
+Parameters:
+  - job: Job
+
+Returns:
+  - error: Duplicate keys, invalid cron expressions or missing handlers
+*/
+func (service *Service) Register(job Job) error {
+	if job.Key == "" || job.Handler == nil {
+		return fmt.Errorf("batch: job %q requires a key and a handler", job.Key)
+	}
+
+	if _, err := ParseCron(job.Cron); err != nil {
+		return fmt.Errorf("batch: job %q: %w", job.Key, err)
+	}
+
+	if job.Timeout <= 0 {
+		job.Timeout = DefaultJobTimeout
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if _, exists := service.jobs[job.Key]; exists {
+		return fmt.Errorf("batch: job %q is already registered", job.Key)
+	}
+
+	service.jobs[job.Key] = &job
+	service.order = append(service.order, job.Key)
+
+	return nil
+}
+
+// # Run History
+
+/*
+ListRuns retrieves the run history, newest first.
+
+Description: Without an explicit lower bound the history is limited to
+the last [DefaultHistoryWindow].
+
+Parameters:
+  - context: context.Context
+  - filter: RunFilter
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*Run: Matching runs
+  - int: Total count of matching runs
+  - error: Validation or repository level errors
+*/
+func (service *Service) ListRuns(context context.Context, filter RunFilter, limit, offset int) ([]*Run, int, error) {
+
+	// Filter validation
+	validator := &validate.Validator{}
+	if filter.Status != "" {
+		validator.Custom(FieldStatus, !filter.Status.IsValid(), "Unknown run status")
+	}
+	if filter.TriggeredBy != "" {
+		validator.OneOf(FieldTriggeredBy, string(filter.TriggeredBy), string(TriggerScheduler), string(TriggerAdmin))
+	}
+	if err := validator.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	// Default time window
+	if filter.From == nil {
+		from := time.Now().UTC().Add(-DefaultHistoryWindow)
+		filter.From = &from
+	}
+
+	return service.runRepo.List(context, filter, limit, offset)
+}
+
+/*
+TriggerJob starts a run of a job immediately on this replica.
+
+Parameters:
+  - context: context.Context
+  - jobKey: string
+  - userID: string (Admin who triggered the run)
+  - params: map[string]any (Job specific parameters)
+
+Returns:
+  - *Run: The accepted run in 'queued' state
+  - error: NotFound (unknown job), Conflict (already running) or ServiceUnavailable
+*/
+func (service *Service) TriggerJob(context context.Context, jobKey, userID string, params map[string]any) (*Run, error) {
+	job, err := service.job(jobKey)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := service.launch(context, job, TriggerAdmin, &userID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	service.logger.Info("batch_job_triggered",
+		slog.String("job_key", jobKey),
+		slog.String("run_id", run.ID),
+		slog.String("user_id", userID),
+	)
+
+	return run, nil
+}
+
+/*
+CancelJob cancels the active run of a job, whichever replica executes it.
+
+Description: Local runs are cancelled immediately. Runs on other replicas
+observe the cancellation flag on their next heartbeat.
+
+Parameters:
+  - context: context.Context
+  - jobKey: string
+
+Returns:
+  - *Run: The run with its requested 'cancelled' state
+  - error: NotFound if the job is unknown or idle
+*/
+func (service *Service) CancelJob(context context.Context, jobKey string) (*Run, error) {
+	job, err := service.job(jobKey)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := service.runRepo.FindActive(context, jobKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cross-replica signal (outlives the longest possible run)
+	if err := service.lockRepo.RequestCancel(context, run.ID, job.Timeout); err != nil {
+		return nil, err
+	}
+
+	// Local fast path
+	service.mu.Lock()
+	if cancel, ok := service.active[run.ID]; ok {
+		cancel(errCancelRequested)
+	}
+	service.mu.Unlock()
+
+	service.logger.Info("batch_job_cancel_requested",
+		slog.String("job_key", jobKey),
+		slog.String("run_id", run.ID),
+	)
+
+	run.Status = StatusCancelled
+	return run, nil
+}
+
+// # Schedule Management
+
+/*
+ListSchedule returns the effective schedule of every registered job.
+
+Parameters:
+  - context: context.Context
+
+Returns:
+  - []*ScheduleEntry: Entries in registration order
+  - error: Repository level errors
+*/
+func (service *Service) ListSchedule(context context.Context) ([]*ScheduleEntry, error) {
+	entries, err := service.effectiveSchedule(context)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := service.runRepo.LatestByJob(context)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		entry.LastRun = latest[entry.JobKey]
+	}
+
+	return entries, nil
+}
+
+// ScheduleInput carries an admin change to a job's schedule. Nil fields are left untouched.
+type ScheduleInput struct {
+	IsEnabled *bool
+	Cron      *string
+}
+
+/*
+UpdateSchedule enables, disables or reschedules a job.
+
+Description: The override is persisted so every replica picks it up on its
+next scheduler tick without a restart.
+
+Parameters:
+  - context: context.Context
+  - jobKey: string
+  - userID: string (Admin performing the change)
+  - input: ScheduleInput
+
+Returns:
+  - *ScheduleEntry: The updated effective schedule
+  - error: Validation or NotFound errors
+*/
+func (service *Service) UpdateSchedule(context context.Context, jobKey, userID string, input ScheduleInput) (*ScheduleEntry, error) {
+	if _, err := service.job(jobKey); err != nil {
+		return nil, err
+	}
+
+	// Cron validation
+	if input.Cron != nil {
+		_, parseErr := ParseCron(*input.Cron)
+
+		validator := &validate.Validator{}
+		validator.Custom(FieldCron, parseErr != nil, "Invalid cron expression")
+		if err := validator.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Current state resolution
+	entries, err := service.effectiveSchedule(context)
+	if err != nil {
+		return nil, err
+	}
+
+	var current *ScheduleEntry
+	for _, entry := range entries {
+		if entry.JobKey == jobKey {
+			current = entry
+		}
+	}
+
+	// Apply partial changes
+	override := &ScheduleOverride{
+		JobKey:    jobKey,
+		Cron:      current.Cron,
+		IsEnabled: current.IsEnabled,
+		UpdatedBy: userID,
+	}
+	if input.Cron != nil {
+		override.Cron = *input.Cron
+	}
+	if input.IsEnabled != nil {
+		override.IsEnabled = *input.IsEnabled
+	}
+
+	if err := service.scheduleRepo.SaveOverride(context, override, current); err != nil {
+		return nil, err
+	}
+
+	service.logger.Info("batch_schedule_updated",
+		slog.String("job_key", jobKey),
+		slog.String("cron", override.Cron),
+		slog.Bool("is_enabled", override.IsEnabled),
+		slog.String("user_id", userID),
+	)
+
+	// Reflect the change in the returned entry
+	updated := *current
+	updated.Cron = override.Cron
+	updated.IsEnabled = override.IsEnabled
+	updated.NextRunAt = nextRunAt(override.Cron, override.IsEnabled)
+
+	return &updated, nil
+}
+
+// # Internal Helpers
+
+// job resolves a registered job by key.
+func (service *Service) job(jobKey string) (*Job, error) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	job, ok := service.jobs[jobKey]
+	if !ok {
+		return nil, apperr.NotFound("Job")
+	}
+	return job, nil
+}
+
+// effectiveSchedule merges the registered defaults with the persisted admin overrides.
+func (service *Service) effectiveSchedule(context context.Context) ([]*ScheduleEntry, error) {
+	overrides, err := service.scheduleRepo.ListOverrides(context)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]*ScheduleOverride, len(overrides))
+	for _, override := range overrides {
+		byKey[override.JobKey] = override
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	entries := make([]*ScheduleEntry, 0, len(service.order))
+	for _, key := range service.order {
+		job := service.jobs[key]
+
+		entry := &ScheduleEntry{
+			JobKey:      job.Key,
+			Description: job.Description,
+			Cron:        job.Cron,
+			IsEnabled:   true,
+		}
+		if override, ok := byKey[key]; ok {
+			entry.Cron = override.Cron
+			entry.IsEnabled = override.IsEnabled
+		}
+		entry.NextRunAt = nextRunAt(entry.Cron, entry.IsEnabled)
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// nextRunAt computes the next activation of an enabled schedule.
+func nextRunAt(expression string, enabled bool) *time.Time {
+	if !enabled {
+		return nil
+	}
+
+	schedule, err := ParseCron(expression)
+	if err != nil {
+		return nil
+	}
+
+	next := schedule.Next(time.Now())
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
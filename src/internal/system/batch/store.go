@@ -0,0 +1,236 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package batch
+
+import (
+	"context"
+	"time"
+)
+
+// # Run History Data Access
+
+// RunRepository defines the data access contract for persisted job runs.
+type RunRepository interface {
+
+	/*
+		Create persists a new run record.
+
+		Parameters:
+		  - context: context.Context
+		  - run: *Run (ID, JobKey, Status, TriggeredBy required)
+
+		Returns:
+		  - error: Storage failures
+	*/
+	Create(context context.Context, run *Run) error
+
+	/*
+		Update persists the mutable lifecycle fields of a run.
+
+		Parameters:
+		  - context: context.Context
+		  - run: *Run
+
+		Returns:
+		  - error: ErrNotFound if the run does not exist
+	*/
+	Update(context context.Context, run *Run) error
+
+	/*
+		List returns a filtered, paginated slice of runs, newest first.
+
+		Parameters:
+		  - context: context.Context
+		  - filter: RunFilter
+		  - limit: int
+		  - offset: int
+
+		Returns:
+		  - []*Run: Matching runs
+		  - int: Total count of matching runs
+		  - error: Database retrieval failures
+	*/
+	List(context context.Context, filter RunFilter, limit, offset int) ([]*Run, int, error)
+
+	/*
+		FindActive returns the most recent queued or running run of a job.
+
+		Parameters:
+		  - context: context.Context
+		  - jobKey: string
+
+		Returns:
+		  - *Run: The active run
+		  - error: ErrNotFound if the job is idle
+	*/
+	FindActive(context context.Context, jobKey string) (*Run, error)
+
+	/*
+		LatestByJob returns the most recent run of every job that has ever run.
+
+		Parameters:
+		  - context: context.Context
+
+		Returns:
+		  - map[string]*Run: Latest run keyed by job key
+		  - error: Database retrieval failures
+	*/
+	LatestByJob(context context.Context) (map[string]*Run, error)
+
+	/*
+		LastSuccessAt returns when the most recent successful run of a job started.
+
+		Parameters:
+		  - context: context.Context
+		  - jobKey: string
+
+		Returns:
+		  - *time.Time: Start time, or nil if the job never succeeded
+		  - error: Database retrieval failures
+	*/
+	LastSuccessAt(context context.Context, jobKey string) (*time.Time, error)
+
+	/*
+		AbandonActive fails every queued or running run of a job.
+
+		Description: Called right after the job lock is acquired. Holding the
+		lock proves no replica is executing the job, so active records left
+		behind by a crashed replica can be closed safely.
+
+		Parameters:
+		  - context: context.Context
+		  - jobKey: string
+		  - reason: string
+
+		Returns:
+		  - int: Number of runs closed
+		  - error: Database execution errors
+	*/
+	AbandonActive(context context.Context, jobKey, reason string) (int, error)
+}
+
+// # Schedule Data Access
+
+// ScheduleRepository defines the data access contract for admin schedule overrides.
+type ScheduleRepository interface {
+
+	/*
+		ListOverrides returns every persisted schedule override.
+
+		Parameters:
+		  - context: context.Context
+
+		Returns:
+		  - []*ScheduleOverride: Overrides in no particular order
+		  - error: Database retrieval failures
+	*/
+	ListOverrides(context context.Context) ([]*ScheduleOverride, error)
+
+	/*
+		SaveOverride upserts a schedule override and records it in the audit log.
+
+		Parameters:
+		  - context: context.Context
+		  - override: *ScheduleOverride
+		  - previous: *ScheduleEntry (State before the change, for the audit trail)
+
+		Returns:
+		  - error: Storage failures
+	*/
+	SaveOverride(context context.Context, override *ScheduleOverride, previous *ScheduleEntry) error
+}
+
+// # Coordination
+
+// LockRepository coordinates job execution across API replicas.
+type LockRepository interface {
+
+	/*
+		ClaimSlot records that a scheduled activation has been handled.
+
+		Description: Replicas tick on the same minute boundary; the first one
+		to claim the slot runs the job. Without it a fast job could finish and
+		release its lock before a slower replica ticks, running twice.
+
+		Parameters:
+		  - context: context.Context
+		  - jobKey: string
+		  - slot: time.Time (Scheduled activation minute)
+		  - ttl: time.Duration
+
+		Returns:
+		  - bool: False if another replica already claimed the slot
+		  - error: Connectivity errors
+	*/
+	ClaimSlot(context context.Context, jobKey string, slot time.Time, ttl time.Duration) (bool, error)
+
+	/*
+		Acquire takes the job lock for a run if no other run holds it.
+
+		Parameters:
+		  - context: context.Context
+		  - jobKey: string
+		  - runID: string (Lock owner)
+		  - ttl: time.Duration
+
+		Returns:
+		  - bool: False if another run holds the lock
+		  - error: Connectivity errors
+	*/
+	Acquire(context context.Context, jobKey, runID string, ttl time.Duration) (bool, error)
+
+	/*
+		Refresh extends the job lock if it is still owned by the run.
+
+		Parameters:
+		  - context: context.Context
+		  - jobKey: string
+		  - runID: string
+		  - ttl: time.Duration
+
+		Returns:
+		  - bool: False if the lock was lost
+		  - error: Connectivity errors
+	*/
+	Refresh(context context.Context, jobKey, runID string, ttl time.Duration) (bool, error)
+
+	/*
+		Release drops the job lock if it is still owned by the run.
+
+		Parameters:
+		  - context: context.Context
+		  - jobKey: string
+		  - runID: string
+
+		Returns:
+		  - error: Connectivity errors
+	*/
+	Release(context context.Context, jobKey, runID string) error
+
+	/*
+		RequestCancel flags a run for cancellation on whichever replica executes it.
+
+		Parameters:
+		  - context: context.Context
+		  - runID: string
+		  - ttl: time.Duration
+
+		Returns:
+		  - error: Connectivity errors
+	*/
+	RequestCancel(context context.Context, runID string, ttl time.Duration) error
+
+	/*
+		IsCancelRequested reports whether a run has been flagged for cancellation.
+
+		Parameters:
+		  - context: context.Context
+		  - runID: string
+
+		Returns:
+		  - bool: True once [LockRepository.RequestCancel] was called for the run
+		  - error: Connectivity errors
+	*/
+	IsCancelRequested(context context.Context, runID string) (bool, error)
+}
@@ -0,0 +1,374 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # PostgreSQL Repositories
+
+// runRepository implements the [RunRepository] interface using pgx.
+type runRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRunRepository constructs a PostgreSQL backed run history store.
+func NewRunRepository(pool *pgxpool.Pool) RunRepository {
+	return &runRepository{pool: pool}
+}
+
+// scheduleRepository implements the [ScheduleRepository] interface using pgx.
+type scheduleRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewScheduleRepository constructs a PostgreSQL backed schedule override store.
+func NewScheduleRepository(pool *pgxpool.Pool) ScheduleRepository {
+	return &scheduleRepository{pool: pool}
+}
+
+// # Run Repository Implementation
+
+// Create persists a new run record.
+func (repository *runRepository) Create(context context.Context, run *Run) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s, %s, %s)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING %s
+	`,
+		schema.SystemBatchRun.Table,
+		schema.SystemBatchRun.ID, schema.SystemBatchRun.JobKey, schema.SystemBatchRun.Status,
+		schema.SystemBatchRun.TriggeredBy, schema.SystemBatchRun.TriggeredByUserID, schema.SystemBatchRun.CreatedAt,
+		schema.SystemBatchRun.CreatedAt,
+	)
+
+	err := repository.pool.QueryRow(context, query,
+		run.ID, run.JobKey, run.Status, run.TriggeredBy, run.TriggeredByUserID,
+	).Scan(&run.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to create batch run: %w", err)
+	}
+
+	return nil
+}
+
+// Update persists the mutable lifecycle fields of a run.
+func (repository *runRepository) Update(context context.Context, run *Run) error {
+	query := fmt.Sprintf(`
+		UPDATE %s SET %s = $2, %s = $3, %s = $4, %s = $5, %s = $6, %s = $7, %s = $8
+		WHERE %s = $1
+	`,
+		schema.SystemBatchRun.Table,
+		schema.SystemBatchRun.Status, schema.SystemBatchRun.StartedAt, schema.SystemBatchRun.FinishedAt,
+		schema.SystemBatchRun.DurationMs, schema.SystemBatchRun.RowsAffected, schema.SystemBatchRun.LastError,
+		schema.SystemBatchRun.Meta,
+		schema.SystemBatchRun.ID,
+	)
+
+	result, err := repository.pool.Exec(context, query,
+		run.ID, run.Status, run.StartedAt, run.FinishedAt, run.DurationMs, run.RowsAffected, run.LastError, run.Meta,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to update batch run: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperr.NotFound("Batch run")
+	}
+
+	return nil
+}
+
+/*
+List returns a filtered, paginated slice of runs, newest first.
+
+Parameters:
+  - context: context.Context
+  - filter: RunFilter
+  - limit: int
+  - offset: int
+
+Returns:
+  - []*Run: Matching runs
+  - int: Total count of matching runs
+  - error: Database execution errors
+*/
+func (repository *runRepository) List(context context.Context, filter RunFilter, limit, offset int) ([]*Run, int, error) {
+
+	// Query build initialization
+	var queryBuilder strings.Builder
+	var args []any
+	argID := 1
+
+	queryBuilder.WriteString(fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER() AS total_count
+		FROM %s
+		WHERE TRUE
+	`, runProjection(), schema.SystemBatchRun.Table))
+
+	// Dynamic filters
+	addFilter := func(column string, operator string, value any) {
+		queryBuilder.WriteString(fmt.Sprintf(" AND %s %s $%d", column, operator, argID))
+		args = append(args, value)
+		argID++
+	}
+
+	if filter.JobKey != "" {
+		addFilter(schema.SystemBatchRun.JobKey, "=", filter.JobKey)
+	}
+	if filter.Status != "" {
+		addFilter(schema.SystemBatchRun.Status, "=", filter.Status)
+	}
+	if filter.TriggeredBy != "" {
+		addFilter(schema.SystemBatchRun.TriggeredBy, "=", filter.TriggeredBy)
+	}
+	if filter.From != nil {
+		addFilter(schema.SystemBatchRun.CreatedAt, ">=", *filter.From)
+	}
+	if filter.To != nil {
+		addFilter(schema.SystemBatchRun.CreatedAt, "<=", *filter.To)
+	}
+
+	queryBuilder.WriteString(fmt.Sprintf(" ORDER BY %s DESC LIMIT $%d OFFSET $%d", schema.SystemBatchRun.CreatedAt, argID, argID+1))
+	args = append(args, limit, offset)
+
+	rows, err := repository.pool.Query(context, queryBuilder.String(), args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("postgres: failed to list batch runs: %w", err)
+	}
+	defer rows.Close()
+
+	// Row Iteration and Entity Hydration
+	var runs []*Run
+	var totalCount int
+
+	for rows.Next() {
+		run := &Run{}
+		if err := rows.Scan(append(runScanTargets(run), &totalCount)...); err != nil {
+			return nil, 0, fmt.Errorf("postgres: failed to scan batch run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, totalCount, rows.Err()
+}
+
+// FindActive returns the most recent queued or running run of a job.
+func (repository *runRepository) FindActive(context context.Context, jobKey string) (*Run, error) {
+	query := fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE %s = $1 AND %s IN ($2, $3)
+		ORDER BY %s DESC
+		LIMIT 1
+	`,
+		runProjection(), schema.SystemBatchRun.Table,
+		schema.SystemBatchRun.JobKey, schema.SystemBatchRun.Status,
+		schema.SystemBatchRun.CreatedAt,
+	)
+
+	run := &Run{}
+	err := repository.pool.QueryRow(context, query, jobKey, StatusQueued, StatusRunning).Scan(runScanTargets(run)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("Active batch run")
+		}
+		return nil, fmt.Errorf("postgres: failed to find active batch run: %w", err)
+	}
+
+	return run, nil
+}
+
+// LatestByJob returns the most recent run of every job that has ever run.
+func (repository *runRepository) LatestByJob(context context.Context) (map[string]*Run, error) {
+	query := fmt.Sprintf(`
+		SELECT DISTINCT ON (%s) %s
+		FROM %s
+		ORDER BY %s, %s DESC
+	`,
+		schema.SystemBatchRun.JobKey, runProjection(),
+		schema.SystemBatchRun.Table,
+		schema.SystemBatchRun.JobKey, schema.SystemBatchRun.CreatedAt,
+	)
+
+	rows, err := repository.pool.Query(context, query)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list latest batch runs: %w", err)
+	}
+	defer rows.Close()
+
+	latest := make(map[string]*Run)
+	for rows.Next() {
+		run := &Run{}
+		if err := rows.Scan(runScanTargets(run)...); err != nil {
+			return nil, fmt.Errorf("postgres: failed to scan batch run: %w", err)
+		}
+		latest[run.JobKey] = run
+	}
+
+	return latest, rows.Err()
+}
+
+// LastSuccessAt returns when the most recent successful run of a job started.
+func (repository *runRepository) LastSuccessAt(context context.Context, jobKey string) (*time.Time, error) {
+	query := fmt.Sprintf(`SELECT MAX(%s) FROM %s WHERE %s = $1 AND %s = $2`,
+		schema.SystemBatchRun.StartedAt, schema.SystemBatchRun.Table,
+		schema.SystemBatchRun.JobKey, schema.SystemBatchRun.Status)
+
+	var startedAt *time.Time
+	if err := repository.pool.QueryRow(context, query, jobKey, StatusDone).Scan(&startedAt); err != nil {
+		return nil, fmt.Errorf("postgres: failed to resolve last successful batch run: %w", err)
+	}
+
+	return startedAt, nil
+}
+
+// AbandonActive fails every queued or running run of a job.
+func (repository *runRepository) AbandonActive(context context.Context, jobKey, reason string) (int, error) {
+	query := fmt.Sprintf(`
+		UPDATE %[1]s SET %[2]s = $2, %[3]s = NOW(), %[4]s = $3
+		WHERE %[5]s = $1 AND %[2]s IN ($4, $5)
+	`,
+		schema.SystemBatchRun.Table,      // 1
+		schema.SystemBatchRun.Status,     // 2
+		schema.SystemBatchRun.FinishedAt, // 3
+		schema.SystemBatchRun.LastError,  // 4
+		schema.SystemBatchRun.JobKey,     // 5
+	)
+
+	result, err := repository.pool.Exec(context, query, jobKey, StatusFailed, reason, StatusQueued, StatusRunning)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: failed to abandon batch runs: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// # Schedule Repository Implementation
+
+// ListOverrides returns every persisted schedule override.
+func (repository *scheduleRepository) ListOverrides(context context.Context) ([]*ScheduleOverride, error) {
+	query := fmt.Sprintf(`SELECT %s, %s, %s, COALESCE(%s::text, ''), %s FROM %s`,
+		schema.SystemBatchSchedule.JobKey, schema.SystemBatchSchedule.Cron, schema.SystemBatchSchedule.IsEnabled,
+		schema.SystemBatchSchedule.UpdatedBy, schema.SystemBatchSchedule.UpdatedAt,
+		schema.SystemBatchSchedule.Table)
+
+	rows, err := repository.pool.Query(context, query)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to list batch schedule: %w", err)
+	}
+	defer rows.Close()
+
+	var overrides []*ScheduleOverride
+	for rows.Next() {
+		override := &ScheduleOverride{}
+		if err := rows.Scan(&override.JobKey, &override.Cron, &override.IsEnabled, &override.UpdatedBy, &override.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("postgres: failed to scan batch schedule: %w", err)
+		}
+		overrides = append(overrides, override)
+	}
+
+	return overrides, rows.Err()
+}
+
+/*
+SaveOverride upserts a schedule override and records it in the audit log.
+
+Parameters:
+  - context: context.Context
+  - override: *ScheduleOverride
+  - previous: *ScheduleEntry
+
+Returns:
+  - error: Database execution errors
+*/
+func (repository *scheduleRepository) SaveOverride(context context.Context, override *ScheduleOverride, previous *ScheduleEntry) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to begin transaction: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Override upsert
+	upsertQuery := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s, %[6]s)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (%[2]s) DO UPDATE
+		SET %[3]s = EXCLUDED.%[3]s, %[4]s = EXCLUDED.%[4]s, %[5]s = EXCLUDED.%[5]s, %[6]s = EXCLUDED.%[6]s
+		RETURNING %[6]s
+	`,
+		schema.SystemBatchSchedule.Table,     // 1
+		schema.SystemBatchSchedule.JobKey,    // 2
+		schema.SystemBatchSchedule.Cron,      // 3
+		schema.SystemBatchSchedule.IsEnabled, // 4
+		schema.SystemBatchSchedule.UpdatedBy, // 5
+		schema.SystemBatchSchedule.UpdatedAt, // 6
+	)
+
+	err = transaction.QueryRow(context, upsertQuery,
+		override.JobKey, override.Cron, override.IsEnabled, override.UpdatedBy,
+	).Scan(&override.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("postgres: failed to save batch schedule: %w", err)
+	}
+
+	// Audit trail
+	auditQuery := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES ($1, $2, 'batch.schedule_update', 'batch_job', $3, $4, $5, NOW())
+	`,
+		schema.SystemAuditLog.Table,
+		schema.SystemAuditLog.ID, schema.SystemAuditLog.ActorID, schema.SystemAuditLog.Action,
+		schema.SystemAuditLog.EntityType, schema.SystemAuditLog.EntityID,
+		schema.SystemAuditLog.Before, schema.SystemAuditLog.After, schema.SystemAuditLog.CreatedAt,
+	)
+
+	before := map[string]any{"cron": previous.Cron, "is_enabled": previous.IsEnabled}
+	after := map[string]any{"cron": override.Cron, "is_enabled": override.IsEnabled}
+
+	if _, err := transaction.Exec(context, auditQuery, uuid.New(), override.UpdatedBy, override.JobKey, before, after); err != nil {
+		return fmt.Errorf("postgres: failed to write audit log: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres: failed to commit batch schedule: %w", err)
+	}
+
+	return nil
+}
+
+// # Internal Helpers
+
+// runProjection returns the shared SELECT list for run queries.
+func runProjection() string {
+	return strings.Join(schema.SystemBatchRun.Columns(), ", ")
+}
+
+// runScanTargets returns the scan destinations matching [runProjection].
+func runScanTargets(run *Run) []any {
+	return []any{
+		&run.ID,
+		&run.JobKey,
+		&run.Status,
+		&run.TriggeredBy,
+		&run.TriggeredByUserID,
+		&run.StartedAt,
+		&run.FinishedAt,
+		&run.DurationMs,
+		&run.RowsAffected,
+		&run.LastError,
+		&run.Meta,
+		&run.CreatedAt,
+	}
+}
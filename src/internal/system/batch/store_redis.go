@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// # Redis Keys
+
+const (
+	lockKeyPrefix   = "batch:lock:"
+	cancelKeyPrefix = "batch:cancel:"
+	slotKeyPrefix   = "batch:slot:"
+)
+
+// Owner-checked scripts so a run never extends or drops a lock it lost.
+var (
+	refreshLockScript = redis.NewScript(`
+		if redis.call("GET", KEYS[1]) == ARGV[1] then
+			return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+		end
+		return 0
+	`)
+
+	releaseLockScript = redis.NewScript(`
+		if redis.call("GET", KEYS[1]) == ARGV[1] then
+			return redis.call("DEL", KEYS[1])
+		end
+		return 0
+	`)
+)
+
+// RedisLockRepository implements [LockRepository] using Redis.
+type RedisLockRepository struct {
+	client *redis.Client
+}
+
+// NewLockRepository creates a new Redis-backed [LockRepository].
+func NewLockRepository(client *redis.Client) *RedisLockRepository {
+	return &RedisLockRepository{client: client}
+}
+
+// ClaimSlot marks a scheduled activation as handled with SET NX.
+func (repository *RedisLockRepository) ClaimSlot(context context.Context, jobKey string, slot time.Time, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf("%s%s:%d", slotKeyPrefix, jobKey, slot.Unix())
+
+	claimed, err := repository.client.SetNX(context, key, 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis_batch_slot_claim_failed: %w", err)
+	}
+	return claimed, nil
+}
+
+// Acquire takes the job lock with SET NX so only one replica can hold it.
+func (repository *RedisLockRepository) Acquire(context context.Context, jobKey, runID string, ttl time.Duration) (bool, error) {
+	acquired, err := repository.client.SetNX(context, lockKeyPrefix+jobKey, runID, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis_batch_lock_acquire_failed: %w", err)
+	}
+	return acquired, nil
+}
+
+// Refresh extends the job lock if runID still owns it.
+func (repository *RedisLockRepository) Refresh(context context.Context, jobKey, runID string, ttl time.Duration) (bool, error) {
+	refreshed, err := refreshLockScript.Run(context, repository.client, []string{lockKeyPrefix + jobKey}, runID, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("redis_batch_lock_refresh_failed: %w", err)
+	}
+	return refreshed == 1, nil
+}
+
+// Release drops the job lock if runID still owns it.
+func (repository *RedisLockRepository) Release(context context.Context, jobKey, runID string) error {
+	if err := releaseLockScript.Run(context, repository.client, []string{lockKeyPrefix + jobKey}, runID).Err(); err != nil {
+		return fmt.Errorf("redis_batch_lock_release_failed: %w", err)
+	}
+	return nil
+}
+
+// RequestCancel flags a run for cancellation. The flag expires on its own.
+func (repository *RedisLockRepository) RequestCancel(context context.Context, runID string, ttl time.Duration) error {
+	if err := repository.client.Set(context, cancelKeyPrefix+runID, 1, ttl).Err(); err != nil {
+		return fmt.Errorf("redis_batch_cancel_set_failed: %w", err)
+	}
+	return nil
+}
+
+// IsCancelRequested reports whether a cancellation flag exists for the run.
+func (repository *RedisLockRepository) IsCancelRequested(context context.Context, runID string) (bool, error) {
+	count, err := repository.client.Exists(context, cancelKeyPrefix+runID).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis_batch_cancel_get_failed: %w", err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package mail
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/system/batch"
+)
+
+/*
+OutboxCleanupJob purges delivered and abandoned outbox messages.
+
+Description: Finished messages keep their recipient, subject and last error
+for [OutboxRetention] so support can answer "did my email go out?", then
+this job deletes them in batches of [OutboxCleanupBatchSize]. Their bodies
+were already dropped when they finished.
+*/
+type OutboxCleanupJob struct {
+	outboxRepo OutboxRepository
+	logger     *slog.Logger
+}
+
+// NewOutboxCleanupJob constructs a new [OutboxCleanupJob].
+func NewOutboxCleanupJob(outboxRepo OutboxRepository, logger *slog.Logger) *OutboxCleanupJob {
+	return &OutboxCleanupJob{
+		outboxRepo: outboxRepo,
+		logger:     logger,
+	}
+}
+
+// Definition describes the job for the batch scheduler.
+func (job *OutboxCleanupJob) Definition() batch.Job {
+	return batch.Job{
+		Key:         OutboxCleanupJobKey,
+		Description: "Delete sent and failed emails past the retention window",
+		Cron:        OutboxCleanupCron,
+		Timeout:     OutboxCleanupTimeout,
+		Handler: func(context context.Context, _ batch.Execution) (batch.Result, error) {
+			deleted, err := job.Purge(context, time.Now().UTC().Add(-OutboxRetention))
+			return batch.Result{
+				RowsAffected: int64(deleted),
+				Meta:         map[string]any{"messages_deleted": deleted},
+			}, err
+		},
+	}
+}
+
+/*
+Purge deletes every finished message created before the cutoff.
+
+Parameters:
+  - context: context.Context
+  - cutoff: time.Time (End of the retention window)
+
+Returns:
+  - int: Number of deleted messages, including batches before a failure
+  - error: Repository level errors or context cancellation
+*/
+func (job *OutboxCleanupJob) Purge(context context.Context, cutoff time.Time) (int, error) {
+	deleted := 0
+	for {
+		affected, err := job.outboxRepo.DeleteFinished(context, cutoff, OutboxCleanupBatchSize)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += affected
+
+		if affected < OutboxCleanupBatchSize {
+			break
+		}
+	}
+
+	job.logger.Info("mail_outbox_cleanup_finished",
+		slog.Int("messages_deleted", deleted),
+		slog.Time("cutoff", cutoff),
+	)
+
+	return deleted, nil
+}
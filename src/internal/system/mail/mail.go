@@ -0,0 +1,175 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+/*
+Package mail delivers transactional email through a persistent outbox.
+
+Domains never talk to an SMTP server directly. They enqueue an [Email],
+which is rendered immediately and stored in system.mailoutbox; a dispatcher
+loop then hands due messages to a [Sender] and retries transient failures
+with exponential backoff.
+
+Core Responsibility:
+
+  - Templates: Embedded html/template and text/template pairs per [Template].
+  - Outbox: Durable queue that survives restarts and SMTP outages.
+  - Delivery: SMTP in production, .eml files or log lines in development.
+  - Retention: Rendered bodies are sealed at rest and dropped once delivered.
+*/
+package mail
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// # Domain Enums
+
+// Template identifies a transactional email layout.
+type Template string
+
+const (
+	// TemplateVerifyEmail asks a new member to confirm their address.
+	TemplateVerifyEmail Template = "verify-email"
+
+	// TemplatePasswordReset carries a forgot-password link.
+	TemplatePasswordReset Template = "password-reset"
+
+	// TemplateEmailChangeConfirm asks the owner of a new address to confirm an email change.
+	TemplateEmailChangeConfirm Template = "email-change-confirm"
+
+	// TemplateEmailChangeNotice warns the current address about a pending change, with a cancel link.
+	TemplateEmailChangeNotice Template = "email-change-notice"
+
+	// TemplateEmailChangedNotice tells the previous address that the change went through.
+	TemplateEmailChangedNotice Template = "email-changed-notice"
+
+	// TemplateDataExportReady tells a member that their personal data archive can be downloaded.
+	TemplateDataExportReady Template = "data-export-ready"
+
+	// TemplateAccountDeletion confirms a deletion request, with a link to cancel it during the grace period.
+	TemplateAccountDeletion Template = "account-deletion"
+)
+
+// templates lists every template parsed at startup.
+var templates = []Template{
+	TemplateVerifyEmail,
+	TemplatePasswordReset,
+	TemplateEmailChangeConfirm,
+	TemplateEmailChangeNotice,
+	TemplateEmailChangedNotice,
+	TemplateDataExportReady,
+	TemplateAccountDeletion,
+}
+
+// IsValid reports whether t is a recognised [Template] value.
+func (t Template) IsValid() bool {
+	return slices.Contains(templates, t)
+}
+
+// Status describes where an outbox message stands in its delivery lifecycle.
+type Status string
+
+const (
+	// StatusPending indicates the message waits for its next delivery attempt.
+	StatusPending Status = "pending"
+
+	// StatusSent indicates the SMTP server accepted the message.
+	StatusSent Status = "sent"
+
+	// StatusFailed indicates the message was rejected or ran out of attempts.
+	StatusFailed Status = "failed"
+)
+
+// # Contracts
+
+// Sender hands a fully rendered message to a delivery backend.
+type Sender interface {
+	// Send delivers one message. Errors wrapping [ErrRejected] are permanent;
+	// every other error is retried.
+	Send(context context.Context, message Message) error
+}
+
+// # Entities
+
+// Email is a request to send a template to one recipient.
+type Email struct {
+	To       string   // Recipient address
+	Template Template // Layout to render
+	Data     any      // Template specific data, e.g. [VerifyEmailData]
+}
+
+// Message is a rendered email ready for delivery.
+type Message struct {
+	ID      string // Outbox ID, reused as the Message-ID local part
+	From    string
+	ReplyTo string
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// OutboxMessage is the persisted record of a queued email.
+type OutboxMessage struct {
+	ID            string
+	Template      Template
+	Recipient     string
+	Subject       string
+	Payload       string // HTML and text bodies, sealed with [sec.SecretBox]; cleared once finished
+	Status        Status
+	Attempts      int
+	LastError     *string
+	NextAttemptAt time.Time
+	SentAt        *time.Time
+	CreatedAt     time.Time
+}
+
+// # Constraints
+
+const (
+	// PollInterval is how often the dispatcher looks for due messages when it is not woken by an enqueue.
+	PollInterval = 10 * time.Second
+
+	// DispatchBatchSize caps the messages claimed by one dispatcher pass.
+	DispatchBatchSize = 20
+
+	// SendTimeout bounds a single delivery attempt, including the SMTP handshake.
+	SendTimeout = 30 * time.Second
+
+	// ClaimLease hides a claimed message from other replicas. A replica that dies
+	// mid-send releases its messages after at most this long.
+	ClaimLease = 5 * time.Minute
+
+	// MaxAttempts is how many deliveries are tried before a message is marked failed.
+	MaxAttempts = 8
+
+	// RetryBaseDelay is the wait after the first failed attempt; it doubles per attempt.
+	RetryBaseDelay = 30 * time.Second
+
+	// RetryMaxDelay caps the backoff between two attempts.
+	RetryMaxDelay = time.Hour
+
+	// MaxErrorLength truncates stored error messages.
+	MaxErrorLength = 2000
+)
+
+// # Outbox Cleanup
+
+const (
+	// OutboxCleanupJobKey identifies the outbox purge in the batch registry.
+	OutboxCleanupJobKey = "mail.outbox_cleanup"
+
+	// OutboxCleanupCron runs the purge once a day by default.
+	OutboxCleanupCron = "30 3 * * *"
+
+	// OutboxCleanupTimeout bounds a single purge run.
+	OutboxCleanupTimeout = 30 * time.Minute
+
+	// OutboxRetention keeps finished messages visible for support requests before deletion.
+	OutboxRetention = 30 * 24 * time.Hour
+
+	// OutboxCleanupBatchSize caps the rows removed by a single DELETE.
+	OutboxCleanupBatchSize = 1000
+)
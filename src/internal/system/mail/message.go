@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// # MIME Composition
+
+// envelope is a message encoded for the wire, with the bare SMTP addresses.
+type envelope struct {
+	From string
+	To   string
+	Data []byte
+}
+
+/*
+compose encodes a message as multipart/alternative MIME.
+
+Description: Addresses are parsed and re-formatted and the subject is
+Q-encoded, so no caller supplied value can inject extra headers. Both
+bodies use quoted-printable to stay within SMTP line limits.
+
+Parameters:
+  - message: Message
+  - now: time.Time (Date header)
+
+Returns:
+  - envelope: SMTP addresses and the encoded message
+  - error: Malformed addresses
+*/
+func compose(message Message, now time.Time) (envelope, error) {
+	from, err := netmail.ParseAddress(message.From)
+	if err != nil {
+		return envelope{}, fmt.Errorf("%w: invalid sender %q: %w", ErrRejected, message.From, err)
+	}
+
+	to, err := netmail.ParseAddress(message.To)
+	if err != nil {
+		return envelope{}, fmt.Errorf("%w: invalid recipient: %w", ErrRejected, err)
+	}
+
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", message.Text},
+		{"text/html; charset=utf-8", message.HTML},
+	} {
+		writer, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return envelope{}, err
+		}
+
+		encoder := quotedprintable.NewWriter(writer)
+		if _, err := encoder.Write([]byte(part.content)); err != nil {
+			return envelope{}, err
+		}
+		if err := encoder.Close(); err != nil {
+			return envelope{}, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return envelope{}, err
+	}
+
+	var data bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&data, "%s: %s\r\n", name, value)
+	}
+
+	header("From", from.String())
+	if message.ReplyTo != "" {
+		if replyTo, err := netmail.ParseAddress(message.ReplyTo); err == nil {
+			header("Reply-To", replyTo.String())
+		}
+	}
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", message.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", fmt.Sprintf("<%s@%s>", message.ID, domainOf(from.Address)))
+	header("MIME-Version", "1.0")
+	header("Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", parts.Boundary()))
+	data.WriteString("\r\n")
+	data.Write(body.Bytes())
+
+	return envelope{From: from.Address, To: to.Address, Data: data.Bytes()}, nil
+}
+
+// domainOf returns the domain part of an address, used to scope Message-IDs.
+func domainOf(address string) string {
+	if at := strings.LastIndexByte(address, '@'); at >= 0 {
+		return address[at+1:]
+	}
+	return "localhost"
+}
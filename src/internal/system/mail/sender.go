@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/config"
+)
+
+// ErrRejected marks a permanent delivery failure (bad address, 5xx reply). Such messages are not retried.
+var ErrRejected = errors.New("mail: message rejected")
+
+// # Providers
+
+// Provider selects the delivery backend via MAIL_PROVIDER.
+const (
+	ProviderSMTP = "smtp" // Deliver through SMTP_HOST
+	ProviderFile = "file" // Write .eml files to MAIL_FILE_DIR (development)
+	ProviderLog  = "log"  // Log the plain-text body (development)
+)
+
+/*
+NewSenderFromConfig builds the [Sender] selected by MAIL_PROVIDER.
+
+Parameters:
+  - cfg: *config.Config
+  - logger: *slog.Logger
+
+Returns:
+  - Sender: Configured backend
+  - error: Unknown provider
+*/
+func NewSenderFromConfig(cfg *config.Config, logger *slog.Logger) (Sender, error) {
+	switch cfg.MailProvider {
+	case ProviderSMTP:
+		return NewSMTPSender(SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUser,
+			Password: cfg.SMTPPass,
+			TLS:      TLSMode(cfg.SMTPTLS),
+		}), nil
+	case ProviderFile:
+		return NewFileSender(cfg.MailFileDir), nil
+	case ProviderLog:
+		return NewLogSender(logger), nil
+	}
+	return nil, fmt.Errorf("mail: unknown provider %q", cfg.MailProvider)
+}
+
+// # Development Senders
+
+// FileSender writes every message as an .eml file that mail clients can open.
+type FileSender struct {
+	dir string
+}
+
+// NewFileSender constructs a [FileSender] writing into dir.
+func NewFileSender(dir string) *FileSender {
+	return &FileSender{dir: dir}
+}
+
+// Send writes the message to DIR/TIMESTAMP-ID.eml.
+func (sender *FileSender) Send(_ context.Context, message Message) error {
+	now := time.Now().UTC()
+	encoded, err := compose(message, now)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(sender.dir, 0o750); err != nil {
+		return fmt.Errorf("mail_file_sender_mkdir_failed: %w", err)
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405"), message.ID)
+	if err := os.WriteFile(filepath.Join(sender.dir, name), encoded.Data, 0o600); err != nil {
+		return fmt.Errorf("mail_file_sender_write_failed: %w", err)
+	}
+	return nil
+}
+
+// LogSender logs messages instead of delivering them. Links in the body are
+// usable as is, which makes it the default for local development.
+type LogSender struct {
+	logger *slog.Logger
+}
+
+// NewLogSender constructs a [LogSender].
+func NewLogSender(logger *slog.Logger) *LogSender {
+	return &LogSender{logger: logger}
+}
+
+// Send logs the recipient, subject and plain-text body.
+func (sender *LogSender) Send(_ context.Context, message Message) error {
+	sender.logger.Info("mail_logged",
+		slog.String("message_id", message.ID),
+		slog.String("to", message.To),
+		slog.String("subject", message.Subject),
+		slog.String("text", message.Text),
+	)
+	return nil
+}
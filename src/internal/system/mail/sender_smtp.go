@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+)
+
+// TLSMode selects how the SMTP connection is secured.
+type TLSMode string
+
+const (
+	// TLSStartTLS upgrades a plain connection and refuses servers without STARTTLS (port 587).
+	TLSStartTLS TLSMode = "starttls"
+
+	// TLSImplicit connects over TLS from the first byte (port 465).
+	TLSImplicit TLSMode = "tls"
+
+	// TLSNone sends in clear text; only for local catch-all servers such as Mailpit.
+	TLSNone TLSMode = "none"
+)
+
+// IsValid reports whether m is a recognised [TLSMode] value.
+func (m TLSMode) IsValid() bool {
+	return m == TLSStartTLS || m == TLSImplicit || m == TLSNone
+}
+
+// SMTPConfig holds the connection settings of an [SMTPSender].
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // Empty skips AUTH
+	Password string
+	TLS      TLSMode
+}
+
+// SMTPSender delivers messages to an SMTP relay, one connection per message.
+type SMTPSender struct {
+	config SMTPConfig
+}
+
+// NewSMTPSender constructs a new [SMTPSender].
+func NewSMTPSender(config SMTPConfig) *SMTPSender {
+	return &SMTPSender{config: config}
+}
+
+/*
+Send delivers a message over a fresh SMTP session.
+
+Description: The context deadline bounds the whole session, including the
+TLS handshake. A 5xx reply to the recipient or the content wraps
+[ErrRejected] so the outbox stops retrying. Everything else, including
+failed authentication, is treated as transient because it is usually a
+relay outage or a configuration problem that will be fixed.
+
+Parameters:
+  - context: context.Context
+  - message: Message
+
+Returns:
+  - error: Delivery failures
+*/
+func (sender *SMTPSender) Send(context context.Context, message Message) error {
+	encoded, err := compose(message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	address := net.JoinHostPort(sender.config.Host, strconv.Itoa(sender.config.Port))
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(context, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("mail_smtp_dial_failed: %w", err)
+	}
+
+	deadline, ok := context.Deadline()
+	if !ok {
+		deadline = time.Now().Add(SendTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("mail_smtp_deadline_failed: %w", err)
+	}
+
+	tlsConfig := &tls.Config{ServerName: sender.config.Host, MinVersion: tls.VersionTLS12}
+	if sender.config.TLS == TLSImplicit {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	client, err := smtp.NewClient(conn, sender.config.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("mail_smtp_greeting_failed: %w", err)
+	}
+	defer client.Close()
+
+	if sender.config.TLS == TLSStartTLS {
+		if supported, _ := client.Extension("STARTTLS"); !supported {
+			return errors.New("mail_smtp_starttls_unsupported")
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("mail_smtp_starttls_failed: %w", err)
+		}
+	}
+
+	if sender.config.Username != "" {
+		auth := smtp.PlainAuth("", sender.config.Username, sender.config.Password, sender.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("mail_smtp_auth_failed: %w", err)
+		}
+	}
+
+	if err := client.Mail(encoded.From); err != nil {
+		return fmt.Errorf("mail_smtp_mail_from_failed: %w", err)
+	}
+	if err := client.Rcpt(encoded.To); err != nil {
+		return classifySMTPError("rcpt_to", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return classifySMTPError("data", err)
+	}
+	if _, err := writer.Write(encoded.Data); err != nil {
+		return classifySMTPError("data", err)
+	}
+	if err := writer.Close(); err != nil {
+		return classifySMTPError("data", err)
+	}
+
+	// The message is accepted once DATA completes; a failed QUIT changes nothing
+	_ = client.Quit()
+	return nil
+}
+
+// classifySMTPError tags permanent replies about the message itself with [ErrRejected].
+func classifySMTPError(step string, err error) error {
+	var reply *textproto.Error
+	if errors.As(err, &reply) && reply.Code >= 500 {
+		return fmt.Errorf("%w: smtp %s: %w", ErrRejected, step, err)
+	}
+	return fmt.Errorf("mail_smtp_%s_failed: %w", step, err)
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package mail_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	netmail "net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taibuivan/yomira/internal/system/mail"
+)
+
+// # Fake SMTP Server
+
+// smtpSession records what a client sent during one SMTP conversation.
+type smtpSession struct {
+	Auth     string
+	MailFrom string
+	RcptTo   []string
+	Data     string
+}
+
+// fakeSMTP is a minimal plain-text SMTP server for a single session.
+type fakeSMTP struct {
+	listener   net.Listener
+	rejectRcpt int // Reply code for RCPT TO; zero accepts
+	sessions   chan smtpSession
+}
+
+func startFakeSMTP(t *testing.T, rejectRcpt int) *fakeSMTP {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	server := &fakeSMTP{listener: listener, rejectRcpt: rejectRcpt, sessions: make(chan smtpSession, 1)}
+	go server.serve()
+	return server
+}
+
+func (server *fakeSMTP) config() mail.SMTPConfig {
+	host, port, _ := net.SplitHostPort(server.listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+	return mail.SMTPConfig{Host: host, Port: portNumber, TLS: mail.TLSNone}
+}
+
+func (server *fakeSMTP) serve() {
+	conn, err := server.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { fmt.Fprintf(conn, "%s\r\n", line) }
+
+	session := smtpSession{}
+	defer func() { server.sessions <- session }()
+
+	reply("220 fake.local ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(command, " ", 2)[0])
+
+		switch verb {
+		case "EHLO", "HELO":
+			reply("250-fake.local")
+			reply("250-8BITMIME")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			session.Auth = strings.TrimPrefix(command, "AUTH PLAIN ")
+			reply("235 2.7.0 Authentication successful")
+		case "MAIL":
+			session.MailFrom = command
+			reply("250 OK")
+		case "RCPT":
+			if server.rejectRcpt != 0 {
+				reply(fmt.Sprintf("%d mailbox unavailable", server.rejectRcpt))
+				continue
+			}
+			session.RcptTo = append(session.RcptTo, command)
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(dataLine, "."))
+			}
+			session.Data = data.String()
+			reply("250 OK queued")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func (server *fakeSMTP) session(t *testing.T) smtpSession {
+	select {
+	case session := <-server.sessions:
+		return session
+	case <-time.After(5 * time.Second):
+		t.Fatal("fake SMTP server saw no session")
+		return smtpSession{}
+	}
+}
+
+func testMessage() mail.Message {
+	return mail.Message{
+		ID:      "0192f0c4-0000-7000-8000-000000000001",
+		From:    "Yomira <noreply@yomira.app>",
+		ReplyTo: "support@yomira.app",
+		To:      "mai@example.com",
+		Subject: "Vérifiez votre adresse",
+		HTML:    `<p>Open <a href="https://yomira.app/x?token=abc">this link</a></p>`,
+		Text:    "Open https://yomira.app/x?token=abc\n",
+	}
+}
+
+// # Tests
+
+/*
+TestSMTPSender_Send delivers a multipart message with authentication and
+checks that the headers and both bodies survive the round trip.
+*/
+func TestSMTPSender_Send(t *testing.T) {
+	server := startFakeSMTP(t, 0)
+	config := server.config()
+	config.Username = "mailer"
+	config.Password = "secret"
+
+	context, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, mail.NewSMTPSender(config).Send(context, testMessage()))
+	session := server.session(t)
+
+	credentials, err := base64.StdEncoding.DecodeString(session.Auth)
+	require.NoError(t, err)
+	assert.Equal(t, "\x00mailer\x00secret", string(credentials))
+	assert.Equal(t, "MAIL FROM:<noreply@yomira.app> BODY=8BITMIME", session.MailFrom)
+	assert.Equal(t, []string{"RCPT TO:<mai@example.com>"}, session.RcptTo)
+
+	parsed, err := netmail.ReadMessage(strings.NewReader(session.Data))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Vérifiez votre adresse", subject)
+	assert.Equal(t, `"Yomira" <noreply@yomira.app>`, parsed.Header.Get("From"))
+	assert.Equal(t, "<support@yomira.app>", parsed.Header.Get("Reply-To"))
+	assert.Equal(t, "<0192f0c4-0000-7000-8000-000000000001@yomira.app>", parsed.Header.Get("Message-ID"))
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	parts := multipart.NewReader(parsed.Body, params["boundary"])
+	bodies := map[string]string{}
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(part) // Decodes quoted-printable transparently
+		require.NoError(t, err)
+		// SMTP transmits CRLF line endings
+		bodies[strings.SplitN(part.Header.Get("Content-Type"), ";", 2)[0]] = strings.ReplaceAll(string(content), "\r\n", "\n")
+	}
+
+	assert.Equal(t, testMessage().Text, bodies["text/plain"])
+	assert.Equal(t, testMessage().HTML, bodies["text/html"])
+}
+
+/*
+TestSMTPSender_Rejected marks 5xx replies as permanent and 4xx as transient.
+*/
+func TestSMTPSender_Rejected(t *testing.T) {
+	context, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := mail.NewSMTPSender(startFakeSMTP(t, 550).config()).Send(context, testMessage())
+	assert.ErrorIs(t, err, mail.ErrRejected)
+
+	err = mail.NewSMTPSender(startFakeSMTP(t, 451).config()).Send(context, testMessage())
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, mail.ErrRejected)
+}
+
+/*
+TestSMTPSender_RequiresStartTLS refuses to send in clear text when STARTTLS
+is required but not offered.
+*/
+func TestSMTPSender_RequiresStartTLS(t *testing.T) {
+	config := startFakeSMTP(t, 0).config()
+	config.TLS = mail.TLSStartTLS
+
+	context, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := mail.NewSMTPSender(config).Send(context, testMessage())
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, mail.ErrRejected)
+}
+
+/*
+TestSMTPSender_InvalidRecipient rejects malformed addresses before dialing.
+*/
+func TestSMTPSender_InvalidRecipient(t *testing.T) {
+	message := testMessage()
+	message.To = "mai@example.com\r\nBcc: victim@example.com"
+
+	err := mail.NewSMTPSender(mail.SMTPConfig{Host: "127.0.0.1", Port: 1, TLS: mail.TLSNone}).Send(context.Background(), message)
+	assert.ErrorIs(t, err, mail.ErrRejected)
+}
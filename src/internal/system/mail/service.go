@@ -0,0 +1,251 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package mail
+
+import (
+	stdctx "context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # Service Layer
+
+// Service queues rendered emails in the outbox and delivers them in the background.
+type Service struct {
+	outboxRepo OutboxRepository
+	renderer   *Renderer
+	sender     Sender
+	secretBox  *sec.SecretBox
+	from       string
+	replyTo    string
+	logger     *slog.Logger
+
+	wake      chan struct{} // Signals the dispatcher that a message was enqueued
+	waitGroup sync.WaitGroup
+}
+
+// NewService constructs a new [Service]. from and replyTo are RFC 5322 addresses.
+func NewService(outboxRepo OutboxRepository, renderer *Renderer, sender Sender, secretBox *sec.SecretBox, from, replyTo string, logger *slog.Logger) *Service {
+	return &Service{
+		outboxRepo: outboxRepo,
+		renderer:   renderer,
+		sender:     sender,
+		secretBox:  secretBox,
+		from:       from,
+		replyTo:    replyTo,
+		logger:     logger,
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+// payload is the sealed part of an outbox row.
+type payload struct {
+	HTML string `json:"html"`
+	Text string `json:"text"`
+}
+
+/*
+Enqueue renders an email and stores it in the outbox.
+
+Description: Rendering happens now, so template errors surface to the
+caller instead of in the background. The bodies carry single-use links and
+are sealed before they touch the database.
+
+Parameters:
+  - context: context.Context
+  - email: Email
+
+Returns:
+  - error: Rendering or storage failures
+*/
+func (service *Service) Enqueue(context stdctx.Context, email Email) error {
+	message, err := service.renderer.Render(email)
+	if err != nil {
+		return fmt.Errorf("mail_service_render_failed: %w", err)
+	}
+
+	encoded, err := json.Marshal(payload{HTML: message.HTML, Text: message.Text})
+	if err != nil {
+		return fmt.Errorf("mail_service_encode_failed: %w", err)
+	}
+
+	sealed, err := service.secretBox.Seal(string(encoded))
+	if err != nil {
+		return fmt.Errorf("mail_service_seal_failed: %w", err)
+	}
+
+	now := time.Now().UTC()
+	outboxMessage := &OutboxMessage{
+		ID:            uuid.New(),
+		Template:      email.Template,
+		Recipient:     email.To,
+		Subject:       message.Subject,
+		Payload:       sealed,
+		Status:        StatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+
+	if err := service.outboxRepo.Create(context, outboxMessage); err != nil {
+		return fmt.Errorf("mail_service_enqueue_failed: %w", err)
+	}
+
+	// Deliver right away on this replica; the poll covers a full channel
+	select {
+	case service.wake <- struct{}{}:
+	default:
+	}
+
+	service.logger.Info("mail_enqueued",
+		slog.String("message_id", outboxMessage.ID),
+		slog.String("template", string(email.Template)),
+	)
+
+	return nil
+}
+
+// # Dispatcher Loop
+
+/*
+Start delivers due messages until the context is cancelled.
+
+Description: The loop runs a pass every [PollInterval] and immediately
+after an enqueue on this replica. Every replica runs it; claims in the
+outbox keep them from sending the same message twice.
+
+Parameters:
+  - context: context.Context (Application lifecycle)
+*/
+func (service *Service) Start(context stdctx.Context) {
+	service.logger.Info("mail_dispatcher_started")
+
+	ticker := time.NewTicker(PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-context.Done():
+			service.logger.Info("mail_dispatcher_stopped")
+			return
+		case <-ticker.C:
+		case <-service.wake:
+		}
+
+		service.waitGroup.Add(1)
+		service.dispatch(context)
+		service.waitGroup.Done()
+	}
+}
+
+// Wait blocks until the current dispatcher pass has recorded its outcomes.
+func (service *Service) Wait() {
+	service.waitGroup.Wait()
+}
+
+// dispatch claims and delivers batches until nothing is due.
+func (service *Service) dispatch(context stdctx.Context) {
+	for context.Err() == nil {
+		now := time.Now().UTC()
+		messages, err := service.outboxRepo.ClaimDue(context, now, now.Add(ClaimLease), DispatchBatchSize)
+		if err != nil {
+			service.logger.Error("mail_claim_failed", slog.Any("error", err))
+			return
+		}
+
+		for _, message := range messages {
+			service.deliver(context, message)
+		}
+
+		if len(messages) < DispatchBatchSize {
+			return
+		}
+	}
+}
+
+// deliver sends one claimed message and records the outcome.
+func (service *Service) deliver(context stdctx.Context, outboxMessage *OutboxMessage) {
+	sendErr := service.send(context, outboxMessage)
+
+	// Outcomes are recorded even during shutdown; otherwise the message waits for its lease
+	recordCtx, cancel := stdctx.WithTimeout(stdctx.WithoutCancel(context), 5*time.Second)
+	defer cancel()
+
+	logger := service.logger.With(
+		slog.String("message_id", outboxMessage.ID),
+		slog.String("template", string(outboxMessage.Template)),
+		slog.Int("attempt", outboxMessage.Attempts),
+	)
+
+	var recordErr error
+	switch {
+	case sendErr == nil:
+		recordErr = service.outboxRepo.MarkSent(recordCtx, outboxMessage.ID, time.Now().UTC())
+		logger.Info("mail_sent")
+
+	case errors.Is(sendErr, ErrRejected) || outboxMessage.Attempts >= MaxAttempts:
+		recordErr = service.outboxRepo.MarkFailed(recordCtx, outboxMessage.ID, truncateError(sendErr))
+		logger.Error("mail_failed", slog.Any("error", sendErr))
+
+	default:
+		nextAttemptAt := time.Now().UTC().Add(RetryDelay(outboxMessage.Attempts))
+		recordErr = service.outboxRepo.MarkRetry(recordCtx, outboxMessage.ID, nextAttemptAt, truncateError(sendErr))
+		logger.Warn("mail_retry_scheduled", slog.Time("next_attempt_at", nextAttemptAt), slog.Any("error", sendErr))
+	}
+
+	if recordErr != nil {
+		logger.Error("mail_outcome_record_failed", slog.Any("error", recordErr))
+	}
+}
+
+// send opens the sealed bodies and hands the message to the sender.
+func (service *Service) send(context stdctx.Context, outboxMessage *OutboxMessage) error {
+	opened, err := service.secretBox.Open(outboxMessage.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrRejected, err)
+	}
+
+	var body payload
+	if err := json.Unmarshal([]byte(opened), &body); err != nil {
+		return fmt.Errorf("%w: %w", ErrRejected, err)
+	}
+
+	sendCtx, cancel := stdctx.WithTimeout(context, SendTimeout)
+	defer cancel()
+
+	return service.sender.Send(sendCtx, Message{
+		ID:      outboxMessage.ID,
+		From:    service.from,
+		ReplyTo: service.replyTo,
+		To:      outboxMessage.Recipient,
+		Subject: outboxMessage.Subject,
+		HTML:    body.HTML,
+		Text:    body.Text,
+	})
+}
+
+// RetryDelay returns the wait after the given failed attempt: [RetryBaseDelay]
+// doubled per previous attempt, capped at [RetryMaxDelay].
+func RetryDelay(attempt int) time.Duration {
+	delay := RetryBaseDelay
+	for i := 1; i < attempt && delay < RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, RetryMaxDelay)
+}
+
+// truncateError keeps stored error messages within [MaxErrorLength].
+func truncateError(err error) string {
+	message := err.Error()
+	if len(message) > MaxErrorLength {
+		return message[:MaxErrorLength]
+	}
+	return message
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package mail_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taibuivan/yomira/internal/system/mail"
+)
+
+/*
+TestRetryDelay doubles the backoff per attempt and caps it.
+*/
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, mail.RetryDelay(1))
+	assert.Equal(t, time.Minute, mail.RetryDelay(2))
+	assert.Equal(t, 4*time.Minute, mail.RetryDelay(4))
+	assert.Equal(t, time.Hour, mail.RetryDelay(8))
+	assert.Equal(t, time.Hour, mail.RetryDelay(100))
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package mail
+
+import (
+	"context"
+	"time"
+)
+
+// # Outbox Data Access
+
+// OutboxRepository defines the data access contract for queued emails.
+type OutboxRepository interface {
+
+	/*
+		Create persists a new pending message.
+
+		Parameters:
+		  - context: context.Context
+		  - message: *OutboxMessage
+
+		Returns:
+		  - error: Storage failures
+	*/
+	Create(context context.Context, message *OutboxMessage) error
+
+	/*
+		ClaimDue locks pending messages whose next attempt has come.
+
+		Description: Claimed messages count the attempt and are hidden until
+		leaseUntil, so replicas never send the same message concurrently and a
+		crashed replica releases its claims on its own.
+
+		Parameters:
+		  - context: context.Context
+		  - now: time.Time
+		  - leaseUntil: time.Time
+		  - limit: int
+
+		Returns:
+		  - []*OutboxMessage: Claimed messages, oldest due first
+		  - error: Storage failures
+	*/
+	ClaimDue(context context.Context, now, leaseUntil time.Time, limit int) ([]*OutboxMessage, error)
+
+	/*
+		MarkSent records a successful delivery and drops the sealed bodies.
+
+		Parameters:
+		  - context: context.Context
+		  - id: string
+		  - sentAt: time.Time
+
+		Returns:
+		  - error: Storage failures
+	*/
+	MarkSent(context context.Context, id string, sentAt time.Time) error
+
+	/*
+		MarkRetry schedules another attempt after a transient failure.
+
+		Parameters:
+		  - context: context.Context
+		  - id: string
+		  - nextAttemptAt: time.Time
+		  - lastError: string
+
+		Returns:
+		  - error: Storage failures
+	*/
+	MarkRetry(context context.Context, id string, nextAttemptAt time.Time, lastError string) error
+
+	/*
+		MarkFailed gives up on a message and drops the sealed bodies.
+
+		Parameters:
+		  - context: context.Context
+		  - id: string
+		  - lastError: string
+
+		Returns:
+		  - error: Storage failures
+	*/
+	MarkFailed(context context.Context, id string, lastError string) error
+
+	/*
+		DeleteFinished removes one batch of sent or failed messages created before the cutoff.
+
+		Parameters:
+		  - context: context.Context
+		  - cutoff: time.Time
+		  - limit: int
+
+		Returns:
+		  - int: Number of deleted messages
+		  - error: Storage failures
+	*/
+	DeleteFinished(context context.Context, cutoff time.Time, limit int) (int, error)
+}
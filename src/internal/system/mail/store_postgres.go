@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package mail
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+)
+
+// # PostgreSQL Repositories
+
+// outboxRepository implements the [OutboxRepository] interface using pgx.
+type outboxRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewOutboxRepository constructs a PostgreSQL backed mail outbox.
+func NewOutboxRepository(pool *pgxpool.Pool) OutboxRepository {
+	return &outboxRepository{pool: pool}
+}
+
+// # Outbox Repository Implementation
+
+// Create persists a new pending message.
+func (repository *outboxRepository) Create(context context.Context, message *OutboxMessage) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES ($1, $2, $3, $4, $5, $6, 0, $7, $8)`,
+		schema.SystemMailOutbox.Table,
+		schema.SystemMailOutbox.ID, schema.SystemMailOutbox.Template, schema.SystemMailOutbox.Recipient,
+		schema.SystemMailOutbox.Subject, schema.SystemMailOutbox.Payload, schema.SystemMailOutbox.Status,
+		schema.SystemMailOutbox.Attempts, schema.SystemMailOutbox.NextAttemptAt, schema.SystemMailOutbox.CreatedAt,
+	)
+
+	_, err := repository.pool.Exec(context, query,
+		message.ID,
+		message.Template,
+		message.Recipient,
+		message.Subject,
+		message.Payload,
+		message.Status,
+		message.NextAttemptAt,
+		message.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres_mail_repo_create_failed: %w", err)
+	}
+	return nil
+}
+
+// ClaimDue locks due pending messages with SKIP LOCKED and pushes their next attempt past the lease.
+func (repository *outboxRepository) ClaimDue(context context.Context, now, leaseUntil time.Time, limit int) ([]*OutboxMessage, error) {
+	query := fmt.Sprintf(`
+		UPDATE %[1]s o
+		SET %[3]s = o.%[3]s + 1, %[4]s = $2
+		FROM (
+			SELECT %[2]s FROM %[1]s
+			WHERE %[5]s = $4 AND %[4]s <= $1
+			ORDER BY %[4]s
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		) due
+		WHERE o.%[2]s = due.%[2]s
+		RETURNING o.%[2]s, o.%[6]s, o.%[7]s, o.%[8]s, o.%[9]s, o.%[5]s, o.%[3]s, o.%[4]s, o.%[10]s`,
+		schema.SystemMailOutbox.Table,         // 1
+		schema.SystemMailOutbox.ID,            // 2
+		schema.SystemMailOutbox.Attempts,      // 3
+		schema.SystemMailOutbox.NextAttemptAt, // 4
+		schema.SystemMailOutbox.Status,        // 5
+		schema.SystemMailOutbox.Template,      // 6
+		schema.SystemMailOutbox.Recipient,     // 7
+		schema.SystemMailOutbox.Subject,       // 8
+		schema.SystemMailOutbox.Payload,       // 9
+		schema.SystemMailOutbox.CreatedAt,     // 10
+	)
+
+	rows, err := repository.pool.Query(context, query, now, leaseUntil, limit, StatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("postgres_mail_repo_claim_failed: %w", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*OutboxMessage, 0)
+	for rows.Next() {
+		message := &OutboxMessage{}
+		if err := rows.Scan(
+			&message.ID,
+			&message.Template,
+			&message.Recipient,
+			&message.Subject,
+			&message.Payload,
+			&message.Status,
+			&message.Attempts,
+			&message.NextAttemptAt,
+			&message.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("postgres_mail_repo_scan_failed: %w", err)
+		}
+		messages = append(messages, message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres_mail_repo_rows_failed: %w", err)
+	}
+
+	return messages, nil
+}
+
+// MarkSent records a successful delivery and drops the sealed bodies.
+func (repository *outboxRepository) MarkSent(context context.Context, id string, sentAt time.Time) error {
+	query := fmt.Sprintf("UPDATE %s SET %s = $2, %s = $3, %s = '', %s = NULL WHERE %s = $1",
+		schema.SystemMailOutbox.Table,
+		schema.SystemMailOutbox.Status, schema.SystemMailOutbox.SentAt,
+		schema.SystemMailOutbox.Payload, schema.SystemMailOutbox.LastError,
+		schema.SystemMailOutbox.ID,
+	)
+
+	if _, err := repository.pool.Exec(context, query, id, StatusSent, sentAt); err != nil {
+		return fmt.Errorf("postgres_mail_repo_mark_sent_failed: %w", err)
+	}
+	return nil
+}
+
+// MarkRetry schedules another attempt after a transient failure.
+func (repository *outboxRepository) MarkRetry(context context.Context, id string, nextAttemptAt time.Time, lastError string) error {
+	query := fmt.Sprintf("UPDATE %s SET %s = $2, %s = $3 WHERE %s = $1",
+		schema.SystemMailOutbox.Table,
+		schema.SystemMailOutbox.NextAttemptAt, schema.SystemMailOutbox.LastError,
+		schema.SystemMailOutbox.ID,
+	)
+
+	if _, err := repository.pool.Exec(context, query, id, nextAttemptAt, lastError); err != nil {
+		return fmt.Errorf("postgres_mail_repo_mark_retry_failed: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed gives up on a message and drops the sealed bodies.
+func (repository *outboxRepository) MarkFailed(context context.Context, id string, lastError string) error {
+	query := fmt.Sprintf("UPDATE %s SET %s = $2, %s = $3, %s = '' WHERE %s = $1",
+		schema.SystemMailOutbox.Table,
+		schema.SystemMailOutbox.Status, schema.SystemMailOutbox.LastError,
+		schema.SystemMailOutbox.Payload,
+		schema.SystemMailOutbox.ID,
+	)
+
+	if _, err := repository.pool.Exec(context, query, id, StatusFailed, lastError); err != nil {
+		return fmt.Errorf("postgres_mail_repo_give_up_failed: %w", err)
+	}
+	return nil
+}
+
+// DeleteFinished removes one batch of sent or failed messages created before the cutoff.
+func (repository *outboxRepository) DeleteFinished(context context.Context, cutoff time.Time, limit int) (int, error) {
+	query := fmt.Sprintf(`
+		DELETE FROM %[1]s
+		WHERE %[2]s IN (
+			SELECT %[2]s FROM %[1]s
+			WHERE %[3]s <> $1 AND %[4]s < $2
+			LIMIT $3
+		)`,
+		schema.SystemMailOutbox.Table,     // 1
+		schema.SystemMailOutbox.ID,        // 2
+		schema.SystemMailOutbox.Status,    // 3
+		schema.SystemMailOutbox.CreatedAt, // 4
+	)
+
+	tag, err := repository.pool.Exec(context, query, StatusPending, cutoff, limit)
+	if err != nil {
+		return 0, fmt.Errorf("postgres_mail_repo_delete_finished_failed: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
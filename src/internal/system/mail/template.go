@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// # Template Data
+
+// VerifyEmailData fills [TemplateVerifyEmail].
+type VerifyEmailData struct {
+	Name      string // Display name or username of the recipient
+	Link      string // Absolute URL of the verification page
+	ExpiresIn time.Duration
+}
+
+// PasswordResetData fills [TemplatePasswordReset].
+type PasswordResetData struct {
+	Name      string
+	Link      string
+	ExpiresIn time.Duration
+}
+
+// EmailChangeConfirmData fills [TemplateEmailChangeConfirm].
+type EmailChangeConfirmData struct {
+	Name      string
+	NewEmail  string
+	Link      string
+	ExpiresIn time.Duration
+}
+
+// EmailChangeNoticeData fills [TemplateEmailChangeNotice].
+type EmailChangeNoticeData struct {
+	Name       string
+	NewEmail   string
+	CancelLink string
+	ExpiresIn  time.Duration
+}
+
+// EmailChangedNoticeData fills [TemplateEmailChangedNotice].
+type EmailChangedNoticeData struct {
+	Name     string
+	NewEmail string
+}
+
+// DataExportReadyData fills [TemplateDataExportReady].
+type DataExportReadyData struct {
+	Name      string
+	Link      string // Page listing the exports; downloading requires signing in
+	ExpiresIn time.Duration
+}
+
+// AccountDeletionData fills [TemplateAccountDeletion].
+type AccountDeletionData struct {
+	Name        string
+	CancelLink  string
+	GracePeriod time.Duration
+}
+
+// # Renderer
+
+//go:embed templates
+var templateFiles embed.FS
+
+// layoutFile wraps every HTML body.
+const layoutFile = "templates/layout.html"
+
+/*
+Renderer turns an [Email] into a [Message].
+
+Description: Every template is a pair of embedded files. NAME.html defines
+the "content" block of the shared HTML layout and is escaped by
+html/template. NAME.txt defines the "subject" block and the plain-text body
+and is rendered by text/template, so links stay readable in text clients.
+*/
+type Renderer struct {
+	html map[Template]*htmltemplate.Template
+	text map[Template]*texttemplate.Template
+}
+
+// NewRenderer parses every embedded template, failing fast on syntax errors.
+func NewRenderer() (*Renderer, error) {
+	renderer := &Renderer{
+		html: make(map[Template]*htmltemplate.Template, len(templates)),
+		text: make(map[Template]*texttemplate.Template, len(templates)),
+	}
+
+	for _, name := range templates {
+		htmlFile := fmt.Sprintf("templates/%s.html", name)
+		textFile := fmt.Sprintf("templates/%s.txt", name)
+
+		// The layout references the subject for the document title
+		html, err := htmltemplate.New("layout.html").Funcs(htmltemplate.FuncMap(templateFuncs)).ParseFS(templateFiles, layoutFile, htmlFile, textFile)
+		if err != nil {
+			return nil, fmt.Errorf("mail: parse %s html: %w", name, err)
+		}
+
+		text, err := texttemplate.New(string(name)+".txt").Funcs(texttemplate.FuncMap(templateFuncs)).ParseFS(templateFiles, textFile)
+		if err != nil {
+			return nil, fmt.Errorf("mail: parse %s text: %w", name, err)
+		}
+
+		renderer.html[name] = html
+		renderer.text[name] = text
+	}
+
+	return renderer, nil
+}
+
+/*
+Render executes the templates of an email.
+
+Parameters:
+  - email: Email
+
+Returns:
+  - Message: Subject and bodies, addressed to email.To (sender fields left empty)
+  - error: Unknown template or data that does not fit it
+*/
+func (renderer *Renderer) Render(email Email) (Message, error) {
+	html, ok := renderer.html[email.Template]
+	if !ok {
+		return Message{}, fmt.Errorf("mail: unknown template %q", email.Template)
+	}
+	text := renderer.text[email.Template]
+
+	var subject, textBody, htmlBody bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", email.Data); err != nil {
+		return Message{}, fmt.Errorf("mail: render %s subject: %w", email.Template, err)
+	}
+	if err := text.Execute(&textBody, email.Data); err != nil {
+		return Message{}, fmt.Errorf("mail: render %s text: %w", email.Template, err)
+	}
+	if err := html.Execute(&htmlBody, email.Data); err != nil {
+		return Message{}, fmt.Errorf("mail: render %s html: %w", email.Template, err)
+	}
+
+	return Message{
+		To:      email.To,
+		Subject: strings.Join(strings.Fields(subject.String()), " "), // Headers must stay on one line
+		HTML:    htmlBody.String(),
+		Text:    strings.TrimSpace(textBody.String()) + "\n",
+	}, nil
+}
+
+// # Template Functions
+
+// templateFuncs is shared by the HTML and text templates.
+var templateFuncs = map[string]any{
+	"duration": formatDuration,
+}
+
+// formatDuration renders a TTL the way people say it, e.g. "24 hours" or "30 minutes".
+func formatDuration(duration time.Duration) string {
+	plural := func(value int, unit string) string {
+		if value == 1 {
+			return "1 " + unit
+		}
+		return fmt.Sprintf("%d %ss", value, unit)
+	}
+
+	switch {
+	case duration >= 48*time.Hour && duration%(24*time.Hour) == 0:
+		return plural(int(duration/(24*time.Hour)), "day")
+	case duration >= time.Hour && duration%time.Hour == 0:
+		return plural(int(duration/time.Hour), "hour")
+	default:
+		return plural(max(int(duration/time.Minute), 1), "minute")
+	}
+}
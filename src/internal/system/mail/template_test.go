@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package mail_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taibuivan/yomira/internal/system/mail"
+)
+
+/*
+TestRenderer_AllTemplates renders every template with its data type, so a
+broken embedded file fails the build pipeline instead of a signup.
+*/
+func TestRenderer_AllTemplates(t *testing.T) {
+	renderer, err := mail.NewRenderer()
+	require.NoError(t, err)
+
+	link := "https://yomira.app/auth/verify-email?token=abc&next=/welcome"
+	cases := []struct {
+		template mail.Template
+		data     any
+		subject  string
+	}{
+		{mail.TemplateVerifyEmail, mail.VerifyEmailData{Name: "Mai", Link: link, ExpiresIn: 24 * time.Hour}, "Verify your Yomira email address"},
+		{mail.TemplatePasswordReset, mail.PasswordResetData{Name: "Mai", Link: link, ExpiresIn: time.Hour}, "Reset your Yomira password"},
+		{mail.TemplateEmailChangeConfirm, mail.EmailChangeConfirmData{Name: "Mai", NewEmail: "new@example.com", Link: link, ExpiresIn: time.Hour}, "Confirm your new Yomira email address"},
+		{mail.TemplateEmailChangeNotice, mail.EmailChangeNoticeData{Name: "Mai", NewEmail: "new@example.com", CancelLink: link, ExpiresIn: time.Hour}, "Email change requested on your Yomira account"},
+		{mail.TemplateDataExportReady, mail.DataExportReadyData{Name: "Mai", Link: link, ExpiresIn: 7 * 24 * time.Hour}, "Your Yomira data export is ready"},
+		{mail.TemplateAccountDeletion, mail.AccountDeletionData{Name: "Mai", CancelLink: link, GracePeriod: 30 * 24 * time.Hour}, "Your Yomira account was deleted"},
+	}
+
+	for _, tc := range cases {
+		t.Run(string(tc.template), func(t *testing.T) {
+			message, err := renderer.Render(mail.Email{To: "mai@example.com", Template: tc.template, Data: tc.data})
+			require.NoError(t, err)
+
+			assert.Equal(t, "mai@example.com", message.To)
+			assert.Equal(t, tc.subject, message.Subject)
+			assert.Contains(t, message.Text, "Hi Mai,")
+			assert.Contains(t, message.Text, link, "text bodies keep links verbatim")
+			assert.Contains(t, message.HTML, "token=abc&amp;next=/welcome", "HTML bodies escape links")
+			assert.Contains(t, message.HTML, "<title>"+tc.subject+"</title>")
+		})
+	}
+}
+
+/*
+TestRenderer_EmailChangedNotice renders the only template without a link.
+*/
+func TestRenderer_EmailChangedNotice(t *testing.T) {
+	renderer, err := mail.NewRenderer()
+	require.NoError(t, err)
+
+	message, err := renderer.Render(mail.Email{
+		To:       "old@example.com",
+		Template: mail.TemplateEmailChangedNotice,
+		Data:     mail.EmailChangedNoticeData{Name: "Mai", NewEmail: "new@example.com"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Your Yomira email address was changed", message.Subject)
+	assert.Contains(t, message.Text, "new@example.com")
+	assert.Contains(t, message.HTML, "<strong>new@example.com</strong>")
+}
+
+/*
+TestRenderer_EscapesHTML keeps user controlled values from injecting markup.
+*/
+func TestRenderer_EscapesHTML(t *testing.T) {
+	renderer, err := mail.NewRenderer()
+	require.NoError(t, err)
+
+	message, err := renderer.Render(mail.Email{
+		To:       "mai@example.com",
+		Template: mail.TemplateVerifyEmail,
+		Data:     mail.VerifyEmailData{Name: `<script>alert(1)</script>`, Link: "https://yomira.app", ExpiresIn: time.Hour},
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, message.HTML, "<script>")
+	assert.Contains(t, message.HTML, "&lt;script&gt;")
+}
+
+/*
+TestRenderer_Errors rejects unknown templates and data of the wrong shape.
+*/
+func TestRenderer_Errors(t *testing.T) {
+	renderer, err := mail.NewRenderer()
+	require.NoError(t, err)
+
+	_, err = renderer.Render(mail.Email{To: "mai@example.com", Template: "welcome", Data: nil})
+	assert.Error(t, err)
+
+	_, err = renderer.Render(mail.Email{To: "mai@example.com", Template: mail.TemplateEmailChangeConfirm, Data: mail.VerifyEmailData{}})
+	assert.Error(t, err)
+}
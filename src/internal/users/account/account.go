@@ -9,7 +9,8 @@ configure their reading experience, and manage their active device sessions.
 
 # Architecture
 
-  - Entities: Preferences, SessionInfo (DTO).
+  - Entities: Preferences, SessionInfo (DTO), EmailChange, AdminUser, PublicProfile, Follow,
+    DataExport, DeletionRequest.
   - Domain: This package depends on the auth package for the User entity.
   - Security: Provides session transparency and revocation mechanisms.
 */
@@ -19,7 +20,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/taibuivan/yomira/internal/platform/sec"
 	"github.com/taibuivan/yomira/internal/users/auth"
+	"github.com/taibuivan/yomira/pkg/pagination"
 )
 
 // # Domain Entities
@@ -49,6 +52,184 @@ type SessionInfo struct {
 	IsCurrent  bool      `json:"is_current"` // True if this session belongs to the current request
 }
 
+// EmailChange tracks the email change requests of a user.
+//
+// The row outlives each request so the rate limit window survives cancels;
+// NewEmail and the token hashes are empty when nothing is pending.
+type EmailChange struct {
+	UserID          string    `json:"-"`
+	NewEmail        string    `json:"new_email"`
+	TokenHash       string    `json:"-"` // Hash of the confirm token sent to the new address
+	CancelTokenHash string    `json:"-"` // Hash of the cancel token sent to the current address
+	ExpiresAt       time.Time `json:"expires_at"`
+	RequestedAt     time.Time `json:"requested_at"`
+	RequestCount    int       `json:"-"` // Requests within the current window
+	WindowStartedAt time.Time `json:"-"`
+}
+
+// IsPending reports whether a request is waiting for confirmation at now.
+func (change *EmailChange) IsPending(now time.Time) bool {
+	return change.NewEmail != "" && now.Before(change.ExpiresAt)
+}
+
+// RetryAfter returns how long the user must wait before another request, or zero.
+func (change *EmailChange) RetryAfter(now time.Time) time.Duration {
+	windowEnd := change.WindowStartedAt.Add(EmailChangeWindow)
+	if change.RequestCount < EmailChangeMaxRequests || !now.Before(windowEnd) {
+		return 0
+	}
+	return windowEnd.Sub(now)
+}
+
+// CountRequest records a request at now, starting a new window when the last one ended.
+func (change *EmailChange) CountRequest(now time.Time) {
+	if !now.Before(change.WindowStartedAt.Add(EmailChangeWindow)) {
+		change.RequestCount = 0
+		change.WindowStartedAt = now
+	}
+	change.RequestCount++
+}
+
+// # Follow Graph Entities
+
+// PublicProfile is the safe public view of an account with its follow counts.
+type PublicProfile struct {
+	ID             string    `json:"id"`
+	Username       string    `json:"username"`
+	DisplayName    string    `json:"display_name"`
+	AvatarURL      string    `json:"avatar_url,omitempty"`
+	Bio            string    `json:"bio,omitempty"`
+	Website        string    `json:"website,omitempty"`
+	IsVerified     bool      `json:"is_verified"`
+	FollowerCount  int       `json:"follower_count"`
+	FollowingCount int       `json:"following_count"`
+	IsFollowing    *bool     `json:"is_following,omitempty"` // Only for authenticated viewers
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// Follow is a directed edge of the follow graph.
+type Follow struct {
+	FollowerID  string    `json:"follower_id"`
+	FollowingID string    `json:"following_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// FollowUser is an account listed as a follower or as followed.
+type FollowUser struct {
+	ID          string    `json:"id"`
+	Username    string    `json:"username"`
+	DisplayName string    `json:"display_name"`
+	AvatarURL   string    `json:"avatar_url,omitempty"`
+	IsVerified  bool      `json:"is_verified"`
+	IsFollowing *bool     `json:"is_following,omitempty"` // Whether the viewer follows this account
+	FollowedAt  time.Time `json:"followed_at"`
+}
+
+// FollowList selects a side of the follow graph.
+type FollowList string
+
+const (
+	// FollowersList lists the accounts following a user.
+	FollowersList FollowList = "followers"
+
+	// FollowingList lists the accounts a user follows.
+	FollowingList FollowList = "following"
+)
+
+// PrivacySettings controls who may see the follow lists of a user.
+type PrivacySettings struct {
+	HideFollowers bool `json:"hide_followers"`
+	HideFollowing bool `json:"hide_following"`
+}
+
+// Hides reports whether a list is hidden from everyone but its owner.
+func (settings PrivacySettings) Hides(list FollowList) bool {
+	if list == FollowersList {
+		return settings.HideFollowers
+	}
+	return settings.HideFollowing
+}
+
+// # Personal Data Entities
+
+// ExportStatus describes where a data export stands.
+type ExportStatus string
+
+const (
+	// ExportPending marks an export waiting for the export job.
+	ExportPending ExportStatus = "pending"
+
+	// ExportReady marks an export whose archive can be downloaded.
+	ExportReady ExportStatus = "ready"
+
+	// ExportFailed marks an export the job gave up on; the user may request another.
+	ExportFailed ExportStatus = "failed"
+)
+
+// DataExport is a request for a copy of the personal data of a user.
+//
+// The archive itself is only read by the download endpoint.
+type DataExport struct {
+	ID          string       `json:"id"`
+	UserID      string       `json:"-"`
+	Status      ExportStatus `json:"status"`
+	SizeBytes   int64        `json:"size_bytes,omitempty"`
+	LastError   string       `json:"-"`
+	CreatedAt   time.Time    `json:"created_at"`
+	CompletedAt *time.Time   `json:"completed_at,omitempty"`
+	ExpiresAt   *time.Time   `json:"expires_at,omitempty"` // When the archive is deleted
+}
+
+// ExportSection is one JSON document of an export archive, e.g. "profile".
+type ExportSection struct {
+	Name string
+	Data []byte // JSON produced by the database
+}
+
+// DeletionRequest schedules the purge of a soft-deleted account.
+type DeletionRequest struct {
+	UserID      string
+	TokenHash   string // Hash of the cancel token emailed to the user
+	RequestedAt time.Time
+	PurgeAfter  time.Time // End of the grace period
+}
+
+// # Administration Entities
+
+// AdminUser is the administrator view of an account, including deleted ones.
+type AdminUser struct {
+	*auth.User
+	SuspendedAt   *time.Time `json:"suspended_at,omitempty"`
+	SuspendReason string     `json:"suspend_reason,omitempty"`
+	LastLoginAt   *time.Time `json:"last_login_at,omitempty"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+}
+
+// UserFilter narrows the administrator account listing.
+type UserFilter struct {
+	Query          string       // Substring of the username, email or display name
+	Role           sec.UserRole // Empty matches every role
+	IsVerified     *bool
+	Suspended      *bool // Suspensions past their end date count as lifted
+	IncludeDeleted bool
+}
+
+// RoleChange records an administrator granting a new role.
+type RoleChange struct {
+	UserID  string
+	Role    sec.UserRole
+	ActorID string
+	Reason  string
+}
+
+// Suspension records an administrator barring an account.
+type Suspension struct {
+	UserID  string
+	ActorID string
+	Reason  string
+	Until   *time.Time // nil suspends indefinitely
+}
+
 // # Repository Contracts
 
 // AccountRepository defines the persistence contract for user accounts.
@@ -89,6 +270,32 @@ type AccountRepository interface {
 		  - error: Execution failures
 	*/
 	SoftDelete(context context.Context, id string) error
+
+	/*
+		EmailExists reports whether any account, deleted or not, uses an email address.
+
+		Parameters:
+		  - context: context.Context
+		  - email: string (Compared case-insensitively)
+
+		Returns:
+		  - bool: True if the address is taken
+		  - error: Execution failures
+	*/
+	EmailExists(context context.Context, email string) (bool, error)
+
+	/*
+		UpdateEmail replaces the email of a user and marks it verified.
+
+		Parameters:
+		  - context: context.Context
+		  - id: string
+		  - email: string (An address the user has just proven)
+
+		Returns:
+		  - error: apperr.Conflict if the address was taken meanwhile, or execution failures
+	*/
+	UpdateEmail(context context.Context, id, email string) error
 }
 
 // PreferencesRepository defines the persistence contract for reader settings.
@@ -119,6 +326,411 @@ type PreferencesRepository interface {
 	Upsert(context context.Context, prefs *Preferences) error
 }
 
+// AdminRepository defines the persistence contract for account administration.
+// Every mutation writes its system.auditlog entry in the same transaction.
+type AdminRepository interface {
+	/*
+		List returns one page of accounts matching the filter, newest first.
+
+		Parameters:
+		  - context: context.Context
+		  - filter: UserFilter
+		  - limit, offset: int
+
+		Returns:
+		  - []*AdminUser: Page of accounts
+		  - int: Total matches
+		  - error: Execution failures
+	*/
+	List(context context.Context, filter UserFilter, limit, offset int) ([]*AdminUser, int, error)
+
+	/*
+		FindByID retrieves any account, soft-deleted or not.
+
+		Parameters:
+		  - context: context.Context
+		  - id: string
+
+		Returns:
+		  - *AdminUser: Hydrated account
+		  - error: apperr.NotFound or execution failures
+	*/
+	FindByID(context context.Context, id string) (*AdminUser, error)
+
+	/*
+		UpdateRole grants a new role.
+
+		Parameters:
+		  - context: context.Context
+		  - change: RoleChange
+
+		Returns:
+		  - error: apperr.NotFound or execution failures
+	*/
+	UpdateRole(context context.Context, change RoleChange) error
+
+	/*
+		Suspend bars an account, replacing any earlier suspension.
+
+		Parameters:
+		  - context: context.Context
+		  - suspension: Suspension
+
+		Returns:
+		  - error: apperr.NotFound or execution failures
+	*/
+	Suspend(context context.Context, suspension Suspension) error
+
+	/*
+		Restore lifts the suspension of an account.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - actorID: string
+
+		Returns:
+		  - error: apperr.NotFound or execution failures
+	*/
+	Restore(context context.Context, userID, actorID string) error
+
+	/*
+		Delete removes an account row for good; dependent rows follow their foreign keys.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - actorID: string
+		  - reason: string
+
+		Returns:
+		  - error: apperr.NotFound, apperr.Conflict for rows that block the delete, or execution failures
+	*/
+	Delete(context context.Context, userID, actorID, reason string) error
+}
+
+// FollowRepository defines the persistence contract for the follow graph and
+// the privacy settings of its lists. Deleted accounts never appear in it.
+type FollowRepository interface {
+	/*
+		FindProfile retrieves the public profile of a live account with its follow counts.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - viewerID: string (Fills IsFollowing; empty for anonymous viewers)
+
+		Returns:
+		  - *PublicProfile: Hydrated profile
+		  - error: apperr.NotFound or execution failures
+	*/
+	FindProfile(context context.Context, userID, viewerID string) (*PublicProfile, error)
+
+	/*
+		Follow adds an edge towards a live, unsuspended account.
+
+		Parameters:
+		  - context: context.Context
+		  - followerID: string
+		  - followingID: string
+
+		Returns:
+		  - *Follow: The created edge
+		  - error: apperr.NotFound, apperr.Conflict if already following, or execution failures
+	*/
+	Follow(context context.Context, followerID, followingID string) (*Follow, error)
+
+	/*
+		Unfollow removes an edge.
+
+		Parameters:
+		  - context: context.Context
+		  - followerID: string
+		  - followingID: string
+
+		Returns:
+		  - error: apperr.NotFound if not following, or execution failures
+	*/
+	Unfollow(context context.Context, followerID, followingID string) error
+
+	/*
+		List returns one side of the graph of a user, most recent edge first.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - list: FollowList
+		  - viewerID: string (Fills IsFollowing; empty for anonymous viewers)
+		  - before: *pagination.Cursor (Edge time and account ID of the last item seen; nil for the first page)
+		  - limit: int
+
+		Returns:
+		  - []*FollowUser: Up to limit accounts
+		  - error: Execution failures
+	*/
+	List(context context.Context, userID string, list FollowList, viewerID string, before *pagination.Cursor, limit int) ([]*FollowUser, error)
+
+	/*
+		FindPrivacy retrieves the privacy settings of a live account.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - *PrivacySettings: Current settings
+		  - error: apperr.NotFound or execution failures
+	*/
+	FindPrivacy(context context.Context, userID string) (*PrivacySettings, error)
+
+	/*
+		UpdatePrivacy replaces the privacy settings of a live account.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - settings: PrivacySettings
+
+		Returns:
+		  - error: apperr.NotFound or execution failures
+	*/
+	UpdatePrivacy(context context.Context, userID string, settings PrivacySettings) error
+}
+
+// ExportRepository defines the persistence contract for personal data exports.
+type ExportRepository interface {
+	/*
+		Create stores a new pending export.
+
+		Parameters:
+		  - context: context.Context
+		  - export: *DataExport
+
+		Returns:
+		  - error: Execution failures
+	*/
+	Create(context context.Context, export *DataExport) error
+
+	/*
+		ListByUser returns the exports of a user that were not deleted yet, newest first.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - []*DataExport: Exports without their archives
+		  - error: Execution failures
+	*/
+	ListByUser(context context.Context, userID string) ([]*DataExport, error)
+
+	/*
+		FindArchive reads the archive of a ready, unexpired export owned by the user.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - exportID: string
+
+		Returns:
+		  - []byte: ZIP archive
+		  - error: apperr.NotFound or execution failures
+	*/
+	FindArchive(context context.Context, userID, exportID string) ([]byte, error)
+
+	/*
+		ListPending returns the oldest exports waiting for the export job.
+
+		Parameters:
+		  - context: context.Context
+		  - limit: int
+
+		Returns:
+		  - []*DataExport: Pending exports
+		  - error: Execution failures
+	*/
+	ListPending(context context.Context, limit int) ([]*DataExport, error)
+
+	/*
+		Collect reads every section of the personal data of a user from one snapshot.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - []ExportSection: JSON documents in archive order
+		  - error: Execution failures
+	*/
+	Collect(context context.Context, userID string) ([]ExportSection, error)
+
+	/*
+		Complete stores the archive of an export and marks it ready.
+
+		Parameters:
+		  - context: context.Context
+		  - exportID: string
+		  - archive: []byte
+		  - completedAt: time.Time
+		  - expiresAt: time.Time
+
+		Returns:
+		  - error: Execution failures
+	*/
+	Complete(context context.Context, exportID string, archive []byte, completedAt, expiresAt time.Time) error
+
+	/*
+		Fail marks an export as failed.
+
+		Parameters:
+		  - context: context.Context
+		  - exportID: string
+		  - lastError: string
+
+		Returns:
+		  - error: Execution failures
+	*/
+	Fail(context context.Context, exportID, lastError string) error
+
+	/*
+		DeleteExpired removes one batch of exports whose archive expired before now.
+
+		Parameters:
+		  - context: context.Context
+		  - now: time.Time
+		  - limit: int
+
+		Returns:
+		  - int: Deleted exports
+		  - error: Execution failures
+	*/
+	DeleteExpired(context context.Context, now time.Time, limit int) (int, error)
+}
+
+// DeletionRepository defines the persistence contract for delayed account deletion.
+type DeletionRepository interface {
+	/*
+		Schedule soft-deletes a live account and stores its deletion request atomically.
+
+		Parameters:
+		  - context: context.Context
+		  - request: *DeletionRequest
+
+		Returns:
+		  - error: apperr.NotFound if the account is gone, or execution failures
+	*/
+	Schedule(context context.Context, request *DeletionRequest) error
+
+	/*
+		Cancel drops a deletion request still in its grace period and restores the account.
+
+		Parameters:
+		  - context: context.Context
+		  - tokenHash: string
+		  - now: time.Time
+
+		Returns:
+		  - string: ID of the restored account
+		  - error: apperr.NotFound for unknown or elapsed requests, or execution failures
+	*/
+	Cancel(context context.Context, tokenHash string, now time.Time) (string, error)
+
+	/*
+		ListDue returns accounts whose grace period ended before now, oldest first.
+
+		Parameters:
+		  - context: context.Context
+		  - now: time.Time
+		  - limit: int
+
+		Returns:
+		  - []string: Account IDs
+		  - error: Execution failures
+	*/
+	ListDue(context context.Context, now time.Time, limit int) ([]string, error)
+
+	/*
+		Purge removes the personal data of a soft-deleted account in one transaction.
+
+		Description: The account row stays as an anonymous tombstone so the
+		comments, votes and ratings it authored keep their references.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - error: Execution failures
+	*/
+	Purge(context context.Context, userID string) error
+}
+
+// EmailChangeRepository defines the persistence contract for email change requests.
+type EmailChangeRepository interface {
+	/*
+		FindByUserID retrieves the email change row of a user, pending or not.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - *EmailChange: Hydrated row
+		  - error: apperr.NotFound if the user never requested a change
+	*/
+	FindByUserID(context context.Context, userID string) (*EmailChange, error)
+
+	/*
+		FindByTokenHash retrieves a pending change by the hash of its confirm token.
+
+		Parameters:
+		  - context: context.Context
+		  - tokenHash: string
+
+		Returns:
+		  - *EmailChange: Hydrated row (may be expired)
+		  - error: apperr.NotFound or execution failures
+	*/
+	FindByTokenHash(context context.Context, tokenHash string) (*EmailChange, error)
+
+	/*
+		FindByCancelTokenHash retrieves a pending change by the hash of its cancel token.
+
+		Parameters:
+		  - context: context.Context
+		  - cancelTokenHash: string
+
+		Returns:
+		  - *EmailChange: Hydrated row (may be expired)
+		  - error: apperr.NotFound or execution failures
+	*/
+	FindByCancelTokenHash(context context.Context, cancelTokenHash string) (*EmailChange, error)
+
+	/*
+		Save inserts or replaces the email change row of a user.
+
+		Parameters:
+		  - context: context.Context
+		  - change: *EmailChange
+
+		Returns:
+		  - error: Storage failures
+	*/
+	Save(context context.Context, change *EmailChange) error
+
+	/*
+		Clear drops the pending request of a user and invalidates both tokens.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - error: Execution failures
+	*/
+	Clear(context context.Context, userID string) error
+}
+
 // SessionRepository defines the visibility and revocation contract for user sessions.
 type SessionRepository interface {
 	/*
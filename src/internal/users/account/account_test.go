@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taibuivan/yomira/internal/users/account"
+)
+
+/*
+TestEmailChange_RateLimit allows [account.EmailChangeMaxRequests] requests per
+window and opens a fresh window once the previous one ends.
+*/
+func TestEmailChange_RateLimit(t *testing.T) {
+	start := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	change := &account.EmailChange{}
+
+	for i := 0; i < account.EmailChangeMaxRequests; i++ {
+		now := start.Add(time.Duration(i) * time.Minute)
+		assert.Zero(t, change.RetryAfter(now), "request %d", i+1)
+		change.CountRequest(now)
+	}
+
+	later := start.Add(time.Hour)
+	assert.Equal(t, account.EmailChangeWindow-time.Hour, change.RetryAfter(later))
+
+	windowEnd := start.Add(account.EmailChangeWindow)
+	assert.Zero(t, change.RetryAfter(windowEnd))
+
+	change.CountRequest(windowEnd)
+	assert.Equal(t, 1, change.RequestCount)
+	assert.Equal(t, windowEnd, change.WindowStartedAt)
+}
+
+/*
+TestEmailChange_IsPending needs a new address and an unexpired link.
+*/
+func TestEmailChange_IsPending(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	change := &account.EmailChange{NewEmail: "new@example.com", ExpiresAt: now.Add(account.EmailChangeTTL)}
+	assert.True(t, change.IsPending(now))
+	assert.False(t, change.IsPending(change.ExpiresAt))
+
+	cleared := &account.EmailChange{ExpiresAt: now.Add(account.EmailChangeTTL)}
+	assert.False(t, cleared.IsPending(now))
+}
+
+/*
+TestPrivacySettings_Hides maps each list to its own setting.
+*/
+func TestPrivacySettings_Hides(t *testing.T) {
+	settings := account.PrivacySettings{HideFollowers: true}
+	assert.True(t, settings.Hides(account.FollowersList))
+	assert.False(t, settings.Hides(account.FollowingList))
+
+	settings = account.PrivacySettings{HideFollowing: true}
+	assert.False(t, settings.Hides(account.FollowersList))
+	assert.True(t, settings.Hides(account.FollowingList))
+}
+
+/*
+TestBuildExportArchive writes one indented JSON file per section, in order,
+and rejects sections that are not valid JSON.
+*/
+func TestBuildExportArchive(t *testing.T) {
+	generatedAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	sections := []account.ExportSection{
+		{Name: "profile", Data: []byte(`{"id":"u1","username":"mai"}`)},
+		{Name: "ratings", Data: []byte(`[]`)},
+	}
+
+	archive, err := account.BuildExportArchive(sections, generatedAt)
+	require.NoError(t, err)
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	require.Len(t, reader.File, 2)
+
+	assert.Equal(t, "profile.json", reader.File[0].Name)
+	assert.Equal(t, "ratings.json", reader.File[1].Name)
+	assert.True(t, reader.File[0].Modified.Equal(generatedAt))
+
+	file, err := reader.File[0].Open()
+	require.NoError(t, err)
+	content, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"id\": \"u1\",\n  \"username\": \"mai\"\n}\n", string(content))
+
+	_, err = account.BuildExportArchive([]account.ExportSection{{Name: "broken", Data: []byte(`{`)}}, generatedAt)
+	assert.Error(t, err)
+}
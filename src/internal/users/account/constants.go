@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import "time"
+
+// # Email Change
+
+const (
+	// EmailChangeTTL is how long the links of an email change request stay valid.
+	EmailChangeTTL = 1 * time.Hour
+
+	// EmailChangeTokenLength is the byte length of the random confirm and cancel tokens.
+	EmailChangeTokenLength = 32
+
+	// EmailChangeMaxRequests caps change requests per user within [EmailChangeWindow].
+	// Each request emails an arbitrary address, so the cap also limits abuse.
+	EmailChangeMaxRequests = 3
+
+	// EmailChangeWindow is the fixed window counted by [EmailChangeMaxRequests].
+	EmailChangeWindow = 24 * time.Hour
+)
+
+// # Email Links
+
+const (
+	// ConfirmEmailChangePath is the web app page that submits a confirmation token.
+	ConfirmEmailChangePath = "/settings/email/confirm"
+
+	// CancelEmailChangePath is the web app page that submits a cancel token.
+	CancelEmailChangePath = "/settings/email/cancel"
+
+	// CancelDeletionPath is the web app page that submits a deletion cancel token.
+	CancelDeletionPath = "/account/restore"
+
+	// DataExportPath is the web app page that lists and downloads exports.
+	DataExportPath = "/settings/privacy"
+)
+
+// # Data Export
+
+const (
+	// ExportCooldown is the minimum time between two export requests of a user.
+	ExportCooldown = 24 * time.Hour
+
+	// ExportRetention is how long a finished archive can be downloaded.
+	ExportRetention = 7 * 24 * time.Hour
+
+	// ExportJobKey identifies the export builder in the batch registry.
+	ExportJobKey = "account.export"
+
+	// ExportCron runs the builder every minute so requests finish quickly.
+	ExportCron = "* * * * *"
+
+	// ExportTimeout bounds a single builder run.
+	ExportTimeout = 10 * time.Minute
+
+	// ExportBatchSize caps the archives built by a single run.
+	ExportBatchSize = 10
+
+	// ExportCleanupBatchSize caps the expired exports removed by a single DELETE.
+	ExportCleanupBatchSize = 100
+
+	// ExportMaxErrorLength truncates the stored error of a failed export.
+	ExportMaxErrorLength = 500
+)
+
+// # Account Deletion
+
+const (
+	// DeletionGracePeriod is how long a deleted account can still be restored.
+	DeletionGracePeriod = 30 * 24 * time.Hour
+
+	// DeletionTokenLength is the byte length of the random cancel token.
+	DeletionTokenLength = 32
+
+	// PurgeJobKey identifies the account purge in the batch registry.
+	PurgeJobKey = "account.purge"
+
+	// PurgeCron runs the purge every hour by default.
+	PurgeCron = "15 * * * *"
+
+	// PurgeTimeout bounds a single purge run.
+	PurgeTimeout = 30 * time.Minute
+
+	// PurgeBatchSize caps the accounts loaded by one due-list query.
+	PurgeBatchSize = 50
+)
@@ -19,8 +19,10 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/middleware"
 	requestutil "github.com/taibuivan/yomira/internal/platform/request"
 	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/sec"
 	"github.com/taibuivan/yomira/internal/platform/validate"
 )
 
@@ -38,19 +40,62 @@ func NewHandler(service *Service) *Handler {
 func (handler *Handler) Routes() chi.Router {
 	router := chi.NewRouter()
 
-	// Account Management
-	router.Get("/me", handler.getMe)
-	router.Patch("/me", handler.updateMe)
-	router.Delete("/me", handler.deleteMe)
+	// Account Management (personal access tokens need the profile scopes)
+	router.With(middleware.RequireScope(sec.ScopeProfileRead)).Get("/me", handler.getMe)
+	router.With(middleware.RequireScope(sec.ScopeProfileWrite)).Patch("/me", handler.updateMe)
+	router.With(middleware.RequireSession).Delete("/me", handler.deleteMe)
 
 	// User Preferences
-	router.Get("/me/preferences", handler.getPreferences)
-	router.Put("/me/preferences", handler.updatePreferences)
+	router.With(middleware.RequireScope(sec.ScopeProfileRead)).Get("/me/preferences", handler.getPreferences)
+	router.With(middleware.RequireScope(sec.ScopeProfileWrite)).Put("/me/preferences", handler.updatePreferences)
+
+	// Session Security (interactive sessions only)
+	router.Group(func(session chi.Router) {
+		session.Use(middleware.RequireSession)
+		session.Get("/me/sessions", handler.listSessions)
+		session.Delete("/me/sessions", handler.revokeOtherSessions)
+		session.Delete("/me/sessions/{id}", handler.revokeSession)
+	})
+
+	// Email Change (interactive sessions only; the emailed links carry their own tokens)
+	router.Group(func(session chi.Router) {
+		session.Use(middleware.RequireSession)
+		session.Get("/me/email/change", handler.getEmailChange)
+		session.Post("/me/email/change/request", handler.requestEmailChange)
+		session.Delete("/me/email/change/cancel", handler.cancelEmailChange)
+	})
+	router.Post("/me/email/change/confirm", handler.confirmEmailChange)
+	router.Post("/me/email/change/cancel", handler.cancelEmailChangeByToken)
+
+	// Personal Data (interactive sessions only; the deletion link carries its own token)
+	router.Group(func(session chi.Router) {
+		session.Use(middleware.RequireSession)
+		session.Post("/me/exports", handler.requestExport)
+		session.Get("/me/exports", handler.listExports)
+		session.Get("/me/exports/{id}/download", handler.downloadExport)
+	})
+	router.Post("/me/deletion/cancel", handler.cancelDeletion)
+
+	// Account Administration
+	router.Group(func(admin chi.Router) {
+		admin.Use(middleware.RequireRole(sec.RoleAdmin))
+		admin.Use(middleware.RequireSession)
+		admin.Get("/admin/users", handler.listUsers)
+		admin.Get("/admin/users/{id}", handler.getUser)
+		admin.Patch("/admin/users/{id}/role", handler.changeRole)
+		admin.Patch("/admin/users/{id}/suspend", handler.suspendUser)
+		admin.Delete("/admin/users/{id}", handler.deleteUser)
+	})
 
-	// Session Security
-	router.Get("/me/sessions", handler.listSessions)
-	router.Delete("/me/sessions", handler.revokeOtherSessions)
-	router.Delete("/me/sessions/{id}", handler.revokeSession)
+	// Follow Graph
+	router.With(middleware.RequireScope(sec.ScopeSocialWrite)).Post("/users/{id}/follow", handler.followUser)
+	router.With(middleware.RequireScope(sec.ScopeSocialWrite)).Delete("/users/{id}/follow", handler.unfollowUser)
+	router.Get("/users/{id}/followers", handler.listFollows(FollowersList))
+	router.Get("/users/{id}/following", handler.listFollows(FollowingList))
+	router.With(middleware.RequireScope(sec.ScopeProfileRead)).Get("/me/followers", handler.listMyFollows(FollowersList))
+	router.With(middleware.RequireScope(sec.ScopeProfileRead)).Get("/me/following", handler.listMyFollows(FollowingList))
+	router.With(middleware.RequireScope(sec.ScopeProfileRead)).Get("/me/privacy", handler.getPrivacy)
+	router.With(middleware.RequireScope(sec.ScopeProfileWrite)).Put("/me/privacy", handler.updatePrivacy)
 
 	// Public Profile discovery
 	router.Get("/users/{id}", handler.getUserProfile)
@@ -150,7 +195,8 @@ func (handler *Handler) updateMe(writer http.ResponseWriter, request *http.Reque
 /*
 DELETE /api/v1/me.
 
-Description: Performs a soft-deletion of the authenticated user's account.
+Description: Soft-deletes the authenticated user's account. Its personal
+data is purged once the grace period ends unless the emailed link restores it.
 
 Response:
   - 204: No Content: Account deleted successfully
@@ -180,8 +226,8 @@ Request:
   - id: string (UUID)
 
 Response:
-  - 200: User: Public profile data
-  - 404: ErrNotFound: User not found or account private
+  - 200: PublicProfile: Public profile with follow counts
+  - 404: ErrNotFound: User not found or account deleted
 */
 func (handler *Handler) getUserProfile(writer http.ResponseWriter, request *http.Request) {
 
@@ -194,8 +240,14 @@ func (handler *Handler) getUserProfile(writer http.ResponseWriter, request *http
 		return
 	}
 
-	// Get user profile
-	user, err := handler.accountService.GetProfile(request.Context(), userID)
+	// Anonymous viewers get the profile without the follow state
+	var viewerID string
+	if claims := requestutil.Claims(request); claims != nil {
+		viewerID = claims.UserID
+	}
+
+	// Get the public projection; private fields such as the email never leave the store
+	profile, err := handler.accountService.GetPublicProfile(request.Context(), userID, viewerID)
 
 	// If the user is not found, return an error
 	if err != nil {
@@ -203,8 +255,7 @@ func (handler *Handler) getUserProfile(writer http.ResponseWriter, request *http
 		return
 	}
 
-	// Security: Consider filtering fields for public consumption
-	respond.OK(writer, user)
+	respond.OK(writer, profile)
 }
 
 // # User Preferences Endpoints
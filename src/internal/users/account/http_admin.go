@@ -0,0 +1,277 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Admin User Endpoints
+
+// changeRoleRequest defines the payload of a role change.
+type changeRoleRequest struct {
+	Role   string `json:"role"`
+	Reason string `json:"reason"`
+}
+
+// suspendRequest defines the payload that suspends or restores an account.
+type suspendRequest struct {
+	Suspend bool       `json:"suspend"`
+	Reason  string     `json:"reason"`
+	Until   *time.Time `json:"until"` // RFC 3339; omitted suspends indefinitely
+}
+
+// deleteUserRequest defines the payload of a hard delete.
+type deleteUserRequest struct {
+	Reason string `json:"reason"`
+}
+
+/*
+GET /api/v1/admin/users.
+
+Description: Lists accounts, newest first.
+
+Request:
+  - q: string (Substring of username, email or display name)
+  - role: string
+  - isverified, suspended: bool
+  - deleted: bool (Include soft-deleted accounts)
+  - page, limit: int
+
+Response:
+  - 200: []AdminUser: Paginated accounts
+  - 400: ErrValidation: Malformed filter
+  - 403: ErrForbidden: Administrator role required
+*/
+func (handler *Handler) listUsers(writer http.ResponseWriter, request *http.Request) {
+	filter, err := parseUserFilter(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	paginationParams := pagination.FromRequest(request)
+
+	users, total, err := handler.accountService.ListUsers(request.Context(), filter, paginationParams.Limit, paginationParams.Offset())
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Paginated(writer, users, pagination.NewMeta(paginationParams.Page, paginationParams.Limit, total))
+}
+
+/*
+GET /api/v1/admin/users/{id}.
+
+Response:
+  - 200: AdminUser: Account, including a soft-deleted one
+  - 403: ErrForbidden: Administrator role required
+  - 404: ErrNotFound: Unknown account
+*/
+func (handler *Handler) getUser(writer http.ResponseWriter, request *http.Request) {
+	user, err := handler.accountService.GetUser(request.Context(), requestutil.Param(request, "id"))
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, user)
+}
+
+/*
+PATCH /api/v1/admin/users/{id}/role.
+
+Request:
+  - body: changeRoleRequest
+
+Response:
+  - 200: AdminUser: The updated account
+  - 400: ErrValidation: Unknown role
+  - 403: ErrForbidden: Administrator role required, or own account
+  - 404: ErrNotFound: Unknown account
+*/
+func (handler *Handler) changeRole(writer http.ResponseWriter, request *http.Request) {
+	actorID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input changeRoleRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	v := &validate.Validator{}
+	v.Required("role", input.Role).
+		OneOf("role", input.Role, string(sec.RoleAdmin), string(sec.RoleModerator), string(sec.RoleAuthor), string(sec.RoleMember)).
+		MaxLen("reason", input.Reason, 500)
+
+	if err := v.Err(); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	user, err := handler.accountService.ChangeRole(request.Context(), RoleChange{
+		UserID:  requestutil.Param(request, "id"),
+		Role:    sec.UserRole(input.Role),
+		ActorID: actorID,
+		Reason:  input.Reason,
+	})
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, user)
+}
+
+/*
+PATCH /api/v1/admin/users/{id}/suspend.
+
+Description: Suspends the account and revokes all of its sessions, or
+lifts the suspension when suspend is false.
+
+Request:
+  - body: suspendRequest
+
+Response:
+  - 200: AdminUser: The updated account
+  - 400: ErrValidation: Missing reason or end date in the past
+  - 403: ErrForbidden: Administrator role required, own account or another administrator
+  - 404: ErrNotFound: Unknown account
+*/
+func (handler *Handler) suspendUser(writer http.ResponseWriter, request *http.Request) {
+	actorID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input suspendRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	userID := requestutil.Param(request, "id")
+	if !input.Suspend {
+		user, err := handler.accountService.RestoreUser(request.Context(), userID, actorID)
+		if err != nil {
+			respond.Error(writer, request, err)
+			return
+		}
+		respond.OK(writer, user)
+		return
+	}
+
+	v := &validate.Validator{}
+	v.Required("reason", input.Reason).MaxLen("reason", input.Reason, 500)
+
+	if err := v.Err(); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	user, err := handler.accountService.SuspendUser(request.Context(), Suspension{
+		UserID:  userID,
+		ActorID: actorID,
+		Reason:  input.Reason,
+		Until:   input.Until,
+	})
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, user)
+}
+
+/*
+DELETE /api/v1/admin/users/{id}.
+
+Description: Hard-deletes the account.
+
+Request:
+  - body: deleteUserRequest
+
+Response:
+  - 204: No Content: Account removed
+  - 400: ErrValidation: Missing reason
+  - 403: ErrForbidden: Administrator role required, own account or another administrator
+  - 404: ErrNotFound: Unknown account
+  - 409: ErrConflict: Content still references the account
+*/
+func (handler *Handler) deleteUser(writer http.ResponseWriter, request *http.Request) {
+	actorID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input deleteUserRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	v := &validate.Validator{}
+	v.Required("reason", input.Reason).MaxLen("reason", input.Reason, 500)
+
+	if err := v.Err(); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.accountService.DeleteUser(request.Context(), requestutil.Param(request, "id"), actorID, input.Reason); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+// # Internal Helpers
+
+// parseUserFilter maps query parameters onto a [UserFilter].
+func parseUserFilter(request *http.Request) (UserFilter, error) {
+	query := request.URL.Query()
+
+	filter := UserFilter{
+		Query: strings.TrimSpace(query.Get("q")),
+		Role:  sec.UserRole(query.Get("role")),
+	}
+
+	if filter.Role != "" && !filter.Role.IsValid() {
+		return filter, validate.RequiredError("role", "Must be admin, moderator, author or member")
+	}
+
+	// Optional boolean flags
+	for field, target := range map[string]**bool{"isverified": &filter.IsVerified, "suspended": &filter.Suspended} {
+		raw := query.Get(field)
+		if raw == "" {
+			continue
+		}
+
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return filter, validate.RequiredError(field, "Must be true or false")
+		}
+		*target = &parsed
+	}
+
+	filter.IncludeDeleted = query.Get("deleted") == "true"
+	return filter, nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+)
+
+// # Personal Data Endpoints
+
+/*
+POST /api/v1/me/exports.
+
+Description: Queues a copy of the personal data of the current user. The
+archive is built in the background and the user is emailed once it is ready.
+
+Response:
+  - 202: DataExport: The pending export
+  - 409: ErrConflict: An export is already being prepared
+  - 429: ErrRateLimited: The previous export is too recent
+*/
+func (handler *Handler) requestExport(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	export, err := handler.accountService.RequestExport(request.Context(), userID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Accepted(writer, export)
+}
+
+/*
+GET /api/v1/me/exports.
+
+Response:
+  - 200: []DataExport: Exports not deleted yet, newest first
+*/
+func (handler *Handler) listExports(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	exports, err := handler.accountService.ListExports(request.Context(), userID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, exports)
+}
+
+/*
+GET /api/v1/me/exports/{id}/download.
+
+Response:
+  - 200: application/zip: The archive
+  - 404: ErrNotFound: Unknown, unfinished or expired export
+*/
+func (handler *Handler) downloadExport(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	exportID := requestutil.ID(request, "id")
+	archive, err := handler.accountService.DownloadExport(request.Context(), userID, exportID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/zip")
+	writer.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="yomira-export-%s.zip"`, exportID))
+	writer.Header().Set("Content-Length", strconv.Itoa(len(archive)))
+	writer.Header().Set("Cache-Control", "no-store")
+	writer.WriteHeader(http.StatusOK)
+	_, _ = writer.Write(archive)
+}
+
+/*
+POST /api/v1/me/deletion/cancel.
+
+Description: Restores a deleted account with the token emailed on
+deletion, without signing in.
+
+Request:
+  - body: linkTokenRequest
+
+Response:
+  - 204: No Content: Account restored
+  - 400: ErrValidation: Missing token
+  - 404: ErrNotFound: Unknown token or grace period over
+*/
+func (handler *Handler) cancelDeletion(writer http.ResponseWriter, request *http.Request) {
+	token, ok := decodeLinkToken(writer, request)
+	if !ok {
+		return
+	}
+
+	if err := handler.accountService.CancelDeletion(request.Context(), token); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
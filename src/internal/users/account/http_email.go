@@ -0,0 +1,199 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"net/http"
+
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+)
+
+// # Email Change Endpoints
+
+// requestEmailChangeRequest defines the payload that starts an email change.
+type requestEmailChangeRequest struct {
+	NewEmail string `json:"new_email"`
+	Password string `json:"password"`
+}
+
+// linkTokenRequest carries a token from an emailed link.
+type linkTokenRequest struct {
+	Token string `json:"token"`
+}
+
+/*
+GET /api/v1/me/email/change.
+
+Description: Returns the email change waiting for confirmation.
+
+Response:
+  - 200: EmailChange: Pending request
+  - 401: ErrUnauthorized: Authentication required
+  - 404: ErrNotFound: Nothing pending
+*/
+func (handler *Handler) getEmailChange(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	change, err := handler.accountService.GetPendingEmailChange(request.Context(), userID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, change)
+}
+
+/*
+POST /api/v1/me/email/change/request.
+
+Description: Emails a confirm link to the new address and a cancel link to
+the current one. The account keeps its email until the link is followed.
+
+Request:
+  - body: requestEmailChangeRequest
+
+Response:
+  - 202: EmailChange: Pending request
+  - 400: ErrValidation: Invalid or unchanged address
+  - 401: ErrUnauthorized: Authentication required
+  - 403: ErrForbidden: Wrong current password
+  - 409: ErrConflict: Address already in use
+  - 429: ErrRateLimited: Too many requests today
+*/
+func (handler *Handler) requestEmailChange(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input requestEmailChangeRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	v := &validate.Validator{}
+	v.Required("new_email", input.NewEmail).Email("new_email", input.NewEmail).MaxLen("new_email", input.NewEmail, 254)
+	if err := v.Err(); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	change, err := handler.accountService.RequestEmailChange(request.Context(), userID, EmailChangeInput{
+		NewEmail: input.NewEmail,
+		Password: input.Password,
+	})
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Accepted(writer, change)
+}
+
+/*
+DELETE /api/v1/me/email/change/cancel.
+
+Description: Drops the pending email change, invalidating both links.
+
+Response:
+  - 204: No Content: Nothing is pending anymore
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) cancelEmailChange(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.accountService.CancelEmailChange(request.Context(), userID); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+/*
+POST /api/v1/me/email/change/confirm.
+
+Description: Applies the change with the token emailed to the new address.
+Signs the user out everywhere.
+
+Request:
+  - body: linkTokenRequest
+
+Response:
+  - 204: No Content: Email changed
+  - 400: ErrValidation: Missing token
+  - 404: ErrNotFound: Unknown or expired token
+  - 409: ErrConflict: Address taken since the request
+*/
+func (handler *Handler) confirmEmailChange(writer http.ResponseWriter, request *http.Request) {
+	token, ok := decodeLinkToken(writer, request)
+	if !ok {
+		return
+	}
+
+	if err := handler.accountService.ConfirmEmailChange(request.Context(), token); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+/*
+POST /api/v1/me/email/change/cancel.
+
+Description: Drops the change with the token emailed to the current address,
+without signing in.
+
+Request:
+  - body: linkTokenRequest
+
+Response:
+  - 204: No Content: Change cancelled
+  - 400: ErrValidation: Missing token
+  - 404: ErrNotFound: Unknown token
+*/
+func (handler *Handler) cancelEmailChangeByToken(writer http.ResponseWriter, request *http.Request) {
+	token, ok := decodeLinkToken(writer, request)
+	if !ok {
+		return
+	}
+
+	if err := handler.accountService.CancelEmailChangeByToken(request.Context(), token); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+// decodeLinkToken reads the token of an emailed link, writing the error response itself.
+func decodeLinkToken(writer http.ResponseWriter, request *http.Request) (string, bool) {
+	var input linkTokenRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return "", false
+	}
+
+	v := &validate.Validator{}
+	v.Required("token", input.Token)
+	if err := v.Err(); err != nil {
+		respond.Error(writer, request, err)
+		return "", false
+	}
+
+	return input.Token, true
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"net/http"
+
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Follow Endpoints
+
+// privacyRequest defines the payload that replaces the privacy settings.
+type privacyRequest struct {
+	HideFollowers bool `json:"hide_followers"`
+	HideFollowing bool `json:"hide_following"`
+}
+
+/*
+POST /api/v1/users/{id}/follow.
+
+Response:
+  - 201: Follow: The new follow
+  - 400: ErrValidation: Following yourself
+  - 404: ErrNotFound: Unknown, deleted or suspended account
+  - 409: ErrConflict: Already following
+*/
+func (handler *Handler) followUser(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	follow, err := handler.accountService.FollowUser(request.Context(), userID, requestutil.ID(request, "id"))
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Created(writer, follow)
+}
+
+/*
+DELETE /api/v1/users/{id}/follow.
+
+Response:
+  - 204: No Content
+  - 404: ErrNotFound: Not following this account
+*/
+func (handler *Handler) unfollowUser(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.accountService.UnfollowUser(request.Context(), userID, requestutil.ID(request, "id")); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+/*
+GET /api/v1/users/{id}/followers and /api/v1/users/{id}/following.
+
+Description: Lists accounts, most recent follow first. Authenticated
+viewers also see whether they follow each account.
+
+Request:
+  - before: string (next_before of the previous page)
+  - limit: int
+
+Response:
+  - 200: []FollowUser: Cursor-paginated accounts
+  - 400: ErrValidation: Malformed cursor
+  - 403: ErrForbidden: The owner hides this list
+  - 404: ErrNotFound: Unknown account
+*/
+func (handler *Handler) listFollows(list FollowList) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var viewerID string
+		if claims := requestutil.Claims(request); claims != nil {
+			viewerID = claims.UserID
+		}
+
+		handler.respondFollows(writer, request, requestutil.ID(request, "id"), list, viewerID)
+	}
+}
+
+/*
+GET /api/v1/me/followers and /api/v1/me/following.
+
+Description: Lists the accounts of the current user, hidden or not.
+*/
+func (handler *Handler) listMyFollows(list FollowList) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		userID, err := requestutil.RequiredUserID(request)
+		if err != nil {
+			respond.Error(writer, request, err)
+			return
+		}
+
+		handler.respondFollows(writer, request, userID, list, userID)
+	}
+}
+
+// respondFollows writes one cursor page of a follow list.
+func (handler *Handler) respondFollows(writer http.ResponseWriter, request *http.Request, userID string, list FollowList, viewerID string) {
+	params := pagination.CursorFromRequest(request)
+
+	users, next, err := handler.accountService.ListFollows(request.Context(), userID, list, viewerID, params)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.CursorPaginated(writer, users, pagination.NewCursorMeta(params.Limit, next))
+}
+
+/*
+GET /api/v1/me/privacy.
+
+Response:
+  - 200: PrivacySettings: Current settings
+*/
+func (handler *Handler) getPrivacy(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	settings, err := handler.accountService.GetPrivacy(request.Context(), userID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, settings)
+}
+
+/*
+PUT /api/v1/me/privacy.
+
+Request:
+  - body: privacyRequest
+
+Response:
+  - 200: PrivacySettings: The stored settings
+  - 400: ErrValidation: Invalid JSON payload
+*/
+func (handler *Handler) updatePrivacy(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input privacyRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	settings := PrivacySettings(input)
+	if err := handler.accountService.UpdatePrivacy(request.Context(), userID, settings); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, settings)
+}
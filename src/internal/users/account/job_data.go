@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/taibuivan/yomira/internal/system/batch"
+)
+
+// # Background Jobs
+
+/*
+ExportJob builds the personal data archives users asked for.
+
+Description: Collecting every table of a heavy reader takes too long for
+an HTTP request, so POST /me/exports only queues a row. This job builds up
+to [ExportBatchSize] archives per run, emails their owners, and deletes
+archives past [ExportRetention].
+*/
+type ExportJob struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+// NewExportJob constructs a new [ExportJob].
+func NewExportJob(service *Service, logger *slog.Logger) *ExportJob {
+	return &ExportJob{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Definition describes the job for the batch scheduler.
+func (job *ExportJob) Definition() batch.Job {
+	return batch.Job{
+		Key:         ExportJobKey,
+		Description: "Build requested personal data exports and delete expired archives",
+		Cron:        ExportCron,
+		Timeout:     ExportTimeout,
+		Handler: func(context context.Context, _ batch.Execution) (batch.Result, error) {
+			built, failed, err := job.service.BuildPendingExports(context)
+			meta := map[string]any{"exports_built": built, "exports_failed": failed}
+			if err != nil {
+				return batch.Result{RowsAffected: int64(built + failed), Meta: meta}, err
+			}
+
+			expired, err := job.service.DeleteExpiredExports(context)
+			meta["exports_expired"] = expired
+
+			if built+failed+expired > 0 {
+				job.logger.Info("account_export_run_finished",
+					slog.Int("exports_built", built),
+					slog.Int("exports_failed", failed),
+					slog.Int("exports_expired", expired),
+				)
+			}
+
+			return batch.Result{RowsAffected: int64(built + failed + expired), Meta: meta}, err
+		},
+	}
+}
+
+/*
+PurgeJob erases accounts whose deletion grace period has ended.
+
+Description: DELETE /me only soft-deletes the account so it can be
+restored for [DeletionGracePeriod]. This job then removes the personal
+data and leaves an anonymous tombstone behind, see [DeletionRepository.Purge].
+*/
+type PurgeJob struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+// NewPurgeJob constructs a new [PurgeJob].
+func NewPurgeJob(service *Service, logger *slog.Logger) *PurgeJob {
+	return &PurgeJob{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Definition describes the job for the batch scheduler.
+func (job *PurgeJob) Definition() batch.Job {
+	return batch.Job{
+		Key:         PurgeJobKey,
+		Description: "Erase the personal data of accounts past their deletion grace period",
+		Cron:        PurgeCron,
+		Timeout:     PurgeTimeout,
+		Handler: func(context context.Context, _ batch.Execution) (batch.Result, error) {
+			purged, err := job.service.PurgeDueAccounts(context)
+
+			if purged > 0 {
+				job.logger.Info("account_purge_finished", slog.Int("accounts_purged", purged))
+			}
+
+			return batch.Result{
+				RowsAffected: int64(purged),
+				Meta:         map[string]any{"accounts_purged": purged},
+			}, err
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/taibuivan/yomira/internal/platform/apperr"
@@ -23,6 +24,15 @@ type Service struct {
 	accountRepository     AccountRepository
 	preferencesRepository PreferencesRepository
 	sessionRepository     SessionRepository
+	emailChangeRepository EmailChangeRepository
+	adminRepository       AdminRepository
+	followRepository      FollowRepository
+	exportRepository      ExportRepository
+	deletionRepository    DeletionRepository
+	suspensions           auth.SuspensionRepository
+	tokenRevoker          auth.TokenRevoker
+	mailer                auth.Mailer
+	appURL                string // Base URL of the web app for links in emails
 	logger                *slog.Logger
 }
 
@@ -31,12 +41,30 @@ func NewService(
 	accountRepo AccountRepository,
 	preferencesRepo PreferencesRepository,
 	sessionRepo SessionRepository,
+	emailChangeRepo EmailChangeRepository,
+	adminRepo AdminRepository,
+	followRepo FollowRepository,
+	exportRepo ExportRepository,
+	deletionRepo DeletionRepository,
+	suspensions auth.SuspensionRepository,
+	tokenRevoker auth.TokenRevoker,
+	mailer auth.Mailer,
+	appURL string,
 	logger *slog.Logger,
 ) *Service {
 	return &Service{
 		accountRepository:     accountRepo,
 		preferencesRepository: preferencesRepo,
 		sessionRepository:     sessionRepo,
+		emailChangeRepository: emailChangeRepo,
+		adminRepository:       adminRepo,
+		followRepository:      followRepo,
+		exportRepository:      exportRepo,
+		deletionRepository:    deletionRepo,
+		suspensions:           suspensions,
+		tokenRevoker:          tokenRevoker,
+		mailer:                mailer,
+		appURL:                strings.TrimRight(appURL, "/"),
 		logger:                logger,
 	}
 }
@@ -117,34 +145,6 @@ func (service *Service) UpdateProfile(context context.Context, userID string, in
 	return user, nil
 }
 
-/*
-DeleteAccount performs an idempotent soft-deletion of a user account.
-
-Description: Flags the account as deleted and immediately terminates all active
-security sessions to force a global sign-out.
-
-Parameters:
-  - context: context.Context
-  - userID: string
-
-Returns:
-  - error: Execution failures
-*/
-func (service *Service) DeleteAccount(context context.Context, userID string) error {
-
-	// Business: Ensure the user is authenticated
-	if err := service.accountRepository.SoftDelete(context, userID); err != nil {
-		return fmt.Errorf("account_service_delete_failed: %w", err)
-	}
-
-	// Force global revocation of sessions for the deleted account
-	_ = service.sessionRepository.RevokeAll(context, userID)
-
-	service.logger.Warn("user_account_deleted", slog.String("user_id", userID))
-
-	return nil
-}
-
 // # Preferences Management
 
 /*
@@ -251,6 +251,9 @@ func (service *Service) RevokeSession(context context.Context, userID, sessionID
 		return fmt.Errorf("account_service_revoke_session_failed: %w", err)
 	}
 
+	// Access tokens do not name their session; the remaining devices refresh once
+	service.revokeAccessTokens(context, userID)
+
 	service.logger.Info("user_session_revoked",
 		slog.String("user_id", userID),
 		slog.String("session_id", sessionID),
@@ -275,7 +278,17 @@ func (service *Service) RevokeOtherSessions(context context.Context, userID, cur
 		return fmt.Errorf("account_service_revoke_others_failed: %w", err)
 	}
 
+	service.revokeAccessTokens(context, userID)
+
 	service.logger.Info("user_other_sessions_revoked", slog.String("user_id", userID))
 
 	return nil
 }
+
+// revokeAccessTokens denies the outstanding access tokens of a user. Failures
+// are logged; the tokens still expire within [auth.AccessTokenTTL].
+func (service *Service) revokeAccessTokens(context context.Context, userID string) {
+	if err := service.tokenRevoker.RevokeUserTokens(context, userID); err != nil {
+		service.logger.Error("account_access_revoke_failed", slog.String("user_id", userID), slog.Any("error", err))
+	}
+}
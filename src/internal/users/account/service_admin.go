@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/users/auth"
+)
+
+// # Account Administration
+
+// ListUsers returns one page of accounts for the administrator console.
+func (service *Service) ListUsers(context context.Context, filter UserFilter, limit, offset int) ([]*AdminUser, int, error) {
+	users, total, err := service.adminRepository.List(context, filter, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("account_service_list_users_failed: %w", err)
+	}
+	return users, total, nil
+}
+
+// GetUser returns the administrator view of any account.
+func (service *Service) GetUser(context context.Context, userID string) (*AdminUser, error) {
+	user, err := service.adminRepository.FindByID(context, userID)
+	if err != nil {
+		return nil, fmt.Errorf("account_service_get_user_failed: %w", err)
+	}
+	return user, nil
+}
+
+/*
+ChangeRole grants a new role to an account.
+
+Description: Administrators cannot change their own role, so the last
+administrator can never lock everyone out by accident. Access tokens
+already issued are revoked so the next refresh carries the new role.
+
+Parameters:
+  - context: context.Context
+  - change: RoleChange
+
+Returns:
+  - *AdminUser: The updated account
+  - error: Forbidden, NotFound, validation or storage failures
+*/
+func (service *Service) ChangeRole(context context.Context, change RoleChange) (*AdminUser, error) {
+	if change.UserID == change.ActorID {
+		return nil, apperr.Forbidden("Administrators cannot change their own role.")
+	}
+
+	if err := service.adminRepository.UpdateRole(context, change); err != nil {
+		return nil, fmt.Errorf("account_service_change_role_failed: %w", err)
+	}
+
+	service.revokeAccessTokens(context, change.UserID)
+
+	return service.GetUser(context, change.UserID)
+}
+
+/*
+SuspendUser bars an account and signs it out everywhere.
+
+Description: The suspension is stored first, then every refresh session and
+access token is revoked and the account is marked in the suspension cache
+that middleware.Authenticate checks, so tokens still in flight stop working
+on the next request.
+
+Parameters:
+  - context: context.Context
+  - suspension: Suspension
+
+Returns:
+  - *AdminUser: The updated account
+  - error: Forbidden, NotFound, validation or storage failures
+*/
+func (service *Service) SuspendUser(context context.Context, suspension Suspension) (*AdminUser, error) {
+	now := time.Now()
+	if suspension.Until != nil && !suspension.Until.After(now) {
+		return nil, apperr.ValidationError("Validation failed", apperr.FieldError{
+			Field:   "until",
+			Message: "must be in the future",
+		})
+	}
+
+	if err := service.guardTarget(context, suspension.ActorID, suspension.UserID, "suspend"); err != nil {
+		return nil, err
+	}
+
+	if err := service.adminRepository.Suspend(context, suspension); err != nil {
+		return nil, fmt.Errorf("account_service_suspend_failed: %w", err)
+	}
+
+	var ttl time.Duration
+	if suspension.Until != nil {
+		ttl = suspension.Until.Sub(now)
+	}
+	service.cutOff(context, suspension.UserID, ttl)
+
+	return service.GetUser(context, suspension.UserID)
+}
+
+// RestoreUser lifts a suspension; the user signs in again to get new sessions.
+func (service *Service) RestoreUser(context context.Context, userID, actorID string) (*AdminUser, error) {
+	if err := service.adminRepository.Restore(context, userID, actorID); err != nil {
+		return nil, fmt.Errorf("account_service_restore_failed: %w", err)
+	}
+
+	if err := service.suspensions.Clear(context, userID); err != nil {
+		return nil, fmt.Errorf("account_service_restore_cache_failed: %w", err)
+	}
+
+	return service.GetUser(context, userID)
+}
+
+/*
+DeleteUser removes an account for good.
+
+Description: Unlike DELETE /me this is not a soft delete. The suspension
+cache is marked for one access token lifetime so tokens issued before the
+delete stop working immediately.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - actorID: string
+  - reason: string
+
+Returns:
+  - error: Forbidden, NotFound, Conflict or storage failures
+*/
+func (service *Service) DeleteUser(context context.Context, userID, actorID, reason string) error {
+	if err := service.guardTarget(context, actorID, userID, "delete"); err != nil {
+		return err
+	}
+
+	if err := service.adminRepository.Delete(context, userID, actorID, reason); err != nil {
+		return fmt.Errorf("account_service_delete_user_failed: %w", err)
+	}
+
+	service.cutOff(context, userID, auth.AccessTokenTTL)
+	return nil
+}
+
+// # Internal Helpers
+
+// guardTarget rejects actions on yourself and on other administrators.
+func (service *Service) guardTarget(context context.Context, actorID, userID, action string) error {
+	if actorID == userID {
+		return apperr.Forbidden(fmt.Sprintf("Administrators cannot %s their own account.", action))
+	}
+
+	target, err := service.adminRepository.FindByID(context, userID)
+	if err != nil {
+		return fmt.Errorf("account_service_admin_lookup_failed: %w", err)
+	}
+
+	if target.Role == sec.RoleAdmin {
+		return apperr.Forbidden(fmt.Sprintf("Demote the administrator before you %s the account.", action))
+	}
+	return nil
+}
+
+// cutOff revokes every session and access token of a user and marks the suspension cache for ttl.
+// Failures are logged; the stored account state still blocks sign-in and refresh.
+func (service *Service) cutOff(context context.Context, userID string, ttl time.Duration) {
+	if err := service.sessionRepository.RevokeAll(context, userID); err != nil {
+		service.logger.Error("admin_cutoff_revoke_failed", slog.String("user_id", userID), slog.Any("error", err))
+	}
+
+	if err := service.suspensions.Mark(context, userID, ttl); err != nil {
+		service.logger.Error("admin_cutoff_cache_failed", slog.String("user_id", userID), slog.Any("error", err))
+	}
+
+	service.revokeAccessTokens(context, userID)
+}
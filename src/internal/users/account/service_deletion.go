@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/system/mail"
+)
+
+// # Account Deletion
+
+/*
+DeleteAccount soft-deletes a user account and schedules its purge.
+
+Description: The account disappears at once and every session is signed
+out. Personal data stays for [DeletionGracePeriod] so the user can change
+their mind through the link emailed to them; the [PurgeJob] erases it
+afterwards.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - error: apperr.NotFound if the account is already deleted, or storage failures
+*/
+func (service *Service) DeleteAccount(context context.Context, userID string) error {
+	user, err := service.accountRepository.FindByID(context, userID)
+	if err != nil {
+		return fmt.Errorf("account_service_delete_lookup_failed: %w", err)
+	}
+
+	token, err := sec.GenerateSecureToken(DeletionTokenLength)
+	if err != nil {
+		return fmt.Errorf("account_service_generate_deletion_token_failed: %w", err)
+	}
+
+	now := time.Now().UTC()
+	request := &DeletionRequest{
+		UserID:      userID,
+		TokenHash:   sec.HashToken(token),
+		RequestedAt: now,
+		PurgeAfter:  now.Add(DeletionGracePeriod),
+	}
+	if err := service.deletionRepository.Schedule(context, request); err != nil {
+		return fmt.Errorf("account_service_delete_failed: %w", err)
+	}
+
+	// Force global revocation of sessions for the deleted account
+	if err := service.sessionRepository.RevokeAll(context, userID); err != nil {
+		service.logger.Error("account_delete_revoke_failed", slog.String("user_id", userID), slog.Any("error", err))
+	}
+	service.revokeAccessTokens(context, userID)
+
+	// The account is already deleted; without the email it can only be restored by support
+	err = service.mailer.Enqueue(context, mail.Email{
+		To:       user.Email,
+		Template: mail.TemplateAccountDeletion,
+		Data: mail.AccountDeletionData{
+			Name:        greetingName(user),
+			CancelLink:  service.appLink(CancelDeletionPath, token),
+			GracePeriod: DeletionGracePeriod,
+		},
+	})
+	if err != nil {
+		service.logger.Error("account_deletion_notice_failed", slog.String("user_id", userID), slog.Any("error", err))
+	}
+
+	service.logger.Warn("user_account_deleted",
+		slog.String("user_id", userID),
+		slog.Time("purge_after", request.PurgeAfter),
+	)
+
+	return nil
+}
+
+/*
+CancelDeletion restores an account using the link emailed on deletion.
+
+Description: Sessions stay revoked; the user signs in again afterwards.
+
+Parameters:
+  - context: context.Context
+  - token: string
+
+Returns:
+  - error: apperr.NotFound for unknown tokens or an elapsed grace period, or storage failures
+*/
+func (service *Service) CancelDeletion(context context.Context, token string) error {
+	userID, err := service.deletionRepository.Cancel(context, sec.HashToken(token), time.Now().UTC())
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return apperr.NotFound("Deletion request")
+		}
+		return fmt.Errorf("account_service_cancel_deletion_failed: %w", err)
+	}
+
+	service.logger.Warn("user_account_restored", slog.String("user_id", userID))
+
+	return nil
+}
+
+/*
+PurgeDueAccounts erases the personal data of accounts past their grace period.
+
+Parameters:
+  - context: context.Context
+
+Returns:
+  - int: Accounts purged, including those before a failure
+  - error: Repository level errors or context cancellation
+*/
+func (service *Service) PurgeDueAccounts(context context.Context) (int, error) {
+	purged := 0
+	for {
+		userIDs, err := service.deletionRepository.ListDue(context, time.Now().UTC(), PurgeBatchSize)
+		if err != nil {
+			return purged, fmt.Errorf("account_service_list_due_deletions_failed: %w", err)
+		}
+
+		for _, userID := range userIDs {
+			if err := service.deletionRepository.Purge(context, userID); err != nil {
+				return purged, fmt.Errorf("account_service_purge_failed: %w", err)
+			}
+			purged++
+
+			service.logger.Warn("user_account_purged", slog.String("user_id", userID))
+		}
+
+		if len(userIDs) < PurgeBatchSize {
+			return purged, nil
+		}
+	}
+}
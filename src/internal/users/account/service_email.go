@@ -0,0 +1,288 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/system/mail"
+	"github.com/taibuivan/yomira/internal/users/auth"
+)
+
+// # Email Change
+
+// EmailChangeInput carries a request to move the account to a new address.
+type EmailChangeInput struct {
+	NewEmail string
+	Password string // Current password; ignored for accounts without one
+}
+
+/*
+RequestEmailChange starts an email change that both addresses can act on.
+
+Description: Nothing changes on the account yet. The new address receives a
+confirm link, the current one a notice with a cancel link, so a stolen
+session cannot move the account silently. A new request replaces the
+pending one and invalidates its links.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - input: EmailChangeInput
+
+Returns:
+  - *EmailChange: The pending request
+  - error: Forbidden, Conflict, RateLimited, validation or storage failures
+*/
+func (service *Service) RequestEmailChange(context context.Context, userID string, input EmailChangeInput) (*EmailChange, error) {
+	user, err := service.accountRepository.FindByID(context, userID)
+	if err != nil {
+		return nil, fmt.Errorf("account_service_email_change_lookup_failed: %w", err)
+	}
+
+	// Accounts created through social login have no password to confirm
+	if user.PasswordHash != "" && !sec.CheckPasswordHash(input.Password, user.PasswordHash) {
+		return nil, apperr.Forbidden("Current password is incorrect.")
+	}
+
+	newEmail := strings.TrimSpace(input.NewEmail)
+	if strings.EqualFold(newEmail, user.Email) {
+		return nil, apperr.ValidationError("Validation failed", apperr.FieldError{
+			Field:   "new_email",
+			Message: "must differ from the current email address",
+		})
+	}
+
+	exists, err := service.accountRepository.EmailExists(context, newEmail)
+	if err != nil {
+		return nil, fmt.Errorf("account_service_email_change_exists_failed: %w", err)
+	}
+	if exists {
+		return nil, apperr.Conflict("This email address is already in use.")
+	}
+
+	now := time.Now().UTC()
+	change, err := service.emailChangeRepository.FindByUserID(context, userID)
+	if err != nil {
+		if !apperr.IsNotFound(err) {
+			return nil, fmt.Errorf("account_service_email_change_load_failed: %w", err)
+		}
+		change = &EmailChange{UserID: userID}
+	}
+
+	if retryAfter := change.RetryAfter(now); retryAfter > 0 {
+		return nil, apperr.RateLimited(int(math.Ceil(retryAfter.Seconds())))
+	}
+
+	token, err := sec.GenerateSecureToken(EmailChangeTokenLength)
+	if err != nil {
+		return nil, fmt.Errorf("account_service_generate_email_change_token_failed: %w", err)
+	}
+	cancelToken, err := sec.GenerateSecureToken(EmailChangeTokenLength)
+	if err != nil {
+		return nil, fmt.Errorf("account_service_generate_email_change_token_failed: %w", err)
+	}
+
+	change.NewEmail = newEmail
+	change.TokenHash = sec.HashToken(token)
+	change.CancelTokenHash = sec.HashToken(cancelToken)
+	change.ExpiresAt = now.Add(EmailChangeTTL)
+	change.RequestedAt = now
+	change.CountRequest(now)
+
+	if err := service.emailChangeRepository.Save(context, change); err != nil {
+		return nil, fmt.Errorf("account_service_save_email_change_failed: %w", err)
+	}
+
+	name := greetingName(user)
+	err = service.mailer.Enqueue(context, mail.Email{
+		To:       user.Email,
+		Template: mail.TemplateEmailChangeNotice,
+		Data: mail.EmailChangeNoticeData{
+			Name:       name,
+			NewEmail:   newEmail,
+			CancelLink: service.appLink(CancelEmailChangePath, cancelToken),
+			ExpiresIn:  EmailChangeTTL,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("account_service_email_change_notice_failed: %w", err)
+	}
+
+	err = service.mailer.Enqueue(context, mail.Email{
+		To:       newEmail,
+		Template: mail.TemplateEmailChangeConfirm,
+		Data: mail.EmailChangeConfirmData{
+			Name:      name,
+			NewEmail:  newEmail,
+			Link:      service.appLink(ConfirmEmailChangePath, token),
+			ExpiresIn: EmailChangeTTL,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("account_service_email_change_confirm_failed: %w", err)
+	}
+
+	service.logger.Info("user_email_change_requested", slog.String("user_id", userID))
+
+	return change, nil
+}
+
+/*
+GetPendingEmailChange returns the request waiting for confirmation.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - *EmailChange: The pending request
+  - error: apperr.NotFound if nothing is pending
+*/
+func (service *Service) GetPendingEmailChange(context context.Context, userID string) (*EmailChange, error) {
+	change, err := service.emailChangeRepository.FindByUserID(context, userID)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, apperr.NotFound("Email change request")
+		}
+		return nil, fmt.Errorf("account_service_get_email_change_failed: %w", err)
+	}
+
+	if !change.IsPending(time.Now()) {
+		return nil, apperr.NotFound("Email change request")
+	}
+	return change, nil
+}
+
+/*
+ConfirmEmailChange applies a pending change using the token sent to the new address.
+
+Description: Following the link proves the new address, so the account stays
+verified (or becomes verified) with it. Every session is revoked because
+the login identity changed, and the previous address is told about it.
+
+Parameters:
+  - context: context.Context
+  - token: string
+
+Returns:
+  - error: apperr.NotFound for unknown or expired tokens, Conflict, or storage failures
+*/
+func (service *Service) ConfirmEmailChange(context context.Context, token string) error {
+	change, err := service.emailChangeRepository.FindByTokenHash(context, sec.HashToken(token))
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return apperr.NotFound("Email change request")
+		}
+		return fmt.Errorf("account_service_confirm_email_change_lookup_failed: %w", err)
+	}
+	if !change.IsPending(time.Now()) {
+		return apperr.NotFound("Email change request")
+	}
+
+	user, err := service.accountRepository.FindByID(context, change.UserID)
+	if err != nil {
+		return fmt.Errorf("account_service_confirm_email_change_user_failed: %w", err)
+	}
+	previousEmail := user.Email
+
+	// The unique constraint catches an address registered since the request
+	if err := service.accountRepository.UpdateEmail(context, change.UserID, change.NewEmail); err != nil {
+		return fmt.Errorf("account_service_confirm_email_change_failed: %w", err)
+	}
+
+	if err := service.emailChangeRepository.Clear(context, change.UserID); err != nil {
+		service.logger.Error("account_email_change_clear_failed", slog.String("user_id", change.UserID), slog.Any("error", err))
+	}
+
+	if err := service.sessionRepository.RevokeAll(context, change.UserID); err != nil {
+		service.logger.Error("account_email_change_revoke_failed", slog.String("user_id", change.UserID), slog.Any("error", err))
+	}
+
+	err = service.mailer.Enqueue(context, mail.Email{
+		To:       previousEmail,
+		Template: mail.TemplateEmailChangedNotice,
+		Data:     mail.EmailChangedNoticeData{Name: greetingName(user), NewEmail: change.NewEmail},
+	})
+	if err != nil {
+		service.logger.Error("account_email_changed_notice_failed", slog.String("user_id", change.UserID), slog.Any("error", err))
+	}
+
+	service.logger.Warn("user_email_changed", slog.String("user_id", change.UserID))
+
+	return nil
+}
+
+/*
+CancelEmailChange drops the pending change of the authenticated user, if any.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - error: Storage failures
+*/
+func (service *Service) CancelEmailChange(context context.Context, userID string) error {
+	if err := service.emailChangeRepository.Clear(context, userID); err != nil {
+		return fmt.Errorf("account_service_cancel_email_change_failed: %w", err)
+	}
+
+	service.logger.Info("user_email_change_cancelled", slog.String("user_id", userID))
+
+	return nil
+}
+
+/*
+CancelEmailChangeByToken drops a pending change using the link sent to the
+current address, which works without signing in.
+
+Parameters:
+  - context: context.Context
+  - token: string
+
+Returns:
+  - error: apperr.NotFound for unknown tokens, or storage failures
+*/
+func (service *Service) CancelEmailChangeByToken(context context.Context, token string) error {
+	change, err := service.emailChangeRepository.FindByCancelTokenHash(context, sec.HashToken(token))
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return apperr.NotFound("Email change request")
+		}
+		return fmt.Errorf("account_service_cancel_email_change_lookup_failed: %w", err)
+	}
+
+	if err := service.emailChangeRepository.Clear(context, change.UserID); err != nil {
+		return fmt.Errorf("account_service_cancel_email_change_failed: %w", err)
+	}
+
+	// Cancelling from the old inbox suggests someone else requested the change
+	service.logger.Warn("user_email_change_cancelled_by_link", slog.String("user_id", change.UserID))
+
+	return nil
+}
+
+// # Email Links
+
+// appLink builds an absolute web app URL carrying a token.
+func (service *Service) appLink(path, token string) string {
+	return service.appURL + path + "?token=" + url.QueryEscape(token)
+}
+
+// greetingName picks how an email addresses the user.
+func greetingName(user *auth.User) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	return user.Username
+}
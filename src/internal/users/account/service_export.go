@@ -0,0 +1,272 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/system/mail"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # Data Export
+
+/*
+RequestExport queues a copy of the personal data of a user.
+
+Description: The archive is built in the background by the [ExportJob]. A
+user may ask again once the previous export failed or [ExportCooldown]
+has passed.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - *DataExport: The pending export
+  - error: Conflict while an export is pending, RateLimited, or storage failures
+*/
+func (service *Service) RequestExport(context context.Context, userID string) (*DataExport, error) {
+	exports, err := service.exportRepository.ListByUser(context, userID)
+	if err != nil {
+		return nil, fmt.Errorf("account_service_request_export_lookup_failed: %w", err)
+	}
+
+	now := time.Now().UTC()
+	for _, export := range exports {
+		if export.Status == ExportPending {
+			return nil, apperr.Conflict("An export is already being prepared.")
+		}
+		if export.Status == ExportReady && now.Before(export.CreatedAt.Add(ExportCooldown)) {
+			retryAfter := export.CreatedAt.Add(ExportCooldown).Sub(now)
+			return nil, apperr.RateLimited(int(math.Ceil(retryAfter.Seconds())))
+		}
+	}
+
+	export := &DataExport{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Status:    ExportPending,
+		CreatedAt: now,
+	}
+	if err := service.exportRepository.Create(context, export); err != nil {
+		return nil, fmt.Errorf("account_service_request_export_failed: %w", err)
+	}
+
+	service.logger.Info("user_data_export_requested",
+		slog.String("user_id", userID),
+		slog.String("export_id", export.ID),
+	)
+
+	return export, nil
+}
+
+// ListExports returns the exports of a user that were not deleted yet, newest first.
+func (service *Service) ListExports(context context.Context, userID string) ([]*DataExport, error) {
+	exports, err := service.exportRepository.ListByUser(context, userID)
+	if err != nil {
+		return nil, fmt.Errorf("account_service_list_exports_failed: %w", err)
+	}
+	return exports, nil
+}
+
+// DownloadExport returns the ZIP archive of a ready export owned by the user.
+func (service *Service) DownloadExport(context context.Context, userID, exportID string) ([]byte, error) {
+	archive, err := service.exportRepository.FindArchive(context, userID, exportID)
+	if err != nil {
+		return nil, fmt.Errorf("account_service_download_export_failed: %w", err)
+	}
+
+	service.logger.Info("user_data_export_downloaded",
+		slog.String("user_id", userID),
+		slog.String("export_id", exportID),
+	)
+
+	return archive, nil
+}
+
+// # Export Processing
+
+/*
+BuildPendingExports builds the archives of the oldest pending exports.
+
+Description: Each export is collected, zipped and stored on its own; a
+failure marks that export as failed and moves on. The owner is emailed
+once the archive is ready.
+
+Parameters:
+  - context: context.Context
+
+Returns:
+  - int: Archives built
+  - int: Exports marked as failed
+  - error: Listing failures or context cancellation
+*/
+func (service *Service) BuildPendingExports(context context.Context) (int, int, error) {
+	exports, err := service.exportRepository.ListPending(context, ExportBatchSize)
+	if err != nil {
+		return 0, 0, fmt.Errorf("account_service_list_pending_exports_failed: %w", err)
+	}
+
+	built, failed := 0, 0
+	for _, export := range exports {
+		if err := context.Err(); err != nil {
+			return built, failed, err
+		}
+
+		buildErr := service.buildExport(context, export)
+		if buildErr == nil {
+			built++
+			continue
+		}
+
+		// Shutdown leaves the export pending for the next run
+		if context.Err() != nil {
+			return built, failed, context.Err()
+		}
+
+		failed++
+		service.logger.Error("account_data_export_failed",
+			slog.String("export_id", export.ID),
+			slog.Any("error", buildErr),
+		)
+
+		message := buildErr.Error()
+		if len(message) > ExportMaxErrorLength {
+			message = message[:ExportMaxErrorLength]
+		}
+		if err := service.exportRepository.Fail(context, export.ID, message); err != nil {
+			return built, failed, fmt.Errorf("account_service_fail_export_failed: %w", err)
+		}
+	}
+
+	return built, failed, nil
+}
+
+// buildExport collects, zips and stores one export, then emails its owner.
+func (service *Service) buildExport(context context.Context, export *DataExport) error {
+	sections, err := service.exportRepository.Collect(context, export.UserID)
+	if err != nil {
+		return fmt.Errorf("collect: %w", err)
+	}
+
+	now := time.Now().UTC()
+	archive, err := BuildExportArchive(sections, now)
+	if err != nil {
+		return fmt.Errorf("archive: %w", err)
+	}
+
+	if err := service.exportRepository.Complete(context, export.ID, archive, now, now.Add(ExportRetention)); err != nil {
+		return fmt.Errorf("store: %w", err)
+	}
+
+	service.logger.Info("user_data_export_ready",
+		slog.String("user_id", export.UserID),
+		slog.String("export_id", export.ID),
+		slog.Int("size_bytes", len(archive)),
+	)
+
+	// The archive is stored; a missing email only means the user has to look
+	user, err := service.accountRepository.FindByID(context, export.UserID)
+	if err != nil {
+		service.logger.Warn("account_data_export_notify_skipped", slog.String("export_id", export.ID), slog.Any("error", err))
+		return nil
+	}
+
+	err = service.mailer.Enqueue(context, mail.Email{
+		To:       user.Email,
+		Template: mail.TemplateDataExportReady,
+		Data: mail.DataExportReadyData{
+			Name:      greetingName(user),
+			Link:      service.appURL + DataExportPath,
+			ExpiresIn: ExportRetention,
+		},
+	})
+	if err != nil {
+		service.logger.Error("account_data_export_notify_failed", slog.String("export_id", export.ID), slog.Any("error", err))
+	}
+
+	return nil
+}
+
+/*
+DeleteExpiredExports removes every export whose archive expired.
+
+Parameters:
+  - context: context.Context
+
+Returns:
+  - int: Deleted exports, including batches before a failure
+  - error: Repository level errors
+*/
+func (service *Service) DeleteExpiredExports(context context.Context) (int, error) {
+	now := time.Now().UTC()
+
+	deleted := 0
+	for {
+		affected, err := service.exportRepository.DeleteExpired(context, now, ExportCleanupBatchSize)
+		if err != nil {
+			return deleted, fmt.Errorf("account_service_delete_expired_exports_failed: %w", err)
+		}
+		deleted += affected
+
+		if affected < ExportCleanupBatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+// # Archive Format
+
+/*
+BuildExportArchive writes the sections of an export into a ZIP archive.
+
+Description: Every section becomes an indented NAME.json file stamped with
+the generation time, in the order given.
+
+Parameters:
+  - sections: []ExportSection
+  - generatedAt: time.Time
+
+Returns:
+  - []byte: ZIP archive
+  - error: Invalid JSON in a section or compression failures
+*/
+func BuildExportArchive(sections []ExportSection, generatedAt time.Time) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+
+	for _, section := range sections {
+		var document bytes.Buffer
+		if err := json.Indent(&document, section.Data, "", "  "); err != nil {
+			return nil, fmt.Errorf("export section %s: %w", section.Name, err)
+		}
+		document.WriteByte('\n')
+
+		file, err := writer.CreateHeader(&zip.FileHeader{
+			Name:     section.Name + ".json",
+			Method:   zip.Deflate,
+			Modified: generatedAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("export section %s: %w", section.Name, err)
+		}
+		if _, err := file.Write(document.Bytes()); err != nil {
+			return nil, fmt.Errorf("export section %s: %w", section.Name, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("export archive: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Follow Graph
+
+// GetPublicProfile returns the public view of a user; viewerID is empty for anonymous viewers.
+func (service *Service) GetPublicProfile(context context.Context, userID, viewerID string) (*PublicProfile, error) {
+	profile, err := service.followRepository.FindProfile(context, userID, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("account_service_get_public_profile_failed: %w", err)
+	}
+	return profile, nil
+}
+
+/*
+FollowUser adds the target to the accounts the follower follows.
+
+Parameters:
+  - context: context.Context
+  - followerID: string
+  - targetID: string
+
+Returns:
+  - *Follow: The created edge
+  - error: Validation for a self-follow, NotFound, Conflict or storage failures
+*/
+func (service *Service) FollowUser(context context.Context, followerID, targetID string) (*Follow, error) {
+	if followerID == targetID {
+		return nil, apperr.ValidationError("Validation failed", apperr.FieldError{Field: "id", Message: "cannot follow yourself"})
+	}
+
+	follow, err := service.followRepository.Follow(context, followerID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("account_service_follow_failed: %w", err)
+	}
+
+	service.logger.Info("user_followed",
+		slog.String("follower_id", followerID),
+		slog.String("following_id", targetID),
+	)
+
+	return follow, nil
+}
+
+// UnfollowUser removes the target from the accounts the follower follows.
+func (service *Service) UnfollowUser(context context.Context, followerID, targetID string) error {
+	if err := service.followRepository.Unfollow(context, followerID, targetID); err != nil {
+		return fmt.Errorf("account_service_unfollow_failed: %w", err)
+	}
+	return nil
+}
+
+/*
+ListFollows returns one page of the followers or followings of a user.
+
+Description: A hidden list is only shown to its owner. One extra row is
+read to tell whether another page exists without counting the whole list.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - list: FollowList
+  - viewerID: string (Empty for anonymous viewers)
+  - params: pagination.CursorParams
+
+Returns:
+  - []*FollowUser: Page of accounts
+  - string: Cursor of the next page; empty on the last page
+  - error: Forbidden for a hidden list, NotFound, validation or storage failures
+*/
+func (service *Service) ListFollows(context context.Context, userID string, list FollowList, viewerID string, params pagination.CursorParams) ([]*FollowUser, string, error) {
+	before, err := pagination.DecodeCursor(params.Before)
+	if err != nil {
+		return nil, "", apperr.ValidationError("Validation failed", apperr.FieldError{Field: "before", Message: "is not a valid cursor"})
+	}
+
+	settings, err := service.followRepository.FindPrivacy(context, userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("account_service_list_follows_privacy_failed: %w", err)
+	}
+
+	if viewerID != userID && settings.Hides(list) {
+		return nil, "", apperr.Forbidden("This user keeps their " + string(list) + " list private.")
+	}
+
+	users, err := service.followRepository.List(context, userID, list, viewerID, before, params.Limit+1)
+	if err != nil {
+		return nil, "", fmt.Errorf("account_service_list_follows_failed: %w", err)
+	}
+
+	if len(users) <= params.Limit {
+		return users, "", nil
+	}
+
+	users = users[:params.Limit]
+	last := users[len(users)-1]
+	return users, pagination.Cursor{At: last.FollowedAt, ID: last.ID}.Encode(), nil
+}
+
+// GetPrivacy returns who may see the follow lists of a user.
+func (service *Service) GetPrivacy(context context.Context, userID string) (*PrivacySettings, error) {
+	settings, err := service.followRepository.FindPrivacy(context, userID)
+	if err != nil {
+		return nil, fmt.Errorf("account_service_get_privacy_failed: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdatePrivacy replaces the privacy settings of a user.
+func (service *Service) UpdatePrivacy(context context.Context, userID string, settings PrivacySettings) error {
+	if err := service.followRepository.UpdatePrivacy(context, userID, settings); err != nil {
+		return fmt.Errorf("account_service_update_privacy_failed: %w", err)
+	}
+	return nil
+}
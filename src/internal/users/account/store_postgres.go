@@ -39,6 +39,12 @@ func NewAccountRepository(pool *pgxpool.Pool) *PostgresAccountRepository {
 	return &PostgresAccountRepository{pool: pool}
 }
 
+// passwordColumn reads the password hash of accounts created through social login as "".
+var passwordColumn = fmt.Sprintf("COALESCE(%s, '')", schema.UserAccount.Password)
+
+// suspendedColumn reads isactive as the [auth.User.Suspended] flag.
+var suspendedColumn = fmt.Sprintf("NOT %s", schema.UserAccount.IsActive)
+
 // PostgresPreferencesRepository implements [PreferencesRepository] using pgx.
 type PostgresPreferencesRepository struct {
 	pool *pgxpool.Pool
@@ -74,13 +80,14 @@ Returns:
 */
 func (repository *PostgresAccountRepository) FindByID(context context.Context, id string) (*auth.User, error) {
 	query := fmt.Sprintf(`
-		SELECT %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s
+		SELECT %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s
 		FROM %s
 		WHERE %s = $1 AND %s IS NULL`,
 		schema.UserAccount.ID, schema.UserAccount.Username, schema.UserAccount.Email,
-		schema.UserAccount.Password, schema.UserAccount.DisplayName, schema.UserAccount.AvatarURL,
+		passwordColumn, schema.UserAccount.DisplayName, schema.UserAccount.AvatarURL,
 		schema.UserAccount.Bio, schema.UserAccount.Website, schema.UserAccount.Role,
-		schema.UserAccount.IsVerified, schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
+		schema.UserAccount.IsVerified, suspendedColumn, schema.UserAccount.SuspendedUntil,
+		schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
 		schema.UserAccount.Table, schema.UserAccount.ID, schema.UserAccount.DeletedAt,
 	)
 
@@ -96,6 +103,8 @@ func (repository *PostgresAccountRepository) FindByID(context context.Context, i
 		&user.Website,
 		&user.Role,
 		&user.IsVerified,
+		&user.Suspended,
+		&user.SuspendedUntil,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -168,6 +177,63 @@ func (repository *PostgresAccountRepository) SoftDelete(context context.Context,
 	return err
 }
 
+/*
+EmailExists checks every account row, including soft-deleted ones, because
+they keep their address under the unique constraint.
+
+Parameters:
+  - context: context.Context
+  - email: string
+
+Returns:
+  - bool: True if the address is taken
+  - error: Execution failures
+*/
+func (repository *PostgresAccountRepository) EmailExists(context context.Context, email string) (bool, error) {
+	query := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE LOWER(%s) = LOWER($1))`,
+		schema.UserAccount.Table, schema.UserAccount.Email)
+
+	var exists bool
+	if err := repository.pool.QueryRow(context, query, email).Scan(&exists); err != nil {
+		return false, fmt.Errorf("postgres_account_repo_email_exists_failed: %w", err)
+	}
+	return exists, nil
+}
+
+/*
+UpdateEmail replaces the email of a user and marks it verified.
+
+Parameters:
+  - context: context.Context
+  - id: string
+  - email: string
+
+Returns:
+  - error: apperr.Conflict, apperr.NotFound or execution failures
+*/
+func (repository *PostgresAccountRepository) UpdateEmail(context context.Context, id, email string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET %s = $2, %s = TRUE, %s = NOW()
+		WHERE %s = $1 AND %s IS NULL`,
+		schema.UserAccount.Table,
+		schema.UserAccount.Email, schema.UserAccount.IsVerified, schema.UserAccount.UpdatedAt,
+		schema.UserAccount.ID, schema.UserAccount.DeletedAt,
+	)
+
+	tag, err := repository.pool.Exec(context, query, id, email)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperr.Conflict("This email address is already in use.")
+		}
+		return fmt.Errorf("postgres_account_repo_update_email_failed: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.NotFound("Account")
+	}
+	return nil
+}
+
 // # PreferencesRepository Methods
 
 /*
@@ -328,8 +394,9 @@ Returns:
   - error: Update failures
 */
 func (repository *PostgresSessionRepository) Revoke(context context.Context, userID, sessionID string) error {
-	query := fmt.Sprintf(`UPDATE %s SET %s = NOW() WHERE %s = $1 AND %s = $2`,
-		schema.UserSession.Table, schema.UserSession.RevokedAt, schema.UserSession.ID, schema.UserSession.UserID)
+	query := fmt.Sprintf(`UPDATE %s SET %s = TRUE, %s = COALESCE(%s, NOW()) WHERE %s = $1 AND %s = $2`,
+		schema.UserSession.Table, schema.UserSession.IsRevoked, schema.UserSession.RevokedAt, schema.UserSession.RevokedAt,
+		schema.UserSession.ID, schema.UserSession.UserID)
 	_, err := repository.pool.Exec(context, query, sessionID, userID)
 	return err
 }
@@ -346,8 +413,8 @@ Returns:
   - error: Batch update failures
 */
 func (repository *PostgresSessionRepository) RevokeOthers(context context.Context, userID, currentSessionID string) error {
-	query := fmt.Sprintf(`UPDATE %s SET %s = NOW() WHERE %s = $1 AND %s != $2 AND %s IS NULL`,
-		schema.UserSession.Table, schema.UserSession.RevokedAt, schema.UserSession.UserID,
+	query := fmt.Sprintf(`UPDATE %s SET %s = TRUE, %s = NOW() WHERE %s = $1 AND %s != $2 AND %s IS NULL`,
+		schema.UserSession.Table, schema.UserSession.IsRevoked, schema.UserSession.RevokedAt, schema.UserSession.UserID,
 		schema.UserSession.ID, schema.UserSession.RevokedAt)
 	_, err := repository.pool.Exec(context, query, userID, currentSessionID)
 	return err
@@ -364,8 +431,9 @@ Returns:
   - error: Batch update failures
 */
 func (repository *PostgresSessionRepository) RevokeAll(context context.Context, userID string) error {
-	query := fmt.Sprintf(`UPDATE %s SET %s = NOW() WHERE %s = $1 AND %s IS NULL`,
-		schema.UserSession.Table, schema.UserSession.RevokedAt, schema.UserSession.UserID, schema.UserSession.RevokedAt)
+	query := fmt.Sprintf(`UPDATE %s SET %s = TRUE, %s = NOW() WHERE %s = $1 AND %s IS NULL`,
+		schema.UserSession.Table, schema.UserSession.IsRevoked, schema.UserSession.RevokedAt,
+		schema.UserSession.UserID, schema.UserSession.RevokedAt)
 	_, err := repository.pool.Exec(context, query, userID)
 	return err
 }
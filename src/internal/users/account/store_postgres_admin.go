@@ -0,0 +1,326 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/internal/users/auth"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// foreignKeyViolation is the PostgreSQL SQLSTATE of a foreign key violation.
+const foreignKeyViolation = "23503"
+
+// # Admin Repository
+
+// PostgresAdminRepository implements [AdminRepository] using pgx.
+type PostgresAdminRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAdminRepository creates a new Postgres implementation for account administration.
+func NewAdminRepository(pool *pgxpool.Pool) *PostgresAdminRepository {
+	return &PostgresAdminRepository{pool: pool}
+}
+
+// activeSuspension matches accounts whose suspension has not run out.
+var activeSuspension = fmt.Sprintf("(NOT %[1]s AND (%[2]s IS NULL OR %[2]s > NOW()))",
+	schema.UserAccount.IsActive, schema.UserAccount.SuspendedUntil)
+
+// adminUserProjection lists the columns read by [scanAdminUser].
+var adminUserProjection = fmt.Sprintf(
+	"%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, COALESCE(%s, ''), %s, %s",
+	schema.UserAccount.ID, schema.UserAccount.Username, schema.UserAccount.Email,
+	schema.UserAccount.DisplayName, schema.UserAccount.AvatarURL, schema.UserAccount.Bio,
+	schema.UserAccount.Website, schema.UserAccount.Role, schema.UserAccount.IsVerified,
+	suspendedColumn, schema.UserAccount.SuspendedUntil,
+	schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
+	schema.UserAccount.SuspendedAt, schema.UserAccount.SuspendReason,
+	schema.UserAccount.LastLoginAt, schema.UserAccount.DeletedAt,
+)
+
+/*
+List filters accounts with a dynamic WHERE clause and counts matches with COUNT(*) OVER().
+
+Parameters:
+  - context: context.Context
+  - filter: UserFilter
+  - limit, offset: int
+
+Returns:
+  - []*AdminUser: Page of accounts
+  - int: Total matches
+  - error: Execution failures
+*/
+func (repository *PostgresAdminRepository) List(context context.Context, filter UserFilter, limit, offset int) ([]*AdminUser, int, error) {
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(fmt.Sprintf("SELECT %s, COUNT(*) OVER() FROM %s WHERE TRUE",
+		adminUserProjection, schema.UserAccount.Table))
+
+	args := []any{}
+	argID := 1
+
+	if !filter.IncludeDeleted {
+		queryBuilder.WriteString(fmt.Sprintf(" AND %s IS NULL", schema.UserAccount.DeletedAt))
+	}
+
+	if filter.Query != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" AND (%[1]s ILIKE $%[4]d OR %[2]s ILIKE $%[4]d OR %[3]s ILIKE $%[4]d)",
+			schema.UserAccount.Username, schema.UserAccount.Email, schema.UserAccount.DisplayName, argID))
+		args = append(args, "%"+likeEscaper.Replace(filter.Query)+"%")
+		argID++
+	}
+
+	if filter.Role != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" AND %s = $%d", schema.UserAccount.Role, argID))
+		args = append(args, filter.Role)
+		argID++
+	}
+
+	if filter.IsVerified != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND %s = $%d", schema.UserAccount.IsVerified, argID))
+		args = append(args, *filter.IsVerified)
+		argID++
+	}
+
+	if filter.Suspended != nil {
+		if *filter.Suspended {
+			queryBuilder.WriteString(" AND " + activeSuspension)
+		} else {
+			queryBuilder.WriteString(" AND NOT " + activeSuspension)
+		}
+	}
+
+	queryBuilder.WriteString(fmt.Sprintf(" ORDER BY %s DESC, %s DESC LIMIT $%d OFFSET $%d",
+		schema.UserAccount.CreatedAt, schema.UserAccount.ID, argID, argID+1))
+	args = append(args, limit, offset)
+
+	rows, err := repository.pool.Query(context, queryBuilder.String(), args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("postgres_admin_repo_list_failed: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*AdminUser, 0)
+	total := 0
+	for rows.Next() {
+		user, err := scanAdminUser(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("postgres_admin_repo_scan_failed: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("postgres_admin_repo_rows_failed: %w", err)
+	}
+
+	return users, total, nil
+}
+
+// FindByID retrieves any account, soft-deleted or not.
+func (repository *PostgresAdminRepository) FindByID(context context.Context, id string) (*AdminUser, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s = $1",
+		adminUserProjection, schema.UserAccount.Table, schema.UserAccount.ID)
+
+	user, err := scanAdminUser(repository.pool.QueryRow(context, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("Account")
+		}
+		return nil, fmt.Errorf("postgres_admin_repo_find_by_id_failed: %w", err)
+	}
+	return user, nil
+}
+
+// UpdateRole grants a new role to a live account.
+func (repository *PostgresAdminRepository) UpdateRole(context context.Context, change RoleChange) error {
+	return repository.audited(context, change.UserID, change.ActorID, "user.role_change", func(transaction pgx.Tx) (map[string]any, error) {
+		query := fmt.Sprintf("UPDATE %s SET %s = $2, %s = NOW() WHERE %s = $1 AND %s IS NULL",
+			schema.UserAccount.Table, schema.UserAccount.Role, schema.UserAccount.UpdatedAt,
+			schema.UserAccount.ID, schema.UserAccount.DeletedAt)
+
+		return map[string]any{"role": change.Role, "reason": change.Reason}, execOne(context, transaction, query, change.UserID, change.Role)
+	})
+}
+
+// Suspend bars a live account, replacing any earlier suspension.
+func (repository *PostgresAdminRepository) Suspend(context context.Context, suspension Suspension) error {
+	return repository.audited(context, suspension.UserID, suspension.ActorID, "user.suspend", func(transaction pgx.Tx) (map[string]any, error) {
+		query := fmt.Sprintf(`
+			UPDATE %s SET %s = FALSE, %s = NOW(), %s = $2, %s = $3, %s = NOW()
+			WHERE %s = $1 AND %s IS NULL`,
+			schema.UserAccount.Table,
+			schema.UserAccount.IsActive, schema.UserAccount.SuspendedAt, schema.UserAccount.SuspendedUntil,
+			schema.UserAccount.SuspendReason, schema.UserAccount.UpdatedAt,
+			schema.UserAccount.ID, schema.UserAccount.DeletedAt)
+
+		after := map[string]any{"is_active": false, "suspended_until": suspension.Until, "suspend_reason": suspension.Reason}
+		return after, execOne(context, transaction, query, suspension.UserID, suspension.Until, suspension.Reason)
+	})
+}
+
+// Restore lifts the suspension of a live account.
+func (repository *PostgresAdminRepository) Restore(context context.Context, userID, actorID string) error {
+	return repository.audited(context, userID, actorID, "user.restore", func(transaction pgx.Tx) (map[string]any, error) {
+		query := fmt.Sprintf(`
+			UPDATE %s SET %s = TRUE, %s = NULL, %s = NULL, %s = NULL, %s = NOW()
+			WHERE %s = $1 AND %s IS NULL`,
+			schema.UserAccount.Table,
+			schema.UserAccount.IsActive, schema.UserAccount.SuspendedAt, schema.UserAccount.SuspendedUntil,
+			schema.UserAccount.SuspendReason, schema.UserAccount.UpdatedAt,
+			schema.UserAccount.ID, schema.UserAccount.DeletedAt)
+
+		return map[string]any{"is_active": true}, execOne(context, transaction, query, userID)
+	})
+}
+
+// Delete removes an account row; the audit entry keeps its role but not its email.
+func (repository *PostgresAdminRepository) Delete(context context.Context, userID, actorID, reason string) error {
+	return repository.audited(context, userID, actorID, "user.delete", func(transaction pgx.Tx) (map[string]any, error) {
+		query := fmt.Sprintf("DELETE FROM %s WHERE %s = $1", schema.UserAccount.Table, schema.UserAccount.ID)
+
+		err := execOne(context, transaction, query, userID)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return nil, apperr.Conflict("The account still owns content that blocks deletion; suspend it instead.")
+		}
+		return map[string]any{"reason": reason}, err
+	})
+}
+
+// # Helpers
+
+/*
+audited locks the account, applies a change and records it in system.auditlog
+within one transaction.
+
+Parameters:
+  - context: context.Context
+  - userID, actorID: string
+  - action: string (Audit action, e.g. "user.suspend")
+  - change: func(pgx.Tx) (map[string]any, error) (Returns the "after" snapshot)
+
+Returns:
+  - error: apperr.NotFound, errors from change, or execution failures
+*/
+func (repository *PostgresAdminRepository) audited(context context.Context, userID, actorID, action string, change func(pgx.Tx) (map[string]any, error)) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres_admin_repo_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	lockQuery := fmt.Sprintf(`
+		SELECT %s, %s, %s, %s, COALESCE(%s, '')
+		FROM %s WHERE %s = $1 FOR UPDATE`,
+		schema.UserAccount.Username, schema.UserAccount.Role, schema.UserAccount.IsActive,
+		schema.UserAccount.SuspendedUntil, schema.UserAccount.SuspendReason,
+		schema.UserAccount.Table, schema.UserAccount.ID,
+	)
+
+	var before struct {
+		Username       string
+		Role           string
+		IsActive       bool
+		SuspendedUntil *time.Time
+		SuspendReason  string
+	}
+	err = transaction.QueryRow(context, lockQuery, userID).Scan(
+		&before.Username, &before.Role, &before.IsActive, &before.SuspendedUntil, &before.SuspendReason,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperr.NotFound("Account")
+		}
+		return fmt.Errorf("postgres_admin_repo_lock_failed: %w", err)
+	}
+
+	after, err := change(transaction)
+	if err != nil {
+		return err
+	}
+
+	auditQuery := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES ($1, $2, $3, 'user', $4, $5, $6, NOW())`,
+		schema.SystemAuditLog.Table,
+		schema.SystemAuditLog.ID, schema.SystemAuditLog.ActorID, schema.SystemAuditLog.Action,
+		schema.SystemAuditLog.EntityType, schema.SystemAuditLog.EntityID,
+		schema.SystemAuditLog.Before, schema.SystemAuditLog.After, schema.SystemAuditLog.CreatedAt,
+	)
+
+	beforeSnapshot := map[string]any{
+		"username":        before.Username,
+		"role":            before.Role,
+		"is_active":       before.IsActive,
+		"suspended_until": before.SuspendedUntil,
+		"suspend_reason":  before.SuspendReason,
+	}
+
+	if _, err := transaction.Exec(context, auditQuery, uuid.New(), actorID, action, userID, beforeSnapshot, after); err != nil {
+		return fmt.Errorf("postgres_admin_repo_audit_failed: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres_admin_repo_commit_failed: %w", err)
+	}
+	return nil
+}
+
+// execOne runs a statement that must touch exactly one live account.
+func execOne(context context.Context, transaction pgx.Tx, query string, args ...any) error {
+	tag, err := transaction.Exec(context, query, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.NotFound("Account")
+	}
+	return nil
+}
+
+// likeEscaper makes user input match literally inside an ILIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// scanAdminUser hydrates an [AdminUser] selected with [adminUserProjection],
+// followed by any extra targets such as a window count.
+func scanAdminUser(row pgx.Row, extra ...any) (*AdminUser, error) {
+	user := &AdminUser{User: &auth.User{}}
+	targets := []any{
+		&user.ID,
+		&user.Username,
+		&user.Email,
+		&user.DisplayName,
+		&user.AvatarURL,
+		&user.Bio,
+		&user.Website,
+		&user.Role,
+		&user.IsVerified,
+		&user.Suspended,
+		&user.SuspendedUntil,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+		&user.SuspendedAt,
+		&user.SuspendReason,
+		&user.LastLoginAt,
+		&user.DeletedAt,
+	}
+
+	if err := row.Scan(append(targets, extra...)...); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+)
+
+// # Deletion Repository
+
+// PostgresDeletionRepository implements [DeletionRepository] using pgx.
+type PostgresDeletionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDeletionRepository creates a new Postgres implementation for delayed account deletion.
+func NewDeletionRepository(pool *pgxpool.Pool) *PostgresDeletionRepository {
+	return &PostgresDeletionRepository{pool: pool}
+}
+
+/*
+Schedule soft-deletes a live account and stores its deletion request.
+
+Parameters:
+  - context: context.Context
+  - request: *DeletionRequest
+
+Returns:
+  - error: apperr.NotFound if the account is already deleted, or execution failures
+*/
+func (repository *PostgresDeletionRepository) Schedule(context context.Context, request *DeletionRequest) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres_deletion_repo_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	deleteQuery := fmt.Sprintf(`UPDATE %s SET %s = $2 WHERE %s = $1 AND %s IS NULL`,
+		schema.UserAccount.Table, schema.UserAccount.DeletedAt,
+		schema.UserAccount.ID, schema.UserAccount.DeletedAt,
+	)
+
+	tag, err := transaction.Exec(context, deleteQuery, request.UserID, request.RequestedAt)
+	if err != nil {
+		return fmt.Errorf("postgres_deletion_repo_soft_delete_failed: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.NotFound("User")
+	}
+
+	scheduleQuery := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (%[2]s) DO UPDATE
+		SET %[3]s = EXCLUDED.%[3]s, %[4]s = EXCLUDED.%[4]s, %[5]s = EXCLUDED.%[5]s`,
+		schema.UserDeletionRequest.Table,       // 1
+		schema.UserDeletionRequest.UserID,      // 2
+		schema.UserDeletionRequest.TokenHash,   // 3
+		schema.UserDeletionRequest.RequestedAt, // 4
+		schema.UserDeletionRequest.PurgeAfter,  // 5
+	)
+
+	_, err = transaction.Exec(context, scheduleQuery, request.UserID, request.TokenHash, request.RequestedAt, request.PurgeAfter)
+	if err != nil {
+		return fmt.Errorf("postgres_deletion_repo_schedule_failed: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres_deletion_repo_commit_failed: %w", err)
+	}
+	return nil
+}
+
+/*
+Cancel drops a deletion request still in its grace period and restores the account.
+
+Parameters:
+  - context: context.Context
+  - tokenHash: string
+  - now: time.Time
+
+Returns:
+  - string: ID of the restored account
+  - error: apperr.NotFound for unknown or elapsed requests, or execution failures
+*/
+func (repository *PostgresDeletionRepository) Cancel(context context.Context, tokenHash string, now time.Time) (string, error) {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return "", fmt.Errorf("postgres_deletion_repo_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	cancelQuery := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1 AND %s > $2 RETURNING %s`,
+		schema.UserDeletionRequest.Table,
+		schema.UserDeletionRequest.TokenHash, schema.UserDeletionRequest.PurgeAfter,
+		schema.UserDeletionRequest.UserID,
+	)
+
+	var userID string
+	if err := transaction.QueryRow(context, cancelQuery, tokenHash, now).Scan(&userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", apperr.NotFound("Deletion request")
+		}
+		return "", fmt.Errorf("postgres_deletion_repo_cancel_failed: %w", err)
+	}
+
+	restoreQuery := fmt.Sprintf(`UPDATE %s SET %s = NULL WHERE %s = $1`,
+		schema.UserAccount.Table, schema.UserAccount.DeletedAt, schema.UserAccount.ID)
+
+	if _, err := transaction.Exec(context, restoreQuery, userID); err != nil {
+		return "", fmt.Errorf("postgres_deletion_repo_restore_failed: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return "", fmt.Errorf("postgres_deletion_repo_commit_failed: %w", err)
+	}
+	return userID, nil
+}
+
+// ListDue returns accounts whose grace period ended before now, oldest first.
+func (repository *PostgresDeletionRepository) ListDue(context context.Context, now time.Time, limit int) ([]string, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s <= $1 ORDER BY %s LIMIT $2`,
+		schema.UserDeletionRequest.UserID, schema.UserDeletionRequest.Table,
+		schema.UserDeletionRequest.PurgeAfter, schema.UserDeletionRequest.PurgeAfter,
+	)
+
+	rows, err := repository.pool.Query(context, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("postgres_deletion_repo_list_due_failed: %w", err)
+	}
+
+	userIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("postgres_deletion_repo_scan_failed: %w", err)
+	}
+	return userIDs, nil
+}
+
+/*
+Purge removes the personal data of a soft-deleted account.
+
+Description: Private data (library, lists, reading history, follows,
+credentials, sessions, settings and exports) is deleted, and the comics and
+groups the user followed lose the follow it counted for. Public
+contributions stay: the account row becomes an anonymous tombstone with a
+generated username and an undeliverable address, so comments, votes and
+ratings keep their references but no longer point at a person. The bodies
+of comments the user had already deleted are erased as well.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - error: Execution failures
+*/
+func (repository *PostgresDeletionRepository) Purge(context context.Context, userID string) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres_deletion_repo_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	for _, query := range purgeQueries {
+		if _, err := transaction.Exec(context, query, userID); err != nil {
+			return fmt.Errorf("postgres_deletion_repo_purge_failed: %w", err)
+		}
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres_deletion_repo_commit_failed: %w", err)
+	}
+	return nil
+}
+
+// deleteByUser deletes every row of a table owned by $1.
+func deleteByUser(table, userColumn string) string {
+	return fmt.Sprintf(`DELETE FROM %s WHERE %s = $1`, table, userColumn)
+}
+
+// purgeQueries run in order against $1, the user ID; children go before their parents.
+var purgeQueries = []string{
+	// Shelf rows and group follows feed the follow counters, so release them first
+	fmt.Sprintf(`UPDATE %[1]s SET %[2]s = GREATEST(%[2]s - 1, 0) WHERE %[3]s IN (SELECT %[4]s FROM %[5]s WHERE %[6]s = $1)`,
+		schema.CoreComic.Table,       // 1
+		schema.CoreComic.FollowCount, // 2
+		schema.CoreComic.ID,          // 3
+		schema.LibraryEntry.ComicID,  // 4
+		schema.LibraryEntry.Table,    // 5
+		schema.LibraryEntry.UserID,   // 6
+	),
+	fmt.Sprintf(`UPDATE %[1]s SET %[2]s = GREATEST(%[2]s - 1, 0) WHERE %[3]s IN (SELECT %[4]s FROM %[5]s WHERE %[6]s = $1)`,
+		schema.CoreGroup.Table,       // 1
+		schema.CoreGroup.FollowCount, // 2
+		schema.CoreGroup.ID,          // 3
+		schema.CoreFollow.GroupID,    // 4
+		schema.CoreFollow.Table,      // 5
+		schema.CoreFollow.UserID,     // 6
+	),
+	fmt.Sprintf(`DELETE FROM %s WHERE %s IN (SELECT %s FROM %s WHERE %s = $1)`,
+		schema.LibraryCustomListItem.Table, schema.LibraryCustomListItem.ListID,
+		schema.LibraryCustomList.ID, schema.LibraryCustomList.Table, schema.LibraryCustomList.UserID,
+	),
+	deleteByUser(schema.LibraryCustomList.Table, schema.LibraryCustomList.UserID),
+	deleteByUser(schema.LibraryEntry.Table, schema.LibraryEntry.UserID),
+	deleteByUser(schema.LibraryReadingProgress.Table, schema.LibraryReadingProgress.UserID),
+	deleteByUser(schema.LibraryViewHistory.Table, schema.LibraryViewHistory.UserID),
+	deleteByUser(schema.CoreUserRead.Table, schema.CoreUserRead.UserID),
+	fmt.Sprintf(`DELETE FROM %s WHERE %s = $1 OR %s = $1`,
+		schema.UserFollow.Table, schema.UserFollow.FollowerID, schema.UserFollow.FollowingID,
+	),
+	deleteByUser(schema.CoreFollow.Table, schema.CoreFollow.UserID),
+	deleteByUser(schema.CoreMember.Table, schema.CoreMember.UserID),
+	deleteByUser(schema.UserSession.Table, schema.UserSession.UserID),
+	deleteByUser(schema.UserAccessToken.Table, schema.UserAccessToken.UserID),
+	deleteByUser(schema.UserOAuthProvider.Table, schema.UserOAuthProvider.UserID),
+	deleteByUser(schema.UserMFARecoveryCode.Table, schema.UserMFARecoveryCode.UserID),
+	deleteByUser(schema.UserMFA.Table, schema.UserMFA.UserID),
+	deleteByUser(schema.UserPreferences.Table, schema.UserPreferences.UserID),
+	deleteByUser(schema.UserEmailChange.Table, schema.UserEmailChange.UserID),
+	deleteByUser(schema.UserDataExport.Table, schema.UserDataExport.UserID),
+	fmt.Sprintf(`UPDATE %s SET %s = '' WHERE %s = $1 AND %s`,
+		schema.SocialComment.Table, schema.SocialComment.Body,
+		schema.SocialComment.UserID, schema.SocialComment.IsDeleted,
+	),
+	fmt.Sprintf(`
+		UPDATE %[1]s
+		SET %[2]s = 'deleted_' || REPLACE(%[3]s, '-', ''),
+			%[4]s = REPLACE(%[3]s, '-', '') || '@deleted.invalid',
+			%[5]s = NULL, %[6]s = '', %[7]s = '', %[8]s = '', %[9]s = '',
+			%[10]s = NULL, %[11]s = NULL, %[12]s = FALSE, %[13]s = TRUE, %[14]s = TRUE, %[15]s = NOW()
+		WHERE %[3]s = $1 AND %[16]s IS NOT NULL`,
+		schema.UserAccount.Table,         // 1
+		schema.UserAccount.Username,      // 2
+		schema.UserAccount.ID,            // 3
+		schema.UserAccount.Email,         // 4
+		schema.UserAccount.Password,      // 5
+		schema.UserAccount.DisplayName,   // 6
+		schema.UserAccount.AvatarURL,     // 7
+		schema.UserAccount.Bio,           // 8
+		schema.UserAccount.Website,       // 9
+		schema.UserAccount.LastLoginAt,   // 10
+		schema.UserAccount.SuspendReason, // 11
+		schema.UserAccount.IsVerified,    // 12
+		schema.UserAccount.HideFollowers, // 13
+		schema.UserAccount.HideFollowing, // 14
+		schema.UserAccount.UpdatedAt,     // 15
+		schema.UserAccount.DeletedAt,     // 16
+	),
+	deleteByUser(schema.UserDeletionRequest.Table, schema.UserDeletionRequest.UserID),
+}
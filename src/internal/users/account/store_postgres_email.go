@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+)
+
+// uniqueViolation is the PostgreSQL SQLSTATE of a unique constraint violation.
+const uniqueViolation = "23505"
+
+// # Email Change Repository
+
+// PostgresEmailChangeRepository implements [EmailChangeRepository] using pgx.
+type PostgresEmailChangeRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewEmailChangeRepository creates a new Postgres implementation for email change requests.
+func NewEmailChangeRepository(pool *pgxpool.Pool) *PostgresEmailChangeRepository {
+	return &PostgresEmailChangeRepository{pool: pool}
+}
+
+// emailChangeProjection lists the columns read by [scanEmailChange]; cleared rows read as "".
+var emailChangeProjection = fmt.Sprintf("%s, COALESCE(%s, ''), COALESCE(%s, ''), COALESCE(%s, ''), %s, %s, %s, %s",
+	schema.UserEmailChange.UserID, schema.UserEmailChange.NewEmail,
+	schema.UserEmailChange.TokenHash, schema.UserEmailChange.CancelTokenHash,
+	schema.UserEmailChange.ExpiresAt, schema.UserEmailChange.RequestedAt,
+	schema.UserEmailChange.RequestCount, schema.UserEmailChange.WindowStartedAt,
+)
+
+// FindByUserID retrieves the row of a user whether or not a request is pending.
+func (repository *PostgresEmailChangeRepository) FindByUserID(context context.Context, userID string) (*EmailChange, error) {
+	return repository.findBy(context, schema.UserEmailChange.UserID, userID)
+}
+
+// FindByTokenHash retrieves a pending change by its confirm token.
+func (repository *PostgresEmailChangeRepository) FindByTokenHash(context context.Context, tokenHash string) (*EmailChange, error) {
+	return repository.findBy(context, schema.UserEmailChange.TokenHash, tokenHash)
+}
+
+// FindByCancelTokenHash retrieves a pending change by its cancel token.
+func (repository *PostgresEmailChangeRepository) FindByCancelTokenHash(context context.Context, cancelTokenHash string) (*EmailChange, error) {
+	return repository.findBy(context, schema.UserEmailChange.CancelTokenHash, cancelTokenHash)
+}
+
+// findBy loads one row by a unique column.
+func (repository *PostgresEmailChangeRepository) findBy(context context.Context, column, value string) (*EmailChange, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1`,
+		emailChangeProjection, schema.UserEmailChange.Table, column)
+
+	change, err := scanEmailChange(repository.pool.QueryRow(context, query, value))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("Email change")
+		}
+		return nil, fmt.Errorf("postgres_email_change_repo_find_failed: %w", err)
+	}
+	return change, nil
+}
+
+/*
+Save upserts the row of a user, replacing any pending request and its tokens.
+
+Parameters:
+  - context: context.Context
+  - change: *EmailChange
+
+Returns:
+  - error: Execution failures
+*/
+func (repository *PostgresEmailChangeRepository) Save(context context.Context, change *EmailChange) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s, %[6]s, %[7]s, %[8]s, %[9]s, %[10]s)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		ON CONFLICT (%[2]s) DO UPDATE SET
+			%[3]s = EXCLUDED.%[3]s, %[4]s = EXCLUDED.%[4]s, %[5]s = EXCLUDED.%[5]s,
+			%[6]s = EXCLUDED.%[6]s, %[7]s = EXCLUDED.%[7]s, %[8]s = EXCLUDED.%[8]s,
+			%[9]s = EXCLUDED.%[9]s`,
+		schema.UserEmailChange.Table,           // 1
+		schema.UserEmailChange.UserID,          // 2
+		schema.UserEmailChange.NewEmail,        // 3
+		schema.UserEmailChange.TokenHash,       // 4
+		schema.UserEmailChange.CancelTokenHash, // 5
+		schema.UserEmailChange.ExpiresAt,       // 6
+		schema.UserEmailChange.RequestedAt,     // 7
+		schema.UserEmailChange.RequestCount,    // 8
+		schema.UserEmailChange.WindowStartedAt, // 9
+		schema.UserEmailChange.CreatedAt,       // 10
+	)
+
+	_, err := repository.pool.Exec(context, query,
+		change.UserID,
+		change.NewEmail,
+		change.TokenHash,
+		change.CancelTokenHash,
+		change.ExpiresAt,
+		change.RequestedAt,
+		change.RequestCount,
+		change.WindowStartedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres_email_change_repo_save_failed: %w", err)
+	}
+	return nil
+}
+
+// Clear keeps the row for the rate limit but forgets the address and both tokens.
+func (repository *PostgresEmailChangeRepository) Clear(context context.Context, userID string) error {
+	query := fmt.Sprintf(`UPDATE %s SET %s = NULL, %s = NULL, %s = NULL WHERE %s = $1`,
+		schema.UserEmailChange.Table,
+		schema.UserEmailChange.NewEmail, schema.UserEmailChange.TokenHash, schema.UserEmailChange.CancelTokenHash,
+		schema.UserEmailChange.UserID,
+	)
+
+	if _, err := repository.pool.Exec(context, query, userID); err != nil {
+		return fmt.Errorf("postgres_email_change_repo_clear_failed: %w", err)
+	}
+	return nil
+}
+
+// # Helpers
+
+// scanEmailChange hydrates an [EmailChange] from a row selected with [emailChangeProjection].
+func scanEmailChange(row pgx.Row) (*EmailChange, error) {
+	change := &EmailChange{}
+	err := row.Scan(
+		&change.UserID,
+		&change.NewEmail,
+		&change.TokenHash,
+		&change.CancelTokenHash,
+		&change.ExpiresAt,
+		&change.RequestedAt,
+		&change.RequestCount,
+		&change.WindowStartedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique constraint violation.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+)
+
+// # Export Repository
+
+// PostgresExportRepository implements [ExportRepository] using pgx.
+type PostgresExportRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewExportRepository creates a new Postgres implementation for personal data exports.
+func NewExportRepository(pool *pgxpool.Pool) *PostgresExportRepository {
+	return &PostgresExportRepository{pool: pool}
+}
+
+// exportProjection lists the columns of a [DataExport]; the archive is never part of it.
+var exportProjection = fmt.Sprintf("%s, %s, %s, COALESCE(%s, 0), COALESCE(%s, ''), %s, %s, %s",
+	schema.UserDataExport.ID, schema.UserDataExport.UserID, schema.UserDataExport.Status,
+	schema.UserDataExport.SizeBytes, schema.UserDataExport.LastError, schema.UserDataExport.CreatedAt,
+	schema.UserDataExport.CompletedAt, schema.UserDataExport.ExpiresAt,
+)
+
+// Create stores a new pending export.
+func (repository *PostgresExportRepository) Create(context context.Context, export *DataExport) error {
+	query := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s) VALUES ($1, $2, $3, $4)`,
+		schema.UserDataExport.Table,
+		schema.UserDataExport.ID, schema.UserDataExport.UserID,
+		schema.UserDataExport.Status, schema.UserDataExport.CreatedAt,
+	)
+
+	if _, err := repository.pool.Exec(context, query, export.ID, export.UserID, export.Status, export.CreatedAt); err != nil {
+		return fmt.Errorf("postgres_export_repo_create_failed: %w", err)
+	}
+	return nil
+}
+
+// ListByUser returns the remaining exports of a user, newest first.
+func (repository *PostgresExportRepository) ListByUser(context context.Context, userID string) ([]*DataExport, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 ORDER BY %s DESC`,
+		exportProjection, schema.UserDataExport.Table,
+		schema.UserDataExport.UserID, schema.UserDataExport.CreatedAt,
+	)
+
+	return repository.query(context, query, userID)
+}
+
+// ListPending returns the oldest exports waiting for the export job.
+func (repository *PostgresExportRepository) ListPending(context context.Context, limit int) ([]*DataExport, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 ORDER BY %s LIMIT $2`,
+		exportProjection, schema.UserDataExport.Table,
+		schema.UserDataExport.Status, schema.UserDataExport.CreatedAt,
+	)
+
+	return repository.query(context, query, ExportPending, limit)
+}
+
+// query runs a projection query and scans every row.
+func (repository *PostgresExportRepository) query(context context.Context, query string, args ...any) ([]*DataExport, error) {
+	rows, err := repository.pool.Query(context, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres_export_repo_list_failed: %w", err)
+	}
+	defer rows.Close()
+
+	exports := make([]*DataExport, 0)
+	for rows.Next() {
+		export := &DataExport{}
+		if err := rows.Scan(
+			&export.ID,
+			&export.UserID,
+			&export.Status,
+			&export.SizeBytes,
+			&export.LastError,
+			&export.CreatedAt,
+			&export.CompletedAt,
+			&export.ExpiresAt,
+		); err != nil {
+			return nil, fmt.Errorf("postgres_export_repo_scan_failed: %w", err)
+		}
+		exports = append(exports, export)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres_export_repo_rows_failed: %w", err)
+	}
+	return exports, nil
+}
+
+// FindArchive reads the archive of a ready, unexpired export owned by the user.
+func (repository *PostgresExportRepository) FindArchive(context context.Context, userID, exportID string) ([]byte, error) {
+	query := fmt.Sprintf(`
+		SELECT %[1]s FROM %[2]s
+		WHERE %[3]s = $1 AND %[4]s = $2 AND %[5]s = $3 AND %[6]s > NOW()`,
+		schema.UserDataExport.Archive,   // 1
+		schema.UserDataExport.Table,     // 2
+		schema.UserDataExport.ID,        // 3
+		schema.UserDataExport.UserID,    // 4
+		schema.UserDataExport.Status,    // 5
+		schema.UserDataExport.ExpiresAt, // 6
+	)
+
+	var archive []byte
+	if err := repository.pool.QueryRow(context, query, exportID, userID, ExportReady).Scan(&archive); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("Export")
+		}
+		return nil, fmt.Errorf("postgres_export_repo_find_archive_failed: %w", err)
+	}
+	return archive, nil
+}
+
+// Complete stores the archive of an export and marks it ready.
+func (repository *PostgresExportRepository) Complete(context context.Context, exportID string, archive []byte, completedAt, expiresAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET %s = $2, %s = $3, %s = $4, %s = $5, %s = $6, %s = NULL WHERE %s = $1`,
+		schema.UserDataExport.Table,
+		schema.UserDataExport.Status, schema.UserDataExport.Archive, schema.UserDataExport.SizeBytes,
+		schema.UserDataExport.CompletedAt, schema.UserDataExport.ExpiresAt,
+		schema.UserDataExport.LastError,
+		schema.UserDataExport.ID,
+	)
+
+	if _, err := repository.pool.Exec(context, query, exportID, ExportReady, archive, len(archive), completedAt, expiresAt); err != nil {
+		return fmt.Errorf("postgres_export_repo_complete_failed: %w", err)
+	}
+	return nil
+}
+
+// Fail marks an export as failed; the row expires like a finished one.
+func (repository *PostgresExportRepository) Fail(context context.Context, exportID, lastError string) error {
+	query := fmt.Sprintf(`UPDATE %s SET %s = $2, %s = $3, %s = $4, %s = $5 WHERE %s = $1`,
+		schema.UserDataExport.Table,
+		schema.UserDataExport.Status, schema.UserDataExport.LastError,
+		schema.UserDataExport.CompletedAt, schema.UserDataExport.ExpiresAt,
+		schema.UserDataExport.ID,
+	)
+
+	now := time.Now().UTC()
+	if _, err := repository.pool.Exec(context, query, exportID, ExportFailed, lastError, now, now.Add(ExportRetention)); err != nil {
+		return fmt.Errorf("postgres_export_repo_fail_failed: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired removes one batch of exports whose archive expired before now.
+func (repository *PostgresExportRepository) DeleteExpired(context context.Context, now time.Time, limit int) (int, error) {
+	query := fmt.Sprintf(`
+		DELETE FROM %[1]s
+		WHERE %[2]s IN (
+			SELECT %[2]s FROM %[1]s
+			WHERE %[3]s <= $1
+			LIMIT $2
+		)`,
+		schema.UserDataExport.Table,     // 1
+		schema.UserDataExport.ID,        // 2
+		schema.UserDataExport.ExpiresAt, // 3
+	)
+
+	tag, err := repository.pool.Exec(context, query, now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("postgres_export_repo_delete_expired_failed: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// # Export Sections
+
+/*
+Collect reads every section of the personal data of a user.
+
+Description: Postgres renders each section as JSON inside one read-only,
+repeatable-read transaction, so the archive is a consistent snapshot.
+Secrets such as password and token hashes are never selected.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - []ExportSection: JSON documents in archive order
+  - error: Execution failures
+*/
+func (repository *PostgresExportRepository) Collect(context context.Context, userID string) ([]ExportSection, error) {
+	transaction, err := repository.pool.BeginTx(context, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("postgres_export_repo_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	sections := make([]ExportSection, 0, len(exportSectionQueries))
+	for _, section := range exportSectionQueries {
+		var data []byte
+		if err := transaction.QueryRow(context, section.query, userID).Scan(&data); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, apperr.NotFound("User")
+			}
+			return nil, fmt.Errorf("postgres_export_repo_collect_%s_failed: %w", section.name, err)
+		}
+		sections = append(sections, ExportSection{Name: section.name, Data: data})
+	}
+
+	return sections, nil
+}
+
+// jsonArray wraps a row query into a JSON array of its rows.
+func jsonArray(query string) string {
+	return fmt.Sprintf(`SELECT COALESCE(json_agg(t), '[]'::json) FROM (%s) t`, query)
+}
+
+// exportSectionQueries renders each archive document from $1, the user ID.
+var exportSectionQueries = []struct {
+	name  string
+	query string
+}{
+	{"profile", fmt.Sprintf(`
+		SELECT row_to_json(t) FROM (
+			SELECT %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s
+			FROM %s WHERE %s = $1
+		) t`,
+		schema.UserAccount.ID, schema.UserAccount.Username, schema.UserAccount.Email,
+		schema.UserAccount.Role, schema.UserAccount.IsVerified, schema.UserAccount.DisplayName,
+		schema.UserAccount.AvatarURL, schema.UserAccount.Bio, schema.UserAccount.Website,
+		schema.UserAccount.HideFollowers, schema.UserAccount.HideFollowing, schema.UserAccount.LastLoginAt,
+		schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
+		schema.UserAccount.Table, schema.UserAccount.ID,
+	)},
+	{"preferences", jsonArray(fmt.Sprintf(`
+		SELECT %s, %s, %s, %s, %s, %s
+		FROM %s WHERE %s = $1`,
+		schema.UserPreferences.ReadingMode, schema.UserPreferences.PageFit, schema.UserPreferences.PreloadPages,
+		schema.UserPreferences.HideNSFW, schema.UserPreferences.HideLanguages, schema.UserPreferences.DataSaver,
+		schema.UserPreferences.Table, schema.UserPreferences.UserID,
+	))},
+	{"library", jsonArray(fmt.Sprintf(`
+		SELECT e.%[2]s, c.%[10]s AS comictitle, e.%[3]s, e.%[4]s, e.%[5]s, e.%[6]s, e.%[7]s, e.%[8]s
+		FROM %[1]s e
+		JOIN %[9]s c ON c.%[11]s = e.%[2]s
+		WHERE e.%[12]s = $1
+		ORDER BY e.%[7]s`,
+		schema.LibraryEntry.Table,             // 1
+		schema.LibraryEntry.ComicID,           // 2
+		schema.LibraryEntry.ReadingStatus,     // 3
+		schema.LibraryEntry.Score,             // 4
+		schema.LibraryEntry.LastReadChapterID, // 5
+		schema.LibraryEntry.LastReadAt,        // 6
+		schema.LibraryEntry.CreatedAt,         // 7
+		schema.LibraryEntry.UpdatedAt,         // 8
+		schema.CoreComic.Table,                // 9
+		schema.CoreComic.Title,                // 10
+		schema.CoreComic.ID,                   // 11
+		schema.LibraryEntry.UserID,            // 12
+	))},
+	{"lists", jsonArray(fmt.Sprintf(`
+		SELECT l.%[2]s, l.%[3]s, l.%[4]s, l.%[5]s, l.%[6]s,
+			COALESCE((
+				SELECT json_agg(json_build_object('comicid', i.%[9]s, 'sortorder', i.%[10]s, 'addedat', i.%[11]s) ORDER BY i.%[10]s)
+				FROM %[7]s i WHERE i.%[8]s = l.%[2]s
+			), '[]'::json) AS items
+		FROM %[1]s l
+		WHERE l.%[12]s = $1 AND l.%[13]s IS NULL
+		ORDER BY l.%[5]s`,
+		schema.LibraryCustomList.Table,         // 1
+		schema.LibraryCustomList.ID,            // 2
+		schema.LibraryCustomList.Name,          // 3
+		schema.LibraryCustomList.Visibility,    // 4
+		schema.LibraryCustomList.CreatedAt,     // 5
+		schema.LibraryCustomList.UpdatedAt,     // 6
+		schema.LibraryCustomListItem.Table,     // 7
+		schema.LibraryCustomListItem.ListID,    // 8
+		schema.LibraryCustomListItem.ComicID,   // 9
+		schema.LibraryCustomListItem.SortOrder, // 10
+		schema.LibraryCustomListItem.AddedAt,   // 11
+		schema.LibraryCustomList.UserID,        // 12
+		schema.LibraryCustomList.DeletedAt,     // 13
+	))},
+	{"ratings", jsonArray(fmt.Sprintf(`
+		SELECT %s, %s, %s, %s
+		FROM %s WHERE %s = $1
+		ORDER BY %s`,
+		schema.SocialComicRating.ComicID, schema.SocialComicRating.Score,
+		schema.SocialComicRating.CreatedAt, schema.SocialComicRating.UpdatedAt,
+		schema.SocialComicRating.Table, schema.SocialComicRating.UserID,
+		schema.SocialComicRating.CreatedAt,
+	))},
+	{"comments", jsonArray(fmt.Sprintf(`
+		SELECT %s, %s, %s, %s, %s, %s, %s, %s
+		FROM %s WHERE %s = $1
+		ORDER BY %s`,
+		schema.SocialComment.ID, schema.SocialComment.ComicID, schema.SocialComment.ChapterID,
+		schema.SocialComment.ParentID, schema.SocialComment.Body, schema.SocialComment.IsDeleted,
+		schema.SocialComment.CreatedAt, schema.SocialComment.UpdatedAt,
+		schema.SocialComment.Table, schema.SocialComment.UserID,
+		schema.SocialComment.CreatedAt,
+	))},
+	{"sessions", jsonArray(fmt.Sprintf(`
+		SELECT %s, %s, %s, %s, %s, %s, %s, %s
+		FROM %s WHERE %s = $1
+		ORDER BY %s`,
+		schema.UserSession.ID, schema.UserSession.DeviceName, schema.UserSession.IPAddress,
+		schema.UserSession.UserAgent, schema.UserSession.IsRevoked, schema.UserSession.CreatedAt,
+		schema.UserSession.ExpiresAt, schema.UserSession.RevokedAt,
+		schema.UserSession.Table, schema.UserSession.UserID,
+		schema.UserSession.CreatedAt,
+	))},
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+// # Follow Repository
+
+// PostgresFollowRepository implements [FollowRepository] using pgx.
+type PostgresFollowRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewFollowRepository creates a new Postgres implementation for the follow graph.
+func NewFollowRepository(pool *pgxpool.Pool) *PostgresFollowRepository {
+	return &PostgresFollowRepository{pool: pool}
+}
+
+// viewerFollows is true when the viewer ($2) follows the account aliased "a".
+var viewerFollows = fmt.Sprintf("EXISTS (SELECT 1 FROM %s v WHERE v.%s = $2 AND v.%s = a.%s)",
+	schema.UserFollow.Table, schema.UserFollow.FollowerID, schema.UserFollow.FollowingID, schema.UserAccount.ID)
+
+/*
+FindProfile loads a live account and counts the live accounts on both sides of it.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - viewerID: string
+
+Returns:
+  - *PublicProfile: Hydrated profile
+  - error: apperr.NotFound or execution failures
+*/
+func (repository *PostgresFollowRepository) FindProfile(context context.Context, userID, viewerID string) (*PublicProfile, error) {
+	countQuery := func(self, other string) string {
+		return fmt.Sprintf(`(SELECT COUNT(*) FROM %[1]s f JOIN %[2]s o ON o.%[3]s = f.%[4]s
+			WHERE f.%[5]s = a.%[3]s AND o.%[6]s IS NULL)`,
+			schema.UserFollow.Table, schema.UserAccount.Table, schema.UserAccount.ID,
+			other, self, schema.UserAccount.DeletedAt)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT a.%s, a.%s, a.%s, a.%s, a.%s, a.%s, a.%s, a.%s, %s, %s, %s
+		FROM %s a
+		WHERE a.%s = $1 AND a.%s IS NULL`,
+		schema.UserAccount.ID, schema.UserAccount.Username, schema.UserAccount.DisplayName,
+		schema.UserAccount.AvatarURL, schema.UserAccount.Bio, schema.UserAccount.Website,
+		schema.UserAccount.IsVerified, schema.UserAccount.CreatedAt,
+		countQuery(schema.UserFollow.FollowingID, schema.UserFollow.FollowerID),
+		countQuery(schema.UserFollow.FollowerID, schema.UserFollow.FollowingID),
+		viewerFollows,
+		schema.UserAccount.Table, schema.UserAccount.ID, schema.UserAccount.DeletedAt,
+	)
+
+	profile := &PublicProfile{}
+	var isFollowing bool
+	err := repository.pool.QueryRow(context, query, userID, nullableID(viewerID)).Scan(
+		&profile.ID,
+		&profile.Username,
+		&profile.DisplayName,
+		&profile.AvatarURL,
+		&profile.Bio,
+		&profile.Website,
+		&profile.IsVerified,
+		&profile.CreatedAt,
+		&profile.FollowerCount,
+		&profile.FollowingCount,
+		&isFollowing,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("User")
+		}
+		return nil, fmt.Errorf("postgres_follow_repo_find_profile_failed: %w", err)
+	}
+
+	if viewerID != "" {
+		profile.IsFollowing = &isFollowing
+	}
+	return profile, nil
+}
+
+/*
+Follow inserts an edge only when the target is live and not suspended.
+
+Description: The INSERT selects the target from users.account, so a missing
+target and an existing edge both return no row; a second lookup tells them
+apart for the error.
+
+Parameters:
+  - context: context.Context
+  - followerID: string
+  - followingID: string
+
+Returns:
+  - *Follow: The created edge
+  - error: apperr.NotFound, apperr.Conflict or execution failures
+*/
+func (repository *PostgresFollowRepository) Follow(context context.Context, followerID, followingID string) (*Follow, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s)
+		SELECT $1, a.%[6]s, NOW()
+		FROM %[5]s a
+		WHERE a.%[6]s = $2 AND a.%[7]s IS NULL AND (a.%[8]s OR a.%[9]s <= NOW())
+		ON CONFLICT (%[2]s, %[3]s) DO NOTHING
+		RETURNING %[4]s`,
+		schema.UserFollow.Table,           // 1
+		schema.UserFollow.FollowerID,      // 2
+		schema.UserFollow.FollowingID,     // 3
+		schema.UserFollow.CreatedAt,       // 4
+		schema.UserAccount.Table,          // 5
+		schema.UserAccount.ID,             // 6
+		schema.UserAccount.DeletedAt,      // 7
+		schema.UserAccount.IsActive,       // 8
+		schema.UserAccount.SuspendedUntil, // 9
+	)
+
+	follow := &Follow{FollowerID: followerID, FollowingID: followingID}
+	err := repository.pool.QueryRow(context, query, followerID, followingID).Scan(&follow.CreatedAt)
+	if err == nil {
+		return follow, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("postgres_follow_repo_insert_failed: %w", err)
+	}
+
+	existsQuery := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE %s = $1 AND %s = $2)",
+		schema.UserFollow.Table, schema.UserFollow.FollowerID, schema.UserFollow.FollowingID)
+
+	var exists bool
+	if err := repository.pool.QueryRow(context, existsQuery, followerID, followingID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("postgres_follow_repo_exists_failed: %w", err)
+	}
+	if exists {
+		return nil, apperr.Conflict("Already following this user")
+	}
+	return nil, apperr.NotFound("User")
+}
+
+// Unfollow deletes an edge.
+func (repository *PostgresFollowRepository) Unfollow(context context.Context, followerID, followingID string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE %s = $1 AND %s = $2",
+		schema.UserFollow.Table, schema.UserFollow.FollowerID, schema.UserFollow.FollowingID)
+
+	tag, err := repository.pool.Exec(context, query, followerID, followingID)
+	if err != nil {
+		return fmt.Errorf("postgres_follow_repo_delete_failed: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.NotFound("Follow")
+	}
+	return nil
+}
+
+/*
+List pages through one side of the graph with a keyset on (edge time, account ID).
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - list: FollowList
+  - viewerID: string
+  - before: *pagination.Cursor
+  - limit: int
+
+Returns:
+  - []*FollowUser: Up to limit accounts
+  - error: Execution failures
+*/
+func (repository *PostgresFollowRepository) List(context context.Context, userID string, list FollowList, viewerID string, before *pagination.Cursor, limit int) ([]*FollowUser, error) {
+	self, other := schema.UserFollow.FollowingID, schema.UserFollow.FollowerID
+	if list == FollowingList {
+		self, other = other, self
+	}
+
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(fmt.Sprintf(`
+		SELECT a.%s, a.%s, a.%s, a.%s, a.%s, f.%s, %s
+		FROM %s f
+		JOIN %s a ON a.%s = f.%s
+		WHERE f.%s = $1 AND a.%s IS NULL`,
+		schema.UserAccount.ID, schema.UserAccount.Username, schema.UserAccount.DisplayName,
+		schema.UserAccount.AvatarURL, schema.UserAccount.IsVerified, schema.UserFollow.CreatedAt,
+		viewerFollows,
+		schema.UserFollow.Table,
+		schema.UserAccount.Table, schema.UserAccount.ID, other,
+		self, schema.UserAccount.DeletedAt,
+	))
+
+	args := []any{userID, nullableID(viewerID)}
+	argID := 3
+
+	if before != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND (f.%s, a.%s) < ($%d, $%d)",
+			schema.UserFollow.CreatedAt, schema.UserAccount.ID, argID, argID+1))
+		args = append(args, before.At, before.ID)
+		argID += 2
+	}
+
+	queryBuilder.WriteString(fmt.Sprintf(" ORDER BY f.%s DESC, a.%s DESC LIMIT $%d",
+		schema.UserFollow.CreatedAt, schema.UserAccount.ID, argID))
+	args = append(args, limit)
+
+	rows, err := repository.pool.Query(context, queryBuilder.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres_follow_repo_list_failed: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*FollowUser, 0, limit)
+	for rows.Next() {
+		user := &FollowUser{}
+		var isFollowing bool
+		if err := rows.Scan(
+			&user.ID,
+			&user.Username,
+			&user.DisplayName,
+			&user.AvatarURL,
+			&user.IsVerified,
+			&user.FollowedAt,
+			&isFollowing,
+		); err != nil {
+			return nil, fmt.Errorf("postgres_follow_repo_scan_failed: %w", err)
+		}
+
+		if viewerID != "" {
+			user.IsFollowing = &isFollowing
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres_follow_repo_rows_failed: %w", err)
+	}
+
+	return users, nil
+}
+
+// FindPrivacy reads the list visibility flags of a live account.
+func (repository *PostgresFollowRepository) FindPrivacy(context context.Context, userID string) (*PrivacySettings, error) {
+	query := fmt.Sprintf("SELECT %s, %s FROM %s WHERE %s = $1 AND %s IS NULL",
+		schema.UserAccount.HideFollowers, schema.UserAccount.HideFollowing,
+		schema.UserAccount.Table, schema.UserAccount.ID, schema.UserAccount.DeletedAt)
+
+	settings := &PrivacySettings{}
+	if err := repository.pool.QueryRow(context, query, userID).Scan(&settings.HideFollowers, &settings.HideFollowing); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("User")
+		}
+		return nil, fmt.Errorf("postgres_follow_repo_find_privacy_failed: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdatePrivacy overwrites the list visibility flags of a live account.
+func (repository *PostgresFollowRepository) UpdatePrivacy(context context.Context, userID string, settings PrivacySettings) error {
+	query := fmt.Sprintf("UPDATE %s SET %s = $2, %s = $3, %s = NOW() WHERE %s = $1 AND %s IS NULL",
+		schema.UserAccount.Table,
+		schema.UserAccount.HideFollowers, schema.UserAccount.HideFollowing, schema.UserAccount.UpdatedAt,
+		schema.UserAccount.ID, schema.UserAccount.DeletedAt)
+
+	tag, err := repository.pool.Exec(context, query, userID, settings.HideFollowers, settings.HideFollowing)
+	if err != nil {
+		return fmt.Errorf("postgres_follow_repo_update_privacy_failed: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.NotFound("User")
+	}
+	return nil
+}
+
+// nullableID sends an empty ID as NULL, which matches no row.
+func nullableID(id string) any {
+	if id == "" {
+		return nil
+	}
+	return id
+}
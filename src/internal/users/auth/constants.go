@@ -33,3 +33,111 @@ const (
 	// VerificationTokenLength is the byte length of the random verification token.
 	VerificationTokenLength = 32
 )
+
+// # Email Links
+
+const (
+	// VerifyEmailPath is the web app page that submits a verification token.
+	VerifyEmailPath = "/auth/verify-email"
+
+	// ResetPasswordPath is the web app page that submits a password reset token.
+	ResetPasswordPath = "/auth/reset-password"
+)
+
+// # Two-Factor Authentication
+
+const (
+	// MFAIssuer labels the account in authenticator apps.
+	MFAIssuer = "Yomira"
+
+	// MFAChallengeTTL is how long a password-verified login waits for its second factor.
+	MFAChallengeTTL = 5 * time.Minute
+
+	// MFAChallengeTokenLength is the byte length of the random challenge token.
+	MFAChallengeTokenLength = 32
+
+	// MFAChallengeMaxAttempts caps code guesses per challenge before a new login is required.
+	MFAChallengeMaxAttempts = 5
+
+	// MFATOTPSkew accepts codes from one 30-second step before or after the server clock.
+	MFATOTPSkew = 1
+
+	// MFARecoveryCodeCount is the number of single-use recovery codes issued at once.
+	MFARecoveryCodeCount = 10
+
+	// MFARecoveryCodeLength is the byte length of a recovery code before encoding.
+	MFARecoveryCodeLength = 5
+)
+
+// # Social Login
+
+const (
+	// OAuthStateTTL bounds the time a user may spend on the provider's consent page.
+	OAuthStateTTL = 10 * time.Minute
+
+	// OAuthStateLength is the byte length of the random state and nonce values.
+	OAuthStateLength = 32
+
+	// OAuthUsernameMaxLength caps usernames derived from a provider profile.
+	OAuthUsernameMaxLength = 20
+
+	// OAuthUsernameAttempts is how many suffixed usernames are tried before giving up.
+	OAuthUsernameAttempts = 5
+)
+
+// # Personal Access Tokens
+
+const (
+	// PersonalTokenLength is the byte length of the random token secret.
+	PersonalTokenLength = 32
+
+	// PersonalTokenPrefixLength is how much of the token is kept in clear to identify it in listings.
+	PersonalTokenPrefixLength = 12
+
+	// PersonalTokenDefaultExpiryDays applies when a token is created without an explicit expiry.
+	PersonalTokenDefaultExpiryDays = 90
+
+	// PersonalTokenMaxExpiryDays caps the lifetime of a token; 0 requests a token that never expires.
+	PersonalTokenMaxExpiryDays = 365
+
+	// PersonalTokenMaxPerUser caps the active tokens of one account.
+	PersonalTokenMaxPerUser = 20
+
+	// PersonalTokenNameMaxLength caps the label of a token.
+	PersonalTokenNameMaxLength = 64
+
+	// PersonalTokenTouchInterval throttles last-used writes for busy tokens.
+	PersonalTokenTouchInterval = 5 * time.Minute
+)
+
+// # Token Revocation
+
+const (
+	// RevocationCacheTTL is how long a replica trusts its last look at the denylist.
+	// Revocations made on another replica take at most this long to apply here.
+	RevocationCacheTTL = 5 * time.Second
+
+	// RevocationCacheSize caps the tokens remembered per replica.
+	RevocationCacheSize = 10000
+)
+
+// # Background Jobs
+
+const (
+	// SessionCleanupJobKey identifies the stale session purge in the batch registry.
+	SessionCleanupJobKey = "sessions.cleanup"
+
+	// SessionCleanupCron runs the purge every 6 hours by default.
+	SessionCleanupCron = "0 */6 * * *"
+
+	// SessionCleanupTimeout bounds a single purge run.
+	SessionCleanupTimeout = 30 * time.Minute
+
+	// SessionRetention keeps dead sessions visible for incident review before deletion.
+	// Rotated sessions are kept until they expire instead, since refresh token reuse
+	// detection needs them for the whole [RefreshTokenTTL].
+	SessionRetention = 7 * 24 * time.Hour
+
+	// SessionCleanupBatchSize caps the rows removed by a single DELETE.
+	SessionCleanupBatchSize = 1000
+)
@@ -41,12 +41,14 @@ import (
 // This handler manages everything related to the user lifecycle entry points
 // (Registration, Login, Password Reset callbacks).
 type Handler struct {
-	authService *Service
+	authService  *Service
+	oauthService *OAuthService
+	tokenService *PersonalTokenService
 }
 
-// NewHandler constructs a new [Handler] with its service dependency.
-func NewHandler(service *Service) *Handler {
-	return &Handler{authService: service}
+// NewHandler constructs a new [Handler] with its service dependencies.
+func NewHandler(service *Service, oauthService *OAuthService, tokenService *PersonalTokenService) *Handler {
+	return &Handler{authService: service, oauthService: oauthService, tokenService: tokenService}
 }
 
 // Routes returns a [chi.Router] configured with authentication-specific routes.
@@ -64,12 +66,35 @@ func (handler *Handler) Routes() chi.Router {
 	router.Post("/verify-email", handler.verifyEmail)
 	router.Post("/forgot-password", handler.forgotPassword)
 	router.Post("/reset-password", handler.resetPassword)
+	router.Post("/mfa/verify", handler.verifyMFA)
+	router.Post("/mfa/enroll", handler.enrollMFAChallenge)
 
-	// Protected endpoints
+	// Social login (action=link resolves the caller from the optional bearer token)
+	router.Get("/oauth/{provider}", handler.startOAuth)
+	router.Get("/oauth/{provider}/callback", handler.oauthCallback)
+
+	// Protected endpoints (interactive sessions only: a personal access token cannot manage credentials)
 	router.Group(func(r chi.Router) {
-		r.Use(middleware.RequireAuth)
+		r.Use(middleware.RequireSession)
 		r.Post("/logout", handler.logout)
 		r.Post("/change-password", handler.changePassword)
+
+		// Two-factor management
+		r.Get("/mfa", handler.getMFAStatus)
+		r.Post("/mfa/setup", handler.setupMFA)
+		r.Post("/mfa/confirm", handler.confirmMFA)
+		r.Post("/mfa/disable", handler.disableMFA)
+		r.Post("/mfa/recovery-codes", handler.regenerateRecoveryCodes)
+
+		// Linked social login providers
+		r.Get("/oauth/linked", handler.listLinkedProviders)
+		r.Delete("/oauth/{provider}", handler.unlinkProvider)
+
+		// Personal access tokens
+		r.Get("/tokens", handler.listTokens)
+		r.Post("/tokens", handler.createToken)
+		r.Get("/tokens/scopes", handler.listTokenScopes)
+		r.Delete("/tokens/{id}", handler.revokeToken)
 	})
 
 	return router
@@ -165,14 +190,17 @@ Login authenticates a user and establishes a session.
 POST /api/v1/auth/login
 
 Description: Verifies credentials, generates JWT access tokens, and injects
-a secure refresh token cookie into the response.
+a secure refresh token cookie into the response. Accounts protected by
+two-factor receive an MFA challenge token instead, to be completed at
+/auth/mfa/verify.
 
 Request:
   - Body: loginRequest (Login, Password)
 
 Response:
-  - 200: Session: Access token and User profile
-  - 401: ErrUnauthorized: Invalid credentials or account locked
+  - 200: Session: Access token and User profile, or mfa_required with mfa_token
+  - 401: ErrUnauthorized: Invalid credentials
+  - 429: ErrRateLimited: Too many failed attempts (Retry-After header set)
 */
 func (handler *Handler) login(writer http.ResponseWriter, request *http.Request) {
 	var input loginRequest
@@ -202,6 +230,37 @@ func (handler *Handler) login(writer http.ResponseWriter, request *http.Request)
 		return
 	}
 
+	// Password accepted, second factor pending
+	if session.MFAToken != "" {
+		respond.OK(writer, map[string]any{
+			FieldMFARequired: true,
+			FieldMFAToken:    session.MFAToken,
+			FieldMFAPurpose:  session.MFAPurpose,
+			FieldExpiresIn:   MFAChallengeTTL / time.Second,
+		})
+		return
+	}
+
+	completeLogin(writer, session)
+}
+
+// completeLogin sets the refresh token cookie and writes the login payload.
+func completeLogin(writer http.ResponseWriter, session *LoginSession) {
+	setRefreshCookie(writer, session)
+
+	payload := map[string]any{
+		FieldAccessToken: session.AccessToken,
+		FieldUser:        session.User,
+	}
+	if len(session.RecoveryCodes) > 0 {
+		payload[FieldRecoveryCodes] = session.RecoveryCodes
+	}
+
+	respond.OK(writer, payload)
+}
+
+// setRefreshCookie stores the rotated refresh token in its HttpOnly cookie.
+func setRefreshCookie(writer http.ResponseWriter, session *LoginSession) {
 	http.SetCookie(writer, &http.Cookie{
 		Name:     constants.RefreshTokenCookieName,
 		Value:    session.RefreshToken,
@@ -211,11 +270,6 @@ func (handler *Handler) login(writer http.ResponseWriter, request *http.Request)
 		HttpOnly: true,
 		SameSite: http.SameSiteStrictMode,
 	})
-
-	respond.OK(writer, map[string]any{
-		"access_token": session.AccessToken,
-		"user":         session.User,
-	})
 }
 
 /*
@@ -223,19 +277,20 @@ Logout terminates the current user session.
 
 POST /api/v1/auth/logout
 
-Description: Invalidates the refresh token (if present) and clears the
-security cookies from the client.
+Description: Invalidates the refresh token (if present) and the access token
+of the request, and clears the security cookies from the client.
 
 Response:
   - 204: No Content: Session terminated
 */
 func (handler *Handler) logout(writer http.ResponseWriter, request *http.Request) {
-	cookie, err := request.Cookie(constants.RefreshTokenCookieName)
-
-	if err == nil && cookie != nil && cookie.Value != "" {
-		_ = handler.authService.Logout(request.Context(), cookie.Value)
+	refreshToken := ""
+	if cookie, err := request.Cookie(constants.RefreshTokenCookieName); err == nil && cookie != nil {
+		refreshToken = cookie.Value
 	}
 
+	_ = handler.authService.Logout(request.Context(), refreshToken, requestutil.Claims(request))
+
 	http.SetCookie(writer, &http.Cookie{
 		Name:     constants.RefreshTokenCookieName,
 		Value:    "",
@@ -280,15 +335,7 @@ func (handler *Handler) refresh(writer http.ResponseWriter, request *http.Reques
 		return
 	}
 
-	http.SetCookie(writer, &http.Cookie{
-		Name:     constants.RefreshTokenCookieName,
-		Value:    session.RefreshToken,
-		Path:     constants.RefreshTokenCookiePath,
-		Expires:  session.RefreshTokenExpiresAt,
-		Secure:   true,
-		HttpOnly: true,
-		SameSite: http.SameSiteStrictMode,
-	})
+	setRefreshCookie(writer, session)
 
 	respond.OK(writer, map[string]any{
 		FieldAccessToken: session.AccessToken,
@@ -298,17 +345,10 @@ func (handler *Handler) refresh(writer http.ResponseWriter, request *http.Reques
 }
 
 // getClientIP tries to extract the real IP address of a user over proxy environments.
+//
+// It shares [middleware.RealIP] so login throttling and rate limiting key on the same address.
 func getClientIP(request *http.Request) string {
-
-	ip := request.Header.Get("X-Real-IP")
-	if ip == "" {
-		ip = request.Header.Get("X-Forwarded-For")
-	}
-
-	if ip == "" {
-		ip = request.RemoteAddr
-	}
-	return ip
+	return middleware.RealIP(request)
 }
 
 /*
@@ -378,8 +418,7 @@ func (handler *Handler) forgotPassword(writer http.ResponseWriter, request *http
 		return
 	}
 
-	_, err := handler.authService.RequestPasswordReset(request.Context(), input.Email)
-	if err != nil {
+	if err := handler.authService.RequestPasswordReset(request.Context(), input.Email); err != nil {
 		respond.Error(writer, request, err)
 		return
 	}
@@ -466,9 +505,9 @@ func (handler *Handler) changePassword(writer http.ResponseWriter, request *http
 		return
 	}
 
+	// The current password is checked by the service; social-login-only accounts have none
 	v := &validate.Validator{}
-	v.Required(FieldCurrentPassword, input.CurrentPassword).
-		Required(FieldNewPassword, input.NewPassword).
+	v.Required(FieldNewPassword, input.NewPassword).
 		MinLen(FieldNewPassword, input.NewPassword, 8)
 
 	if err := v.Err(); err != nil {
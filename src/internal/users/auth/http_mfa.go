@@ -0,0 +1,286 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"net/http"
+
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+)
+
+// # Request Payloads
+
+type mfaVerifyRequest struct {
+	MFAToken string `json:"mfa_token"`
+	Code     string `json:"code"`
+}
+
+type mfaEnrollRequest struct {
+	MFAToken string `json:"mfa_token"`
+}
+
+type mfaCodeRequest struct {
+	Code string `json:"code"`
+}
+
+type mfaDisableRequest struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+// # Challenge Endpoints
+
+/*
+VerifyMFA completes a login paused by an MFA challenge.
+
+POST /api/v1/auth/mfa/verify
+
+Description: Accepts a TOTP code or a recovery code. For an enrolment
+challenge the code confirms the new authenticator and the response also
+carries the recovery codes, shown once.
+
+Request:
+  - Body: mfaVerifyRequest (MFAToken, Code)
+
+Response:
+  - 200: Session: Access token and User profile
+  - 401: ErrUnauthorized: Invalid code, expired or exhausted challenge
+  - 429: ErrRateLimited: Too many failed attempts for this account or IP
+*/
+func (handler *Handler) verifyMFA(writer http.ResponseWriter, request *http.Request) {
+	var input mfaVerifyRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, validate.ErrInvalidJSON)
+		return
+	}
+
+	validator := &validate.Validator{}
+	validator.Required(FieldMFAToken, input.MFAToken)
+	validator.Required(FieldCode, input.Code)
+	if err := validator.Err(); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	session, err := handler.authService.VerifyMFA(request.Context(), input.MFAToken, input.Code, getClientIP(request))
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	completeLogin(writer, session)
+}
+
+/*
+EnrollMFAChallenge starts the mandatory enrolment of a privileged account.
+
+POST /api/v1/auth/mfa/enroll
+
+Description: Only valid for challenges with purpose 'enroll'. The client
+shows the secret as a QR code, then submits the first code to /mfa/verify.
+
+Request:
+  - Body: mfaEnrollRequest (MFAToken)
+
+Response:
+  - 200: MFAEnrollment: Secret and otpauth URI
+  - 401: ErrUnauthorized: Invalid or expired challenge
+*/
+func (handler *Handler) enrollMFAChallenge(writer http.ResponseWriter, request *http.Request) {
+	var input mfaEnrollRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, validate.ErrInvalidJSON)
+		return
+	}
+
+	if input.MFAToken == "" {
+		respond.Error(writer, request, validate.RequiredError(FieldMFAToken, "is required"))
+		return
+	}
+
+	enrollment, err := handler.authService.BeginChallengeEnrollment(request.Context(), input.MFAToken)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, enrollment)
+}
+
+// # Management Endpoints
+
+/*
+GetMFAStatus returns the caller's two-factor state.
+
+GET /api/v1/auth/mfa
+
+Response:
+  - 200: MFAStatus: Current state
+  - 401: ErrUnauthorized: Missing authentication
+*/
+func (handler *Handler) getMFAStatus(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	status, err := handler.authService.GetMFAStatus(request.Context(), userID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, status)
+}
+
+/*
+SetupMFA starts enrolment for the caller.
+
+POST /api/v1/auth/mfa/setup
+
+Response:
+  - 200: MFAEnrollment: Secret and otpauth URI
+  - 409: ErrConflict: Two-factor already enabled
+*/
+func (handler *Handler) setupMFA(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	enrollment, err := handler.authService.BeginMFAEnrollment(request.Context(), userID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, enrollment)
+}
+
+/*
+ConfirmMFA enables two-factor with the first code from the authenticator app.
+
+POST /api/v1/auth/mfa/confirm
+
+Request:
+  - Body: mfaCodeRequest (Code)
+
+Response:
+  - 200: Recovery codes, shown once
+  - 401: ErrUnauthorized: Invalid code
+  - 422: ErrUnprocessable: No pending enrolment
+*/
+func (handler *Handler) confirmMFA(writer http.ResponseWriter, request *http.Request) {
+	userID, input, ok := handler.decodeCode(writer, request)
+	if !ok {
+		return
+	}
+
+	codes, err := handler.authService.ConfirmMFAEnrollment(request.Context(), userID, input.Code)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, map[string]any{FieldRecoveryCodes: codes})
+}
+
+/*
+DisableMFA turns two-factor off.
+
+POST /api/v1/auth/mfa/disable
+
+Request:
+  - Body: mfaDisableRequest (Password, Code)
+
+Response:
+  - 204: No Content: Two-factor disabled
+  - 401: ErrUnauthorized: Wrong password or code
+  - 403: ErrForbidden: Mandatory for the caller's role
+  - 429: ErrRateLimited: Too many failed checks for this account
+*/
+func (handler *Handler) disableMFA(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input mfaDisableRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, validate.ErrInvalidJSON)
+		return
+	}
+
+	// The password is checked by the service; social-login-only accounts have none
+	validator := &validate.Validator{}
+	validator.Required(FieldCode, input.Code)
+	if err := validator.Err(); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.authService.DisableMFA(request.Context(), userID, input.Password, input.Code); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
+
+/*
+RegenerateRecoveryCodes replaces every recovery code.
+
+POST /api/v1/auth/mfa/recovery-codes
+
+Request:
+  - Body: mfaCodeRequest (Code)
+
+Response:
+  - 200: New recovery codes, shown once
+  - 401: ErrUnauthorized: Invalid code
+  - 422: ErrUnprocessable: Two-factor not enabled
+  - 429: ErrRateLimited: Too many invalid codes for this account
+*/
+func (handler *Handler) regenerateRecoveryCodes(writer http.ResponseWriter, request *http.Request) {
+	userID, input, ok := handler.decodeCode(writer, request)
+	if !ok {
+		return
+	}
+
+	codes, err := handler.authService.RegenerateRecoveryCodes(request.Context(), userID, input.Code)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, map[string]any{FieldRecoveryCodes: codes})
+}
+
+// decodeCode resolves the caller and a required code body, writing errors itself.
+func (handler *Handler) decodeCode(writer http.ResponseWriter, request *http.Request) (string, mfaCodeRequest, bool) {
+	var input mfaCodeRequest
+
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return "", input, false
+	}
+
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, validate.ErrInvalidJSON)
+		return "", input, false
+	}
+
+	if input.Code == "" {
+		respond.Error(writer, request, validate.RequiredError(FieldCode, "is required"))
+		return "", input, false
+	}
+
+	return userID, input, true
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/ctxutil"
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+)
+
+// Fragment keys read by the web app after a social login callback.
+const (
+	fragmentOAuthError  = "oauth_error"
+	fragmentOAuthLinked = "oauth_linked"
+)
+
+// # Social Login Endpoints
+
+/*
+StartOAuth redirects the browser to the provider consent page.
+
+GET /api/v1/auth/oauth/{provider}
+
+Description: With action=link the caller must be authenticated; since a
+top-level navigation cannot carry the bearer token, clients sending
+'Accept: application/json' receive the URL in the body and navigate to it.
+
+Request:
+  - provider: string (google, discord, github or the configured OIDC name)
+  - action: string (login | link, default login)
+  - redirect: string (Web app path to land on, default "/")
+
+Response:
+  - 302: Redirect to the provider
+  - 200: { url }: When JSON is requested
+  - 400: Validation: Invalid action or redirect path
+  - 401: ErrUnauthorized: action=link without a session (personal access tokens do not count)
+  - 404: ErrNotFound: Provider not configured
+*/
+func (handler *Handler) startOAuth(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+
+	input := OAuthStartInput{
+		Provider:     requestutil.Param(request, FieldProvider),
+		Action:       OAuthAction(query.Get(FieldAction)),
+		RedirectPath: query.Get(FieldRedirect),
+	}
+	if claims := ctxutil.GetAuthUser(request.Context()); claims != nil && !claims.IsPersonalToken() {
+		input.UserID = claims.UserID
+	}
+
+	authURL, err := handler.oauthService.StartOAuth(request.Context(), input)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if strings.Contains(request.Header.Get("Accept"), "application/json") {
+		respond.OK(writer, map[string]string{FieldURL: authURL})
+		return
+	}
+
+	http.Redirect(writer, request, authURL, http.StatusFound)
+}
+
+/*
+OAuthCallback completes the flow when the provider redirects back.
+
+GET /api/v1/auth/oauth/{provider}/callback
+
+Description: Always answers with a redirect to the web app. The outcome is
+passed in the URL fragment:
+  - Signed in: refresh token cookie set, no fragment (the app calls /auth/refresh)
+  - MFA pending: #mfa_token=...&mfa_purpose=...
+  - Linked: #oauth_linked={provider}
+  - Failed: #oauth_error={CODE}
+
+Request:
+  - provider: string
+  - state: string
+  - code: string
+  - error: string (Set by the provider when consent is denied)
+
+Response:
+  - 302: Redirect to the web app
+*/
+func (handler *Handler) oauthCallback(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+	provider := requestutil.Param(request, FieldProvider)
+
+	result, err := handler.oauthService.CompleteOAuth(request.Context(), OAuthCallbackInput{
+		Provider:      provider,
+		State:         query.Get("state"),
+		Code:          query.Get("code"),
+		ProviderError: query.Get("error"),
+		UserAgent:     request.UserAgent(),
+		IPAddress:     getClientIP(request),
+	})
+
+	redirectPath := ""
+	if result != nil {
+		redirectPath = result.RedirectPath
+	}
+
+	fragment := url.Values{}
+	switch {
+	case err != nil:
+		appErr := apperr.As(err)
+		if appErr == nil || appErr.HTTPStatus >= http.StatusInternalServerError {
+			ctxutil.GetLogger(request.Context()).ErrorContext(request.Context(), "auth_oauth_callback_failed",
+				slog.String("provider", provider),
+				slog.Any("error", err),
+			)
+		}
+		code := "INTERNAL_ERROR"
+		if appErr != nil {
+			code = appErr.Code
+		}
+		fragment.Set(fragmentOAuthError, code)
+
+	case result.Action == OAuthActionLink:
+		fragment.Set(fragmentOAuthLinked, provider)
+
+	case result.Session.MFAToken != "":
+		fragment.Set(FieldMFAToken, result.Session.MFAToken)
+		fragment.Set(FieldMFAPurpose, string(result.Session.MFAPurpose))
+
+	default:
+		setRefreshCookie(writer, result.Session)
+	}
+
+	http.Redirect(writer, request, handler.oauthService.LandingURL(redirectPath, fragment), http.StatusFound)
+}
+
+/*
+ListLinkedProviders lists the social login providers of the caller.
+
+GET /api/v1/auth/oauth/linked
+
+Response:
+  - 200: []OAuthLink: Provider, email and link time (never the access token)
+  - 401: ErrUnauthorized: Authentication required
+*/
+func (handler *Handler) listLinkedProviders(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	links, err := handler.oauthService.ListLinkedProviders(request.Context(), userID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, links)
+}
+
+/*
+UnlinkProvider removes a social login provider from the caller's account.
+
+DELETE /api/v1/auth/oauth/{provider}
+
+Response:
+  - 204: No Content: Provider unlinked
+  - 401: ErrUnauthorized: Authentication required
+  - 404: ErrNotFound: Provider not linked to this account
+  - 409: ErrConflict: Only sign-in method left; set a password first
+*/
+func (handler *Handler) unlinkProvider(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.oauthService.UnlinkProvider(request.Context(), userID, requestutil.Param(request, FieldProvider)); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"net/http"
+
+	requestutil "github.com/taibuivan/yomira/internal/platform/request"
+	"github.com/taibuivan/yomira/internal/platform/respond"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+)
+
+// # Request Payloads
+
+type createTokenRequest struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays *int     `json:"expires_in_days"`
+}
+
+// # Personal Access Token Endpoints
+
+/*
+ListTokenScopes lists the scopes a personal access token may be granted.
+
+GET /api/v1/auth/tokens/scopes
+
+Response:
+  - 200: []string: Known scopes
+*/
+func (handler *Handler) listTokenScopes(writer http.ResponseWriter, _ *http.Request) {
+	respond.OK(writer, sec.AllScopes())
+}
+
+/*
+ListTokens lists the caller's personal access tokens.
+
+GET /api/v1/auth/tokens
+
+Response:
+  - 200: []PersonalAccessToken: Unrevoked tokens, newest first (never the secret)
+  - 401: ErrUnauthorized: Authentication required
+  - 403: ErrForbidden: Called with a personal access token
+*/
+func (handler *Handler) listTokens(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	tokens, err := handler.tokenService.ListTokens(request.Context(), userID)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.OK(writer, tokens)
+}
+
+/*
+CreateToken issues a personal access token.
+
+POST /api/v1/auth/tokens
+
+Description: The secret is returned in "token" once and cannot be retrieved
+again. Send it as "Authorization: Bearer ymr_pat_...".
+
+Request:
+  - Body: createTokenRequest (Name, Scopes, ExpiresInDays: 0-365, default 90, 0 = never)
+
+Response:
+  - 201: CreatedPersonalToken: Token metadata and secret
+  - 400: Validation: Missing name, unknown scope or invalid expiry
+  - 403: ErrForbidden: Called with a personal access token
+  - 409: ErrConflict: Too many active tokens
+*/
+func (handler *Handler) createToken(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	var input createTokenRequest
+	if err := requestutil.DecodeJSON(request, &input); err != nil {
+		respond.Error(writer, request, validate.ErrInvalidJSON)
+		return
+	}
+
+	created, err := handler.tokenService.CreateToken(request.Context(), userID, CreatePersonalTokenInput{
+		Name:          input.Name,
+		Scopes:        input.Scopes,
+		ExpiresInDays: input.ExpiresInDays,
+	})
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.Created(writer, created)
+}
+
+/*
+RevokeToken revokes one of the caller's personal access tokens.
+
+DELETE /api/v1/auth/tokens/{id}
+
+Response:
+  - 204: No Content: Token revoked, effective immediately
+  - 403: ErrForbidden: Called with a personal access token
+  - 404: ErrNotFound: Unknown or already revoked token
+*/
+func (handler *Handler) revokeToken(writer http.ResponseWriter, request *http.Request) {
+	userID, err := requestutil.RequiredUserID(request)
+	if err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	if err := handler.tokenService.RevokeToken(request.Context(), userID, requestutil.ID(request, "id")); err != nil {
+		respond.Error(writer, request, err)
+		return
+	}
+
+	respond.NoContent(writer)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/system/batch"
+)
+
+/*
+SessionCleanupJob purges refresh-token sessions that can never be used again.
+
+Description: Logout and session revocation only mark rows as revoked, and
+expired sessions are simply ignored by the refresh flow. Both stay in
+users.session for [SessionRetention] so recent activity remains visible,
+then this job deletes them in batches of [SessionCleanupBatchSize].
+Rotated sessions stay until their own expiry plus the retention window, so
+reuse detection keeps working for the full life of a refresh token.
+*/
+type SessionCleanupJob struct {
+	sessionRepo SessionRepository
+	logger      *slog.Logger
+}
+
+// NewSessionCleanupJob constructs a new [SessionCleanupJob].
+func NewSessionCleanupJob(sessionRepo SessionRepository, logger *slog.Logger) *SessionCleanupJob {
+	return &SessionCleanupJob{
+		sessionRepo: sessionRepo,
+		logger:      logger,
+	}
+}
+
+// Definition describes the job for the batch scheduler.
+func (job *SessionCleanupJob) Definition() batch.Job {
+	return batch.Job{
+		Key:         SessionCleanupJobKey,
+		Description: "Delete expired and revoked sessions past the retention window",
+		Cron:        SessionCleanupCron,
+		Timeout:     SessionCleanupTimeout,
+		Handler: func(context context.Context, _ batch.Execution) (batch.Result, error) {
+			deleted, err := job.Purge(context, time.Now().UTC().Add(-SessionRetention))
+			return batch.Result{
+				RowsAffected: int64(deleted),
+				Meta:         map[string]any{"sessions_deleted": deleted},
+			}, err
+		},
+	}
+}
+
+/*
+Purge deletes every dead session older than the cutoff.
+
+Parameters:
+  - context: context.Context
+  - cutoff: time.Time (End of the retention window)
+
+Returns:
+  - int: Number of deleted sessions, including batches before a failure
+  - error: Repository level errors or context cancellation
+*/
+func (job *SessionCleanupJob) Purge(context context.Context, cutoff time.Time) (int, error) {
+	deleted := 0
+	for {
+		affected, err := job.sessionRepo.DeleteStale(context, cutoff, SessionCleanupBatchSize)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += affected
+
+		if affected < SessionCleanupBatchSize {
+			break
+		}
+	}
+
+	job.logger.Info("auth_session_cleanup_finished",
+		slog.Int("sessions_deleted", deleted),
+		slog.Time("cutoff", cutoff),
+	)
+
+	return deleted, nil
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// # Plain OAuth2 Providers
+
+// Endpoint holds the fixed URLs of a provider without discovery.
+type Endpoint struct {
+	AuthURL  string
+	TokenURL string
+}
+
+// profileFunc resolves the account behind an access token.
+type profileFunc func(context context.Context, client *http.Client, accessToken string) (*Identity, error)
+
+// OAuth2Provider implements [Provider] for services that do not issue ID tokens.
+type OAuth2Provider struct {
+	name        string
+	credentials Credentials
+	endpoint    Endpoint
+	scopes      []string
+	client      *http.Client
+	profile     profileFunc
+}
+
+// Name returns the URL identifier of the provider.
+func (provider *OAuth2Provider) Name() string {
+	return provider.name
+}
+
+// AuthCodeURL builds the consent page URL. The nonce is not used by plain OAuth2.
+func (provider *OAuth2Provider) AuthCodeURL(_ context.Context, state, codeChallenge, _ string) (string, error) {
+	return authCodeURL(provider.endpoint.AuthURL, provider.credentials, provider.scopes, state, codeChallenge, nil), nil
+}
+
+// Exchange redeems the code and loads the profile with the resulting access token.
+func (provider *OAuth2Provider) Exchange(context context.Context, code, codeVerifier, _ string) (*Identity, error) {
+	token, err := exchangeCode(context, provider.client, provider.endpoint.TokenURL, provider.credentials, code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := provider.profile(context, provider.client, token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	identity.Provider = provider.name
+	identity.AccessToken = token.AccessToken
+	return identity, nil
+}
+
+// GitHub constructs the GitHub provider. Emails come from the verified address list.
+func GitHub(credentials Credentials, client *http.Client) *OAuth2Provider {
+	return &OAuth2Provider{
+		name:        "github",
+		credentials: credentials,
+		endpoint: Endpoint{
+			AuthURL:  "https://github.com/login/oauth/authorize",
+			TokenURL: "https://github.com/login/oauth/access_token",
+		},
+		scopes:  []string{"read:user", "user:email"},
+		client:  client,
+		profile: githubProfile,
+	}
+}
+
+// Discord constructs the Discord provider.
+func Discord(credentials Credentials, client *http.Client) *OAuth2Provider {
+	return &OAuth2Provider{
+		name:        "discord",
+		credentials: credentials,
+		endpoint: Endpoint{
+			AuthURL:  "https://discord.com/oauth2/authorize",
+			TokenURL: "https://discord.com/api/oauth2/token",
+		},
+		scopes:  []string{"identify", "email"},
+		client:  client,
+		profile: discordProfile,
+	}
+}
+
+// githubProfile reads the user and its primary verified email.
+func githubProfile(context context.Context, client *http.Client, accessToken string) (*Identity, error) {
+	var user struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := getJSON(context, client, "https://api.github.com/user", accessToken, &user); err != nil {
+		return nil, err
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(context, client, "https://api.github.com/user/emails", accessToken, &emails); err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Subject:   strconv.FormatInt(user.ID, 10),
+		Name:      firstNonEmpty(user.Name, user.Login),
+		AvatarURL: user.AvatarURL,
+	}
+	for _, email := range emails {
+		if email.Primary {
+			identity.Email = email.Email
+			identity.EmailVerified = email.Verified
+		}
+	}
+
+	return identity, nil
+}
+
+// discordProfile reads the current user.
+func discordProfile(context context.Context, client *http.Client, accessToken string) (*Identity, error) {
+	var user struct {
+		ID         string `json:"id"`
+		Username   string `json:"username"`
+		GlobalName string `json:"global_name"`
+		Avatar     string `json:"avatar"`
+		Email      string `json:"email"`
+		Verified   bool   `json:"verified"`
+	}
+	if err := getJSON(context, client, "https://discord.com/api/users/@me", accessToken, &user); err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Subject:       user.ID,
+		Email:         user.Email,
+		EmailVerified: user.Verified,
+		Name:          firstNonEmpty(user.GlobalName, user.Username),
+	}
+	if user.Avatar != "" {
+		identity.AvatarURL = fmt.Sprintf("https://cdn.discordapp.com/avatars/%s/%s.png", user.ID, user.Avatar)
+	}
+
+	return identity, nil
+}
+
+// # Protocol Helpers
+
+// tokenResponse is the token endpoint payload (RFC 6749 §5.1 and OIDC Core §3.1.3.3).
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// authCodeURL assembles an authorization request with a PKCE S256 challenge.
+func authCodeURL(base string, credentials Credentials, scopes []string, state, codeChallenge string, extra url.Values) string {
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", credentials.ClientID)
+	query.Set("redirect_uri", credentials.RedirectURL)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", state)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	for key, values := range extra {
+		query[key] = values
+	}
+
+	separator := "?"
+	if strings.Contains(base, "?") {
+		separator = "&"
+	}
+	return base + separator + query.Encode()
+}
+
+// exchangeCode redeems an authorization code at the token endpoint.
+func exchangeCode(context context.Context, client *http.Client, tokenURL string, credentials Credentials, code, codeVerifier string) (*tokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", credentials.RedirectURL)
+	form.Set("client_id", credentials.ClientID)
+	form.Set("client_secret", credentials.ClientSecret)
+	form.Set("code_verifier", codeVerifier)
+
+	request, err := http.NewRequestWithContext(context, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("oauth: build token request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("oauth: token request: %w", err)
+	}
+	defer response.Body.Close()
+
+	// Some providers report errors with a 200 status, so the body decides
+	var token tokenResponse
+	if err := json.NewDecoder(io.LimitReader(response.Body, maxResponseBytes)).Decode(&token); err != nil {
+		return nil, fmt.Errorf("%w: status %d", ErrExchange, response.StatusCode)
+	}
+	if response.StatusCode != http.StatusOK || token.Error != "" || token.AccessToken == "" {
+		return nil, fmt.Errorf("%w: %s %s", ErrExchange, token.Error, token.ErrorDescription)
+	}
+
+	return &token, nil
+}
+
+// getJSON performs an authenticated GET and decodes the JSON body.
+func getJSON(context context.Context, client *http.Client, endpoint, accessToken string, target any) error {
+	request, err := http.NewRequestWithContext(context, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("oauth: build request: %w", err)
+	}
+	request.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		request.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return fmt.Errorf("oauth: request %s: %w", endpoint, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("oauth: request %s: unexpected status %d", endpoint, response.StatusCode)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(response.Body, maxResponseBytes)).Decode(target); err != nil {
+		return fmt.Errorf("oauth: decode %s: %w", endpoint, err)
+	}
+	return nil
+}
+
+// maxResponseBytes caps provider responses read into memory.
+const maxResponseBytes = 1 << 20
+
+// firstNonEmpty returns the first non-empty string.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
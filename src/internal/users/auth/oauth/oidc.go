@@ -0,0 +1,308 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package oauth
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// # OpenID Connect Provider
+
+// discoveryDocument is the subset of the issuer metadata used by the flow.
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// idTokenClaims are the ID token claims mapped onto an [Identity].
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string       `json:"nonce"`
+	Email         string       `json:"email"`
+	EmailVerified flexibleBool `json:"email_verified"`
+	Name          string       `json:"name"`
+	Picture       string       `json:"picture"`
+}
+
+/*
+OIDCProvider implements [Provider] for any OpenID Connect issuer.
+
+Description: Endpoints are discovered lazily from
+{issuer}/.well-known/openid-configuration and cached for [DiscoveryTTL].
+ID tokens must be RS256-signed by a key of the issuer's JWKS, issued by
+the configured issuer for this client, unexpired, and carry the nonce of
+the flow. Unknown key IDs trigger a JWKS refetch so key rotation at the
+issuer needs no restart.
+*/
+type OIDCProvider struct {
+	name        string
+	issuer      string
+	credentials Credentials
+	scopes      []string
+	client      *http.Client
+
+	mu            sync.Mutex
+	discovery     *discoveryDocument
+	discoveredAt  time.Time
+	keys          map[string]*rsa.PublicKey
+	keysFetchedAt time.Time
+}
+
+// NewOIDCProvider constructs a generic OpenID Connect provider.
+func NewOIDCProvider(name, issuer string, credentials Credentials, client *http.Client) *OIDCProvider {
+	return &OIDCProvider{
+		name:        name,
+		issuer:      strings.TrimSuffix(issuer, "/"),
+		credentials: credentials,
+		scopes:      []string{"openid", "email", "profile"},
+		client:      client,
+	}
+}
+
+// Google constructs the Google provider.
+func Google(credentials Credentials, client *http.Client) *OIDCProvider {
+	return NewOIDCProvider("google", "https://accounts.google.com", credentials, client)
+}
+
+// Name returns the URL identifier of the provider.
+func (provider *OIDCProvider) Name() string {
+	return provider.name
+}
+
+// AuthCodeURL builds the consent page URL from the discovered authorization endpoint.
+func (provider *OIDCProvider) AuthCodeURL(context context.Context, state, codeChallenge, nonce string) (string, error) {
+	document, err := provider.discover(context)
+	if err != nil {
+		return "", err
+	}
+
+	extra := url.Values{}
+	extra.Set("nonce", nonce)
+
+	return authCodeURL(document.AuthorizationEndpoint, provider.credentials, provider.scopes, state, codeChallenge, extra), nil
+}
+
+// Exchange redeems the code and verifies the returned ID token.
+func (provider *OIDCProvider) Exchange(context context.Context, code, codeVerifier, nonce string) (*Identity, error) {
+	document, err := provider.discover(context)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := exchangeCode(context, provider.client, document.TokenEndpoint, provider.credentials, code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, fmt.Errorf("%w: missing id_token", ErrInvalidIDToken)
+	}
+
+	claims, err := provider.verifyIDToken(context, token.IDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Provider:      provider.name,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+		AvatarURL:     claims.Picture,
+		AccessToken:   token.AccessToken,
+	}
+
+	// Some issuers keep the email out of the ID token
+	if identity.Email == "" && document.UserInfoEndpoint != "" {
+		var info struct {
+			Subject       string       `json:"sub"`
+			Email         string       `json:"email"`
+			EmailVerified flexibleBool `json:"email_verified"`
+		}
+		if err := getJSON(context, provider.client, document.UserInfoEndpoint, token.AccessToken, &info); err != nil {
+			return nil, err
+		}
+		if info.Subject == identity.Subject {
+			identity.Email = info.Email
+			identity.EmailVerified = bool(info.EmailVerified)
+		}
+	}
+
+	return identity, nil
+}
+
+// # Token Verification
+
+// verifyIDToken checks the signature and the claims of an ID token.
+func (provider *OIDCProvider) verifyIDToken(context context.Context, raw, nonce string) (*idTokenClaims, error) {
+	claims := &idTokenClaims{}
+
+	_, err := jwt.ParseWithClaims(raw, claims,
+		func(token *jwt.Token) (any, error) {
+			kid, _ := token.Header["kid"].(string)
+			return provider.key(context, kid)
+		},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(provider.issuer),
+		jwt.WithAudience(provider.credentials.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	return claims, nil
+}
+
+// key returns the signing key with the given ID, refetching the JWKS when it is unknown.
+func (provider *OIDCProvider) key(context context.Context, kid string) (*rsa.PublicKey, error) {
+	provider.mu.Lock()
+	key, ok := provider.keys[kid]
+	stale := time.Since(provider.keysFetchedAt) > KeyRefreshInterval
+	provider.mu.Unlock()
+
+	if ok {
+		return key, nil
+	}
+	if !stale {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	document, err := provider.discover(context)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := fetchKeys(context, provider.client, document.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+
+	provider.mu.Lock()
+	provider.keys = keys
+	provider.keysFetchedAt = time.Now()
+	provider.mu.Unlock()
+
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// # Discovery
+
+// discover returns the cached issuer metadata, fetching it when missing or expired.
+func (provider *OIDCProvider) discover(context context.Context) (*discoveryDocument, error) {
+	provider.mu.Lock()
+	if provider.discovery != nil && time.Since(provider.discoveredAt) < DiscoveryTTL {
+		document := provider.discovery
+		provider.mu.Unlock()
+		return document, nil
+	}
+	provider.mu.Unlock()
+
+	var document discoveryDocument
+	if err := getJSON(context, provider.client, provider.issuer+"/.well-known/openid-configuration", "", &document); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDiscovery, err)
+	}
+
+	// OIDC Discovery §4.3: the metadata must describe the configured issuer
+	if strings.TrimSuffix(document.Issuer, "/") != provider.issuer {
+		return nil, fmt.Errorf("%w: issuer mismatch %q", ErrDiscovery, document.Issuer)
+	}
+	if document.AuthorizationEndpoint == "" || document.TokenEndpoint == "" || document.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: incomplete metadata", ErrDiscovery)
+	}
+
+	provider.mu.Lock()
+	provider.discovery = &document
+	provider.discoveredAt = time.Now()
+	provider.mu.Unlock()
+
+	return &document, nil
+}
+
+// fetchKeys downloads a JWKS and keeps its RSA signing keys by key ID.
+func fetchKeys(context context.Context, client *http.Client, jwksURI string) (map[string]*rsa.PublicKey, error) {
+	var set struct {
+		Keys []struct {
+			KeyType string `json:"kty"`
+			KeyID   string `json:"kid"`
+			Use     string `json:"use"`
+			N       string `json:"n"`
+			E       string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := getJSON(context, client, jwksURI, "", &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.KeyType != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+
+		modulus, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			continue
+		}
+		exponent, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil || len(exponent) > 4 {
+			continue
+		}
+
+		keys[jwk.KeyID] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(modulus),
+			E: int(new(big.Int).SetBytes(exponent).Int64()),
+		}
+	}
+
+	return keys, nil
+}
+
+// flexibleBool decodes booleans that some issuers encode as strings.
+type flexibleBool bool
+
+// UnmarshalJSON accepts true, false, "true" and "false".
+func (value *flexibleBool) UnmarshalJSON(data []byte) error {
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch typed := raw.(type) {
+	case bool:
+		*value = flexibleBool(typed)
+	case string:
+		*value = flexibleBool(strings.EqualFold(typed, "true"))
+	default:
+		*value = false
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package oauth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taibuivan/yomira/internal/users/auth/oauth"
+)
+
+// # Mock Issuer
+
+// mockIssuer is a minimal OpenID Connect provider served by httptest.
+type mockIssuer struct {
+	server   *httptest.Server
+	key      *rsa.PrivateKey
+	clientID string
+
+	mu         sync.Mutex
+	challenges map[string]string // code -> PKCE challenge
+	nonces     map[string]string // code -> nonce
+	issuer     string            // Overrides the advertised issuer when set
+	tokenNonce string            // Overrides the ID token nonce when set
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	mock := &mockIssuer{
+		key:        key,
+		clientID:   "yomira-test",
+		challenges: map[string]string{},
+		nonces:     map[string]string{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", mock.discovery)
+	mux.HandleFunc("/jwks", mock.jwks)
+	mux.HandleFunc("/token", mock.token)
+
+	mock.server = httptest.NewServer(mux)
+	t.Cleanup(mock.server.Close)
+
+	return mock
+}
+
+// authorize simulates the consent page: it records the request and returns a code.
+func (mock *mockIssuer) authorize(t *testing.T, authURL string) string {
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+
+	query := parsed.Query()
+	require.Equal(t, "S256", query.Get("code_challenge_method"))
+	require.Equal(t, mock.clientID, query.Get("client_id"))
+
+	code := "code-" + query.Get("state")
+
+	mock.mu.Lock()
+	mock.challenges[code] = query.Get("code_challenge")
+	mock.nonces[code] = query.Get("nonce")
+	mock.mu.Unlock()
+
+	return code
+}
+
+func (mock *mockIssuer) discovery(writer http.ResponseWriter, _ *http.Request) {
+	issuer := mock.server.URL
+	if mock.issuer != "" {
+		issuer = mock.issuer
+	}
+
+	_ = json.NewEncoder(writer).Encode(map[string]string{
+		"issuer":                 issuer,
+		"authorization_endpoint": mock.server.URL + "/authorize",
+		"token_endpoint":         mock.server.URL + "/token",
+		"jwks_uri":               mock.server.URL + "/jwks",
+	})
+}
+
+func (mock *mockIssuer) jwks(writer http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(writer).Encode(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test-key",
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(mock.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(mock.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (mock *mockIssuer) token(writer http.ResponseWriter, request *http.Request) {
+	_ = request.ParseForm()
+	code := request.PostForm.Get("code")
+
+	mock.mu.Lock()
+	challenge, known := mock.challenges[code]
+	nonce := mock.nonces[code]
+	delete(mock.challenges, code)
+	mock.mu.Unlock()
+
+	// Single-use codes bound to the PKCE verifier
+	digest := sha256.Sum256([]byte(request.PostForm.Get("code_verifier")))
+	if !known || base64.RawURLEncoding.EncodeToString(digest[:]) != challenge {
+		writer.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(writer).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	if mock.tokenNonce != "" {
+		nonce = mock.tokenNonce
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            mock.server.URL,
+		"sub":            "subject-42",
+		"aud":            mock.clientID,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          nonce,
+		"email":          "reader@example.com",
+		"email_verified": "true",
+		"name":           "Reader",
+	})
+	token.Header["kid"] = "test-key"
+
+	signed, err := token.SignedString(mock.key)
+	if err != nil {
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	_ = json.NewEncoder(writer).Encode(map[string]string{
+		"access_token": "access-" + code,
+		"token_type":   "Bearer",
+		"id_token":     signed,
+	})
+}
+
+// provider builds an [oauth.OIDCProvider] pointing at the mock issuer.
+func (mock *mockIssuer) provider() *oauth.OIDCProvider {
+	return oauth.NewOIDCProvider("mock", mock.server.URL, oauth.Credentials{
+		ClientID:     mock.clientID,
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost/api/v1/auth/oauth/mock/callback",
+	}, mock.server.Client())
+}
+
+// # Tests
+
+/*
+TestOIDCProvider_Flow runs discovery, PKCE, the code exchange and ID token
+verification end to end against the mock issuer.
+*/
+func TestOIDCProvider_Flow(t *testing.T) {
+	mock := newMockIssuer(t)
+	provider := mock.provider()
+	ctx := context.Background()
+
+	verifier, err := oauth.NewVerifier()
+	require.NoError(t, err)
+
+	authURL, err := provider.AuthCodeURL(ctx, "state-1", oauth.S256Challenge(verifier), "nonce-1")
+	require.NoError(t, err)
+	assert.Contains(t, authURL, mock.server.URL+"/authorize?")
+
+	code := mock.authorize(t, authURL)
+
+	identity, err := provider.Exchange(ctx, code, verifier, "nonce-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "mock", identity.Provider)
+	assert.Equal(t, "subject-42", identity.Subject)
+	assert.Equal(t, "reader@example.com", identity.Email)
+	assert.True(t, identity.EmailVerified)
+	assert.Equal(t, "access-"+code, identity.AccessToken)
+}
+
+/*
+TestOIDCProvider_WrongVerifier ensures an intercepted code is useless without the verifier.
+*/
+func TestOIDCProvider_WrongVerifier(t *testing.T) {
+	mock := newMockIssuer(t)
+	provider := mock.provider()
+	ctx := context.Background()
+
+	verifier, err := oauth.NewVerifier()
+	require.NoError(t, err)
+
+	authURL, err := provider.AuthCodeURL(ctx, "state-2", oauth.S256Challenge(verifier), "nonce-2")
+	require.NoError(t, err)
+	code := mock.authorize(t, authURL)
+
+	_, err = provider.Exchange(ctx, code, "not-the-verifier", "nonce-2")
+	assert.ErrorIs(t, err, oauth.ErrExchange)
+}
+
+/*
+TestOIDCProvider_NonceMismatch rejects an ID token minted for another flow.
+*/
+func TestOIDCProvider_NonceMismatch(t *testing.T) {
+	mock := newMockIssuer(t)
+	mock.tokenNonce = "replayed"
+	provider := mock.provider()
+	ctx := context.Background()
+
+	verifier, err := oauth.NewVerifier()
+	require.NoError(t, err)
+
+	authURL, err := provider.AuthCodeURL(ctx, "state-3", oauth.S256Challenge(verifier), "nonce-3")
+	require.NoError(t, err)
+	code := mock.authorize(t, authURL)
+
+	_, err = provider.Exchange(ctx, code, verifier, "nonce-3")
+	assert.ErrorIs(t, err, oauth.ErrInvalidIDToken)
+}
+
+/*
+TestOIDCProvider_IssuerMismatch refuses metadata advertising a different issuer.
+*/
+func TestOIDCProvider_IssuerMismatch(t *testing.T) {
+	mock := newMockIssuer(t)
+	mock.issuer = "https://evil.example.com"
+
+	_, err := mock.provider().AuthCodeURL(context.Background(), "state", "challenge", "nonce")
+	assert.ErrorIs(t, err, oauth.ErrDiscovery)
+}
+
+/*
+TestRegistry keeps registration order and ignores unconfigured providers.
+*/
+func TestRegistry(t *testing.T) {
+	registry := oauth.NewRegistry(
+		oauth.GitHub(oauth.Credentials{}, http.DefaultClient),
+		nil,
+		oauth.Discord(oauth.Credentials{}, http.DefaultClient),
+	)
+
+	assert.Equal(t, []string{"github", "discord"}, registry.Names())
+
+	_, ok := registry.Get("discord")
+	assert.True(t, ok)
+
+	_, ok = registry.Get("apple")
+	assert.False(t, ok)
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+/*
+Package oauth implements the provider side of social login.
+
+It speaks the OAuth 2.0 authorization code flow with PKCE (RFC 7636) and
+OpenID Connect, and normalises every provider to an [Identity] so the auth
+service never handles provider-specific payloads.
+
+Provider Kinds:
+
+  - OIDC: Endpoints discovered from the issuer's openid-configuration; the
+    identity comes from a signature-checked ID token (Google, generic issuers).
+  - OAuth2: Fixed endpoints plus a profile API call (GitHub, Discord).
+
+Only the standard library is used on the wire, so a local mock issuer is
+enough to exercise the full flow in tests.
+*/
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/config"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+)
+
+// # Contracts & Types
+
+// Identity is the normalised account returned by a provider after a successful exchange.
+type Identity struct {
+	Provider      string
+	Subject       string // Stable provider-side account ID
+	Email         string
+	EmailVerified bool // Only a provider-verified email may be matched to an existing account
+	Name          string
+	AvatarURL     string
+	AccessToken   string
+}
+
+// Provider is a single social login backend.
+type Provider interface {
+
+	// Name returns the URL identifier of the provider (e.g. "google").
+	Name() string
+
+	/*
+		AuthCodeURL builds the consent page URL.
+
+		Parameters:
+		  - context: context.Context
+		  - state: string (Opaque CSRF token echoed back on the callback)
+		  - codeChallenge: string (PKCE S256 challenge)
+		  - nonce: string (Bound into the ID token; ignored by plain OAuth2 providers)
+
+		Returns:
+		  - string: Absolute URL to redirect the browser to
+		  - error: Discovery failures
+	*/
+	AuthCodeURL(context context.Context, state, codeChallenge, nonce string) (string, error)
+
+	/*
+		Exchange redeems an authorization code and resolves the account behind it.
+
+		Parameters:
+		  - context: context.Context
+		  - code: string
+		  - codeVerifier: string (PKCE verifier matching the challenge)
+		  - nonce: string (Expected ID token nonce)
+
+		Returns:
+		  - *Identity: Normalised account
+		  - error: ErrExchange, ErrInvalidIDToken or transport failures
+	*/
+	Exchange(context context.Context, code, codeVerifier, nonce string) (*Identity, error)
+}
+
+// Credentials are the client registration of the application at a provider.
+type Credentials struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string // Must match the callback registered at the provider
+}
+
+// # Errors
+
+var (
+	// ErrExchange is returned when the provider rejects the authorization code.
+	ErrExchange = errors.New("oauth: code exchange failed")
+
+	// ErrInvalidIDToken is returned when an ID token fails signature or claim checks.
+	ErrInvalidIDToken = errors.New("oauth: invalid id token")
+
+	// ErrDiscovery is returned when the issuer metadata cannot be used.
+	ErrDiscovery = errors.New("oauth: discovery failed")
+)
+
+// # Defaults
+
+const (
+	// RequestTimeout bounds every call made to a provider.
+	RequestTimeout = 10 * time.Second
+
+	// DiscoveryTTL is how long issuer metadata is cached before it is fetched again.
+	DiscoveryTTL = 24 * time.Hour
+
+	// KeyRefreshInterval rate limits JWKS refetches triggered by unknown key IDs.
+	KeyRefreshInterval = time.Minute
+
+	// verifierLength is the byte length of a PKCE verifier (43 characters once encoded).
+	verifierLength = 32
+)
+
+// NewHTTPClient returns the client shared by providers.
+func NewHTTPClient() *http.Client {
+	return &http.Client{Timeout: RequestTimeout}
+}
+
+// # PKCE
+
+// NewVerifier generates a random PKCE code verifier.
+func NewVerifier() (string, error) {
+	return sec.GenerateSecureToken(verifierLength)
+}
+
+// S256Challenge derives the PKCE code challenge of a verifier.
+func S256Challenge(verifier string) string {
+	digest := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(digest[:])
+}
+
+// # Registry
+
+// Registry holds the configured providers by name.
+type Registry struct {
+	providers map[string]Provider
+	order     []string
+}
+
+// NewRegistry constructs a [Registry]. Nil providers are skipped.
+func NewRegistry(providers ...Provider) *Registry {
+	registry := &Registry{providers: make(map[string]Provider, len(providers))}
+	for _, provider := range providers {
+		if provider == nil {
+			continue
+		}
+		if _, exists := registry.providers[provider.Name()]; !exists {
+			registry.order = append(registry.order, provider.Name())
+		}
+		registry.providers[provider.Name()] = provider
+	}
+	return registry
+}
+
+// Get returns the provider registered under the given name.
+func (registry *Registry) Get(name string) (Provider, bool) {
+	provider, ok := registry.providers[name]
+	return provider, ok
+}
+
+// Names lists the configured providers in registration order.
+func (registry *Registry) Names() []string {
+	return append([]string(nil), registry.order...)
+}
+
+/*
+NewRegistryFromConfig builds the registry of every provider with a client ID.
+
+Description: Each provider calls back to {OAUTH_REDIRECT_BASE_URL}/{name}/callback,
+which must be registered verbatim at the provider.
+
+Parameters:
+  - cfg: *config.Config
+
+Returns:
+  - *Registry: Configured providers (possibly none)
+*/
+func NewRegistryFromConfig(cfg *config.Config) *Registry {
+	client := NewHTTPClient()
+
+	credentials := func(name, clientID, clientSecret string) Credentials {
+		return Credentials{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  strings.TrimSuffix(cfg.OAuthRedirectBaseURL, "/") + "/" + name + "/callback",
+		}
+	}
+
+	var providers []Provider
+	if cfg.GoogleClientID != "" {
+		providers = append(providers, Google(credentials("google", cfg.GoogleClientID, cfg.GoogleClientSecret), client))
+	}
+	if cfg.DiscordClientID != "" {
+		providers = append(providers, Discord(credentials("discord", cfg.DiscordClientID, cfg.DiscordClientSecret), client))
+	}
+	if cfg.GitHubClientID != "" {
+		providers = append(providers, GitHub(credentials("github", cfg.GitHubClientID, cfg.GitHubClientSecret), client))
+	}
+	if cfg.OIDCIssuerURL != "" {
+		name := cfg.OIDCProviderName
+		providers = append(providers, NewOIDCProvider(name, cfg.OIDCIssuerURL, credentials(name, cfg.OIDCClientID, cfg.OIDCClientSecret), client))
+	}
+
+	return NewRegistry(providers...)
+}
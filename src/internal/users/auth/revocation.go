@@ -0,0 +1,200 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+)
+
+// # Access State
+
+// AccessState is everything the denylist knows about one access token.
+type AccessState struct {
+	TokenRevoked  bool      // The jti was revoked, e.g. by logout
+	RevokedBefore time.Time // Tokens of the user issued earlier are denied; zero if none
+	Suspended     bool      // The user is suspended
+}
+
+// Check returns the error for a token issued at issuedAt, or nil if it may pass.
+func (state AccessState) Check(issuedAt time.Time) error {
+	switch {
+	case state.Suspended:
+		return apperr.AccountSuspended()
+	case state.TokenRevoked, issuedAt.Before(state.RevokedBefore):
+		return apperr.Unauthorized("Token has been revoked")
+	}
+	return nil
+}
+
+// TokenRevoker cuts access tokens short before they expire.
+type TokenRevoker interface {
+	// RevokeAccessToken denies the token described by claims.
+	RevokeAccessToken(context context.Context, claims *sec.AuthClaims) error
+
+	// RevokeUserTokens denies every access token already issued to a user.
+	RevokeUserTokens(context context.Context, userID string) error
+}
+
+// # Access Guard
+
+/*
+AccessGuard checks access tokens against the revocation denylist.
+
+Description: Each check needs one Redis round trip for the jti, the per-user
+cutoff and the suspension flag. Results are cached per token for
+[RevocationCacheTTL], so a client firing requests in a burst costs one
+lookup. Revocations made through the guard drop the affected entries at
+once; other replicas notice within the cache lifetime.
+*/
+type AccessGuard struct {
+	revocationRepository RevocationRepository
+	cacheTTL             time.Duration
+
+	mutex sync.Mutex
+	cache map[string]cachedAccess
+}
+
+// cachedAccess is a denylist result remembered by an [AccessGuard].
+type cachedAccess struct {
+	userID    string
+	state     AccessState
+	expiresAt time.Time
+}
+
+// NewAccessGuard constructs a new [AccessGuard]; a zero cacheTTL disables the cache.
+func NewAccessGuard(revocationRepo RevocationRepository, cacheTTL time.Duration) *AccessGuard {
+	return &AccessGuard{
+		revocationRepository: revocationRepo,
+		cacheTTL:             cacheTTL,
+		cache:                make(map[string]cachedAccess),
+	}
+}
+
+/*
+CheckAccess decides whether an access token may still be used.
+
+Parameters:
+  - context: context.Context
+  - claims: *sec.AuthClaims (A verified JWT)
+
+Returns:
+  - error: apperr.Unauthorized for revoked tokens, apperr.AccountSuspended,
+    or lookup failures (not application errors)
+*/
+func (guard *AccessGuard) CheckAccess(context context.Context, claims *sec.AuthClaims) error {
+	var issuedAt time.Time
+	if claims.IssuedAt != nil {
+		issuedAt = claims.IssuedAt.Time
+	}
+
+	key := cacheKey(claims)
+	now := time.Now()
+
+	if state, ok := guard.cached(key, now); ok {
+		return state.Check(issuedAt)
+	}
+
+	state, err := guard.revocationRepository.Lookup(context, claims.ID, claims.UserID)
+	if err != nil {
+		return fmt.Errorf("auth_access_lookup_failed: %w", err)
+	}
+
+	guard.remember(key, claims.UserID, state, now)
+	return state.Check(issuedAt)
+}
+
+// RevokeAccessToken denies one token for the rest of its lifetime.
+func (guard *AccessGuard) RevokeAccessToken(context context.Context, claims *sec.AuthClaims) error {
+	if claims.ID == "" || claims.ExpiresAt == nil {
+		return nil
+	}
+
+	ttl := time.Until(claims.ExpiresAt.Time)
+	if ttl <= 0 {
+		return nil
+	}
+
+	if err := guard.revocationRepository.RevokeToken(context, claims.ID, ttl); err != nil {
+		return fmt.Errorf("auth_access_revoke_token_failed: %w", err)
+	}
+
+	guard.mutex.Lock()
+	delete(guard.cache, cacheKey(claims))
+	guard.mutex.Unlock()
+
+	return nil
+}
+
+/*
+RevokeUserTokens denies every access token issued to a user until now.
+
+Description: The iat claim has one second precision, so a token issued in
+the same second as the cutoff survives. In exchange, a client refreshing
+right after the cutoff is not locked out.
+*/
+func (guard *AccessGuard) RevokeUserTokens(context context.Context, userID string) error {
+	if err := guard.revocationRepository.RevokeBefore(context, userID, time.Now(), AccessTokenTTL); err != nil {
+		return fmt.Errorf("auth_access_revoke_user_failed: %w", err)
+	}
+
+	guard.mutex.Lock()
+	for key, entry := range guard.cache {
+		if entry.userID == userID {
+			delete(guard.cache, key)
+		}
+	}
+	guard.mutex.Unlock()
+
+	return nil
+}
+
+// # Cache
+
+// cached returns a live cache entry.
+func (guard *AccessGuard) cached(key string, now time.Time) (AccessState, bool) {
+	guard.mutex.Lock()
+	defer guard.mutex.Unlock()
+
+	entry, ok := guard.cache[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		return AccessState{}, false
+	}
+	return entry.state, true
+}
+
+// remember caches a lookup, sweeping expired entries once the cache is full.
+func (guard *AccessGuard) remember(key, userID string, state AccessState, now time.Time) {
+	if guard.cacheTTL <= 0 {
+		return
+	}
+
+	guard.mutex.Lock()
+	defer guard.mutex.Unlock()
+
+	if len(guard.cache) >= RevocationCacheSize {
+		for cachedKey, entry := range guard.cache {
+			if !now.Before(entry.expiresAt) {
+				delete(guard.cache, cachedKey)
+			}
+		}
+
+		// Still full of live entries: start over rather than evict one by one
+		if len(guard.cache) >= RevocationCacheSize {
+			clear(guard.cache)
+		}
+	}
+
+	guard.cache[key] = cachedAccess{userID: userID, state: state, expiresAt: now.Add(guard.cacheTTL)}
+}
+
+// cacheKey identifies a token; tokens issued before jti was added share their user's entry.
+func cacheKey(claims *sec.AuthClaims) string {
+	return claims.UserID + ":" + claims.ID
+}
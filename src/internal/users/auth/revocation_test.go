@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/users/auth"
+)
+
+// fakeRevocations is an in-memory [auth.RevocationRepository] that counts lookups.
+type fakeRevocations struct {
+	tokens    map[string]bool
+	cutoffs   map[string]time.Time
+	suspended map[string]bool
+	lookups   int
+	err       error
+}
+
+func newFakeRevocations() *fakeRevocations {
+	return &fakeRevocations{tokens: map[string]bool{}, cutoffs: map[string]time.Time{}, suspended: map[string]bool{}}
+}
+
+func (repository *fakeRevocations) RevokeToken(_ context.Context, tokenID string, _ time.Duration) error {
+	repository.tokens[tokenID] = true
+	return nil
+}
+
+func (repository *fakeRevocations) RevokeBefore(_ context.Context, userID string, cutoff time.Time, _ time.Duration) error {
+	repository.cutoffs[userID] = time.Unix(cutoff.Unix(), 0)
+	return nil
+}
+
+func (repository *fakeRevocations) Lookup(_ context.Context, tokenID, userID string) (auth.AccessState, error) {
+	repository.lookups++
+	if repository.err != nil {
+		return auth.AccessState{}, repository.err
+	}
+	return auth.AccessState{
+		TokenRevoked:  repository.tokens[tokenID],
+		RevokedBefore: repository.cutoffs[userID],
+		Suspended:     repository.suspended[userID],
+	}, nil
+}
+
+func accessClaims(userID, tokenID string, issuedAt time.Time) *sec.AuthClaims {
+	return &sec.AuthClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(auth.AccessTokenTTL)),
+		},
+		UserID: userID,
+	}
+}
+
+/*
+TestAccessGuard_CachesLookups checks a burst of requests with one lookup and
+drops the cached entry when the token is revoked through the guard.
+*/
+func TestAccessGuard_CachesLookups(t *testing.T) {
+	repository := newFakeRevocations()
+	guard := auth.NewAccessGuard(repository, time.Minute)
+	claims := accessClaims("user-1", "token-1", time.Now())
+
+	for range 3 {
+		require.NoError(t, guard.CheckAccess(context.Background(), claims))
+	}
+	assert.Equal(t, 1, repository.lookups)
+
+	require.NoError(t, guard.RevokeAccessToken(context.Background(), claims))
+	err := guard.CheckAccess(context.Background(), claims)
+	assert.Equal(t, http.StatusUnauthorized, apperr.As(err).HTTPStatus)
+	assert.Equal(t, 2, repository.lookups)
+}
+
+/*
+TestAccessGuard_RevokeUserTokens denies tokens issued before the cutoff but
+lets a token from a refresh right after it through.
+*/
+func TestAccessGuard_RevokeUserTokens(t *testing.T) {
+	repository := newFakeRevocations()
+	guard := auth.NewAccessGuard(repository, time.Minute)
+
+	old := accessClaims("user-1", "token-old", time.Now().Add(-time.Minute))
+	other := accessClaims("user-2", "token-other", time.Now().Add(-time.Minute))
+	require.NoError(t, guard.CheckAccess(context.Background(), old))
+	require.NoError(t, guard.CheckAccess(context.Background(), other))
+
+	require.NoError(t, guard.RevokeUserTokens(context.Background(), "user-1"))
+
+	assert.Error(t, guard.CheckAccess(context.Background(), old))
+	assert.NoError(t, guard.CheckAccess(context.Background(), other), "other users keep their cached result")
+	assert.NoError(t, guard.CheckAccess(context.Background(), accessClaims("user-1", "token-new", time.Now())))
+}
+
+/*
+TestAccessGuard_Suspended reports suspension ahead of revocation and
+surfaces lookup failures as non-application errors.
+*/
+func TestAccessGuard_Suspended(t *testing.T) {
+	repository := newFakeRevocations()
+	repository.suspended["user-1"] = true
+	guard := auth.NewAccessGuard(repository, 0)
+
+	err := guard.CheckAccess(context.Background(), accessClaims("user-1", "token-1", time.Now()))
+	assert.Equal(t, "ACCOUNT_SUSPENDED", apperr.As(err).Code)
+
+	repository.err = errors.New("connection refused")
+	err = guard.CheckAccess(context.Background(), accessClaims("user-2", "token-2", time.Now()))
+	require.Error(t, err)
+	assert.False(t, apperr.IsAppError(err))
+}
@@ -22,10 +22,13 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/taibuivan/yomira/internal/platform/apperr"
 	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/system/mail"
 	"github.com/taibuivan/yomira/pkg/uuid"
 )
 
@@ -46,6 +49,11 @@ type TokenProvider interface {
 	GenerateAccessToken(userID, username, role string, timeToLive time.Duration) (string, error)
 }
 
+// Mailer queues transactional emails for background delivery.
+type Mailer interface {
+	Enqueue(context context.Context, email mail.Email) error
+}
+
 // Service implements user authentication use cases.
 //
 // # Review Process
@@ -57,7 +65,15 @@ type Service struct {
 	sessionRepository           SessionRepository
 	resetTokenRepository        ResetTokenRepository
 	verificationTokenRepository VerificationTokenRepository
+	throttleRepository          LoginThrottleRepository
+	mfaRepository               MFARepository
+	challengeRepository         MFAChallengeRepository
+	secretBox                   *sec.SecretBox
 	tokenProvider               TokenProvider
+	tokenRevoker                TokenRevoker
+	mfaRequiredRole             sec.UserRole // Empty disables mandatory two-factor
+	mailer                      Mailer
+	appURL                      string // Base URL of the web app for links in emails
 	logger                      *slog.Logger
 }
 
@@ -67,7 +83,15 @@ func NewService(
 	sessionRepo SessionRepository,
 	resetRepo ResetTokenRepository,
 	verifyRepo VerificationTokenRepository,
+	throttleRepo LoginThrottleRepository,
+	mfaRepo MFARepository,
+	challengeRepo MFAChallengeRepository,
+	secretBox *sec.SecretBox,
 	tokenProv TokenProvider,
+	tokenRevoker TokenRevoker,
+	mfaRequiredRole sec.UserRole,
+	mailer Mailer,
+	appURL string,
 	logger *slog.Logger,
 ) *Service {
 	return &Service{
@@ -75,7 +99,15 @@ func NewService(
 		sessionRepository:           sessionRepo,
 		resetTokenRepository:        resetRepo,
 		verificationTokenRepository: verifyRepo,
+		throttleRepository:          throttleRepo,
+		mfaRepository:               mfaRepo,
+		challengeRepository:         challengeRepo,
+		secretBox:                   secretBox,
 		tokenProvider:               tokenProv,
+		tokenRevoker:                tokenRevoker,
+		mfaRequiredRole:             mfaRequiredRole,
+		mailer:                      mailer,
+		appURL:                      strings.TrimRight(appURL, "/"),
 		logger:                      logger,
 	}
 }
@@ -141,11 +173,10 @@ func (service *Service) Register(context context.Context, input RegisterInput) (
 		return nil, fmt.Errorf("auth_service_register_failed: %w", err)
 	}
 
-	// Generate and store a verification token in Redis as an async-ready side effect
-	token, err := sec.GenerateSecureToken(VerificationTokenLength)
-	if err == nil {
-		_ = service.verificationTokenRepository.Set(context, token, user.ID, VerificationTokenTTL)
-		// TODO: Trigger email service with the verification link
+	// The account exists at this point; a failed verification email must not
+	// fail the signup, so it is only logged
+	if err := service.sendVerificationEmail(context, user); err != nil {
+		service.logger.Error("auth_verification_email_failed", slog.String("user_id", user.ID), slog.Any("error", err))
 	}
 
 	service.logger.Info("user_registered", slog.String("user_id", user.ID))
@@ -164,11 +195,17 @@ type LoginInput struct {
 }
 
 // LoginSession represents a successfully established user session.
+//
+// When MFAToken is set the password was correct but no tokens were issued;
+// the client must complete the challenge described by MFAPurpose.
 type LoginSession struct {
 	AccessToken           string
 	RefreshToken          string
 	RefreshTokenExpiresAt time.Time
 	User                  *User
+	MFAToken              string
+	MFAPurpose            MFAPurpose
+	RecoveryCodes         []string // Set once, when a login completes a forced enrolment
 }
 
 /*
@@ -177,33 +214,95 @@ Login validates user credentials and issues security tokens.
 Description: Verifies identity, performs constant-time password comparison,
 and initializes a new session with rotated security tokens.
 
+Accounts with two-factor enabled, and privileged accounts that must enrol,
+receive an MFA challenge token instead of credentials.
+
+Brute-force protection: failures are counted per account and per IP
+address. Unknown logins are counted under the identifier; once the user is
+found, its ID keys the scope, so alternating username and email shares one
+budget. Past the free attempts each scope is blocked with exponential
+backoff, and too many failures lock it temporarily. Blocked attempts are
+rejected before the password is checked. The account scope is only reset
+once the last factor passes, so invalid MFA codes share the same budget.
+
 Parameters:
   - context: context.Context
   - input: LoginInput
 
 Returns:
   - *LoginSession: Transport-ready session identifiers
-  - err: Unauthorized or internal failures
+  - err: Unauthorized, RateLimited or internal failures
 */
 func (service *Service) Login(context context.Context, input LoginInput) (*LoginSession, error) {
-	var user *User
-	var err error
+	loginScope, ipScope := loginScopes(input)
+
+	if err := service.checkThrottle(context, loginScope, ipScope); err != nil {
+		return nil, err
+	}
+
 	// Flexible login: look up by Email or Username
-	user, err = service.userRepository.FindByEmail(context, input.Login)
+	user, err := service.userRepository.FindByEmail(context, input.Login)
 	if err != nil {
 		user, err = service.userRepository.FindByUsername(context, input.Login)
 	}
 
 	// If (err != nil) the user does not exist. Generic message to prevent enumeration.
 	if err != nil {
+		service.recordLoginFailure(context, loginScope, ipScope)
 		return nil, apperr.Unauthorized("Invalid login credentials")
 	}
 
+	// Every identifier of the account shares one budget from here on
+	accountScope := accountThrottleScope(user.ID)
+	if err := service.checkThrottle(context, accountScope); err != nil {
+		return nil, err
+	}
+
 	// Verify password hash usando constant-time comparison in bcrypt to prevent timing attacks
 	if !sec.CheckPasswordHash(input.Password, user.PasswordHash) {
+		service.recordLoginFailure(context, accountScope, ipScope)
 		return nil, apperr.Unauthorized("Invalid login credentials")
 	}
 
+	// Only someone holding the password learns about the suspension
+	if user.IsSuspended(time.Now()) {
+		return nil, apperr.AccountSuspended()
+	}
+
+	// Second factor gate: enrolled or privileged accounts continue through a challenge
+	purpose, err := service.mfaPurpose(context, user)
+	if err != nil {
+		return nil, err
+	}
+	if purpose != "" {
+		return service.startMFAChallenge(context, user, purpose, accountScope, input.UserAgent, input.IPAddress)
+	}
+
+	service.resetThrottle(context, accountScope)
+
+	return service.issueSession(context, user, input.UserAgent, input.IPAddress)
+}
+
+/*
+issueSession creates a new token family for a fully authenticated user.
+
+Parameters:
+  - context: context.Context
+  - user: *User
+  - userAgent: string
+  - ipAddress: string
+
+Returns:
+  - *LoginSession: Access and refresh tokens
+  - err: apperr.AccountSuspended, token generation or storage failures
+*/
+func (service *Service) issueSession(context context.Context, user *User, userAgent, ipAddress string) (*LoginSession, error) {
+
+	// Every login path ends here, including second factors and social login
+	if user.IsSuspended(time.Now()) {
+		return nil, apperr.AccountSuspended()
+	}
+
 	// Generate short-lived Access Token
 	accessToken, err := service.tokenProvider.GenerateAccessToken(user.ID, user.Username, string(user.Role), AccessTokenTTL)
 	if err != nil {
@@ -216,14 +315,16 @@ func (service *Service) Login(context context.Context, input LoginInput) (*Login
 		return nil, fmt.Errorf("auth_service_refresh_token_failed: %w", err)
 	}
 
-	// Create and persist the tracking session
+	// Create and persist the tracking session (root of a new token family)
 	expiresAt := time.Now().Add(RefreshTokenTTL)
+	sessionID := uuid.New()
 	session := &Session{
-		ID:        uuid.New(),
+		ID:        sessionID,
 		UserID:    user.ID,
 		TokenHash: sec.HashToken(refreshToken),
-		UserAgent: input.UserAgent,
-		IPAddress: input.IPAddress,
+		FamilyID:  sessionID,
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
 		ExpiresAt: expiresAt,
 		IsRevoked: false,
 	}
@@ -242,19 +343,112 @@ func (service *Service) Login(context context.Context, input LoginInput) (*Login
 	}, nil
 }
 
+/*
+checkThrottle rejects an attempt while any of its scopes is blocked.
+
+Description: Fails open so a Redis outage does not block every login.
+
+Parameters:
+  - context: context.Context
+  - scopes: ...string
+
+Returns:
+  - error: apperr.RateLimited with the remaining wait, or nil
+*/
+func (service *Service) checkThrottle(context context.Context, scopes ...string) error {
+	wait, err := service.throttleRepository.BlockedFor(context, scopes...)
+	if err != nil {
+		service.logger.Error("auth_throttle_check_failed", slog.Any("error", err))
+	}
+	if wait > 0 {
+		return apperr.RateLimited(retryAfterSeconds(wait))
+	}
+	return nil
+}
+
+// recordLoginFailure counts a failed login against both throttle scopes.
+func (service *Service) recordLoginFailure(context context.Context, accountScope, ipScope string) {
+	service.recordThrottleFailure(context, accountScope, AccountThrottle)
+	service.recordThrottleFailure(context, ipScope, IPThrottle)
+}
+
+/*
+recordThrottleFailure counts a failure against one throttle scope.
+
+Description: Blocks the scope once its policy demands a delay and logs a
+security event when the temporary lock is reached.
+
+Parameters:
+  - context: context.Context
+  - scope: string
+  - policy: ThrottlePolicy
+*/
+func (service *Service) recordThrottleFailure(context context.Context, scope string, policy ThrottlePolicy) {
+	failures, err := service.throttleRepository.RecordFailure(context, scope, policy.Window)
+	if err != nil {
+		service.logger.Error("auth_throttle_record_failed", slog.Any("error", err))
+		return
+	}
+
+	delay := policy.Delay(failures)
+	if delay == 0 {
+		return
+	}
+
+	if err := service.throttleRepository.Block(context, scope, delay); err != nil {
+		service.logger.Error("auth_throttle_block_failed", slog.Any("error", err))
+		return
+	}
+
+	if policy.IsLock(failures) {
+		service.logger.Warn("security_login_locked",
+			slog.String("scope", scope),
+			slog.Int("failures", failures),
+			slog.Duration("duration", delay),
+		)
+	}
+}
+
+// resetThrottle forgives earlier mistakes on a scope once every factor has passed.
+func (service *Service) resetThrottle(context context.Context, scope string) {
+	if err := service.throttleRepository.Reset(context, scope); err != nil {
+		service.logger.Warn("auth_throttle_reset_failed", slog.Any("error", err))
+	}
+}
+
+// loginScopes derives the throttle scopes of an attempt before the user is known.
+// Logins are case-insensitive.
+func loginScopes(input LoginInput) (string, string) {
+	return "account:" + strings.ToLower(strings.TrimSpace(input.Login)), "ip:" + input.IPAddress
+}
+
+// accountThrottleScope keys the account throttle of a resolved user.
+func accountThrottleScope(userID string) string {
+	return "account:" + userID
+}
+
 /*
 Logout permanently revokes the user's active session.
 
-Description: Ensures that a tracked refresh token can never be used again.
+Description: Ensures that a tracked refresh token can never be used again,
+and denies the access token that made the request for the rest of its life.
 
 Parameters:
   - context: context.Context
   - refreshToken: string
+  - claims: *sec.AuthClaims (The caller's access token; nil skips it)
 
 Returns:
   - err: Revocation failures
 */
-func (service *Service) Logout(context context.Context, refreshToken string) error {
+func (service *Service) Logout(context context.Context, refreshToken string, claims *sec.AuthClaims) error {
+
+	// Deny the access token first; it would otherwise outlive the session
+	if claims != nil {
+		if err := service.tokenRevoker.RevokeAccessToken(context, claims); err != nil {
+			return fmt.Errorf("auth_service_logout_access_failed: %w", err)
+		}
+	}
 
 	// Hash the refresh token
 	tokenHash := sec.HashToken(refreshToken)
@@ -275,6 +469,13 @@ func (service *Service) Logout(context context.Context, refreshToken string) err
 	return nil
 }
 
+// revokeUserTokens denies the outstanding access tokens of a user, logging failures.
+func (service *Service) revokeUserTokens(context context.Context, userID string) {
+	if err := service.tokenRevoker.RevokeUserTokens(context, userID); err != nil {
+		service.logger.Error("auth_access_revoke_failed", slog.String("user_id", userID), slog.Any("error", err))
+	}
+}
+
 // # Session Management
 
 /*
@@ -283,6 +484,11 @@ RefreshSession implements the Refresh Token Rotation mechanism.
 Description: Verifies the existing refresh token, revokes it to prevent reuse
 (replay attack mitigation), and issues a fresh pair of rotated tokens.
 
+Reuse detection: if the presented token belongs to a session that was
+already rotated, it has been copied. The whole token family is revoked so
+neither the thief nor the victim can continue, and a security event is
+recorded.
+
 Parameters:
   - context: context.Context
   - refreshToken: string
@@ -301,19 +507,18 @@ func (service *Service) RefreshSession(context context.Context, refreshToken, us
 
 	// If (err != nil) the token is either expired, already revoked, or completely invalid.
 	if err != nil {
+		service.detectReuse(context, tokenHash, ipAddress)
 		return nil, apperr.Unauthorized("Invalid or expired refresh token")
 	}
 
-	// Rotation: Revoke the old session to prevent replay attacks
-	if err := service.sessionRepository.Revoke(context, session.ID); err != nil {
-		return nil, fmt.Errorf("auth_service_refresh_revoke_failed: %w", err)
-	}
-
 	// Fetch the user associated with this session
 	user, err := service.userRepository.FindByID(context, session.UserID)
 	if err != nil {
 		return nil, apperr.Unauthorized("User not found or suspended")
 	}
+	if user.IsSuspended(time.Now()) {
+		return nil, apperr.AccountSuspended()
+	}
 
 	// Generate a fresh Access Token
 	accessToken, err := service.tokenProvider.GenerateAccessToken(user.ID, user.Username, string(user.Role), AccessTokenTTL)
@@ -327,20 +532,25 @@ func (service *Service) RefreshSession(context context.Context, refreshToken, us
 		return nil, fmt.Errorf("auth_service_refresh_secure_token_failed: %w", err)
 	}
 
-	// Persist the new session
+	// Rotation: revoke the old session and persist its child atomically
 	expiresAt := time.Now().Add(RefreshTokenTTL)
 	newSession := &Session{
 		ID:        uuid.New(),
 		UserID:    user.ID,
 		TokenHash: sec.HashToken(newRefreshToken),
+		ParentID:  &session.ID,
+		FamilyID:  session.FamilyID,
 		UserAgent: userAgent,
 		IPAddress: ipAddress,
 		ExpiresAt: expiresAt,
 		IsRevoked: false,
 	}
 
-	if err := service.sessionRepository.Create(context, newSession); err != nil {
-		return nil, fmt.Errorf("auth_service_refresh_session_creation_failed: %w", err)
+	if err := service.sessionRepository.Rotate(context, session.ID, newSession); err != nil {
+		if appErr := apperr.As(err); appErr != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("auth_service_refresh_rotate_failed: %w", err)
 	}
 
 	return &LoginSession{
@@ -351,41 +561,94 @@ func (service *Service) RefreshSession(context context.Context, refreshToken, us
 	}, nil
 }
 
+/*
+detectReuse revokes the token family of a replayed refresh token.
+
+Description: Called after an active session lookup failed. Tokens that
+were never rotated (unknown, expired, logged out) are ignored. Failures are
+logged only; the caller rejects the request either way.
+
+Parameters:
+  - context: context.Context
+  - tokenHash: string
+  - ipAddress: string
+*/
+func (service *Service) detectReuse(context context.Context, tokenHash, ipAddress string) {
+	session, err := service.sessionRepository.FindRotatedByTokenHash(context, tokenHash)
+	if err != nil {
+		if !apperr.IsNotFound(err) {
+			service.logger.Error("auth_reuse_lookup_failed", slog.Any("error", err))
+		}
+		return
+	}
+
+	revoked, err := service.sessionRepository.RevokeFamily(context, session, ipAddress)
+	if err != nil {
+		service.logger.Error("auth_family_revoke_failed",
+			slog.String("family_id", session.FamilyID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	service.logger.Warn("security_refresh_token_reuse",
+		slog.String("user_id", session.UserID),
+		slog.String("session_id", session.ID),
+		slog.String("family_id", session.FamilyID),
+		slog.String("ip_address", ipAddress),
+		slog.Int("sessions_revoked", revoked),
+	)
+}
+
 // # Password Recovery
 
 /*
 RequestPasswordReset initiates the forgot-password flow.
 
-Description: Generates a secure token and saves it to Redis.
+Description: Generates a secure token, saves it to Redis and emails the
+reset link. The token never leaves the server any other way.
 
 Parameters:
   - context: context.Context
   - email: string
 
 Returns:
-  - string: Discovery token
-  - err: Generation errors
+  - err: Generation, storage or queueing errors
 */
-func (service *Service) RequestPasswordReset(context context.Context, email string) (string, error) {
+func (service *Service) RequestPasswordReset(context context.Context, email string) error {
 	// Look up user.
 	// NOTE: We don't return NOT_FOUND if the email doesn't exist to prevent user enumeration.
 	user, err := service.userRepository.FindByEmail(context, email)
 	if err != nil {
-		return "", nil
+		return nil
 	}
 
 	// Generate reset token
 	token, err := sec.GenerateSecureToken(ResetTokenLength)
 	if err != nil {
-		return "", fmt.Errorf("auth_service_generate_reset_token_failed: %w", err)
+		return fmt.Errorf("auth_service_generate_reset_token_failed: %w", err)
 	}
 
 	// Save to Redis
 	if err := service.resetTokenRepository.Set(context, token, user.ID, ResetTokenTTL); err != nil {
-		return "", fmt.Errorf("auth_service_save_reset_token_failed: %w", err)
+		return fmt.Errorf("auth_service_save_reset_token_failed: %w", err)
+	}
+
+	// Queue the email with the reset link
+	err = service.mailer.Enqueue(context, mail.Email{
+		To:       user.Email,
+		Template: mail.TemplatePasswordReset,
+		Data: mail.PasswordResetData{
+			Name:      greetingName(user),
+			Link:      service.appLink(ResetPasswordPath, token),
+			ExpiresIn: ResetTokenTTL,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("auth_service_reset_email_failed: %w", err)
 	}
 
-	return token, nil
+	return nil
 }
 
 /*
@@ -421,8 +684,9 @@ func (service *Service) ResetPassword(context context.Context, token, newPasswor
 		return fmt.Errorf("auth_service_reset_password_update_failed: %w", err)
 	}
 
-	// Security Cleanup: Revoke EVERY active session for this user
+	// Security Cleanup: Revoke EVERY active session and access token for this user
 	_ = service.sessionRepository.RevokeAll(context, userID)
+	service.revokeUserTokens(context, userID)
 
 	// Delete the used token from Redis
 	_ = service.resetTokenRepository.Delete(context, token)
@@ -436,7 +700,8 @@ func (service *Service) ResetPassword(context context.Context, token, newPasswor
 ChangePassword allows an authenticated user to update their credentials.
 
 Description: Verifies the current password and then rotates all OTHER refresh sessions
-to ensure high security across devices.
+to ensure high security across devices. Social-login-only accounts use it to
+set a first password, which keeps them able to sign in after unlinking.
 
 Parameters:
   - context: context.Context
@@ -456,8 +721,9 @@ func (service *Service) ChangePassword(context context.Context, userID, currentP
 		return err
 	}
 
-	// Verify the current password before allowing change
-	if !sec.CheckPasswordHash(currentPassword, user.PasswordHash) {
+	// Verify the current password before allowing change. Accounts created
+	// by social login have none and set their first password here.
+	if user.PasswordHash != "" && !sec.CheckPasswordHash(currentPassword, user.PasswordHash) {
 		return apperr.Unauthorized("Current password is incorrect")
 	}
 
@@ -479,6 +745,9 @@ func (service *Service) ChangePassword(context context.Context, userID, currentP
 		_ = service.sessionRepository.RevokeOthers(context, userID, session.ID)
 	}
 
+	// Access tokens do not name their session; this device refreshes once
+	service.revokeUserTokens(context, userID)
+
 	service.logger.Info("user_password_changed", slog.String("user_id", userID))
 
 	return nil
@@ -514,3 +783,49 @@ func (service *Service) VerifyEmail(context context.Context, token string) error
 
 	return nil
 }
+
+// # Email Links
+
+/*
+sendVerificationEmail issues a verification token and emails its link.
+
+Parameters:
+  - context: context.Context
+  - user: *User
+
+Returns:
+  - error: Generation, storage or queueing errors
+*/
+func (service *Service) sendVerificationEmail(context context.Context, user *User) error {
+	token, err := sec.GenerateSecureToken(VerificationTokenLength)
+	if err != nil {
+		return fmt.Errorf("auth_service_generate_verification_token_failed: %w", err)
+	}
+
+	if err := service.verificationTokenRepository.Set(context, token, user.ID, VerificationTokenTTL); err != nil {
+		return fmt.Errorf("auth_service_save_verification_token_failed: %w", err)
+	}
+
+	return service.mailer.Enqueue(context, mail.Email{
+		To:       user.Email,
+		Template: mail.TemplateVerifyEmail,
+		Data: mail.VerifyEmailData{
+			Name:      greetingName(user),
+			Link:      service.appLink(VerifyEmailPath, token),
+			ExpiresIn: VerificationTokenTTL,
+		},
+	})
+}
+
+// appLink builds an absolute web app URL carrying a one-time token.
+func (service *Service) appLink(path, token string) string {
+	return service.appURL + path + "?token=" + url.QueryEscape(token)
+}
+
+// greetingName picks how an email addresses the user.
+func greetingName(user *User) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	return user.Username
+}
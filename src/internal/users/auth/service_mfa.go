@@ -0,0 +1,491 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+)
+
+// # Two-Factor Login
+
+/*
+VerifyMFA completes a login that was paused by an MFA challenge.
+
+Description: For a 'login' challenge the code may be a TOTP code or an
+unused recovery code. For an 'enroll' challenge the code confirms the
+enrolment started with [Service.BeginChallengeEnrollment], and the new
+recovery codes are returned with the session. Each challenge accepts
+[MFAChallengeMaxAttempts] codes, and every invalid code also counts as a
+failed login for the account and the caller's IP, so opening new
+challenges cannot bypass the login backoff and lock.
+
+Parameters:
+  - context: context.Context
+  - token: string (MFA challenge token from Login)
+  - code: string
+  - ipAddress: string (Caller address, throttled like a login attempt)
+
+Returns:
+  - *LoginSession: Issued tokens (plus recovery codes after enrolment)
+  - err: Unauthorized, RateLimited or storage failures
+*/
+func (service *Service) VerifyMFA(context context.Context, token, code, ipAddress string) (*LoginSession, error) {
+	challenge, attempts, err := service.challengeRepository.Attempt(context, token)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, apperr.Unauthorized("MFA challenge is invalid or expired")
+		}
+		return nil, err
+	}
+
+	// Exhausted challenges force a fresh password check
+	if attempts > MFAChallengeMaxAttempts {
+		_ = service.challengeRepository.Delete(context, token)
+		service.logger.Warn("security_mfa_challenge_exhausted", slog.String("user_id", challenge.UserID))
+		return nil, apperr.Unauthorized("Too many invalid codes. Please log in again")
+	}
+
+	// Same throttle gate as the password step (fails open)
+	accountScope, ipScope := challenge.ThrottleScope, "ip:"+ipAddress
+	if err := service.checkThrottle(context, accountScope, ipScope); err != nil {
+		return nil, err
+	}
+
+	user, err := service.userRepository.FindByID(context, challenge.UserID)
+	if err != nil {
+		return nil, apperr.Unauthorized("User not found or suspended")
+	}
+
+	// Second factor by purpose
+	var recoveryCodes []string
+	switch challenge.Purpose {
+	case MFAPurposeLogin:
+		err = service.verifySecondFactor(context, user.ID, code)
+	case MFAPurposeEnroll:
+		recoveryCodes, err = service.ConfirmMFAEnrollment(context, user.ID, code)
+	default:
+		err = apperr.Unauthorized("MFA challenge is invalid or expired")
+	}
+	if err != nil {
+		if apperr.IsUnauthorized(err) {
+			service.recordLoginFailure(context, accountScope, ipScope)
+		}
+		return nil, err
+	}
+
+	_ = service.challengeRepository.Delete(context, token)
+	service.resetThrottle(context, accountScope)
+
+	session, err := service.issueSession(context, user, challenge.UserAgent, challenge.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+	session.RecoveryCodes = recoveryCodes
+
+	return session, nil
+}
+
+/*
+BeginChallengeEnrollment starts enrolment for a privileged user who cannot log in without it.
+
+Parameters:
+  - context: context.Context
+  - token: string (MFA challenge token with purpose 'enroll')
+
+Returns:
+  - *MFAEnrollment: Secret and provisioning URI
+  - err: Unauthorized or storage failures
+*/
+func (service *Service) BeginChallengeEnrollment(context context.Context, token string) (*MFAEnrollment, error) {
+	challenge, err := service.challengeRepository.Get(context, token)
+	if err != nil || challenge.Purpose != MFAPurposeEnroll {
+		return nil, apperr.Unauthorized("MFA challenge is invalid or expired")
+	}
+
+	return service.BeginMFAEnrollment(context, challenge.UserID)
+}
+
+// # Two-Factor Management
+
+/*
+GetMFAStatus reports the two-factor state of an account.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - *MFAStatus: Current state
+  - err: Storage failures
+*/
+func (service *Service) GetMFAStatus(context context.Context, userID string) (*MFAStatus, error) {
+	user, err := service.userRepository.FindByID(context, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &MFAStatus{IsRequired: service.requiresMFA(user.Role)}
+
+	mfa, err := service.mfaRepository.Find(context, userID)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return status, nil
+		}
+		return nil, err
+	}
+
+	if mfa.IsEnabled {
+		status.IsEnabled = true
+		status.EnabledAt = mfa.EnabledAt
+
+		status.RecoveryCodesRemaining, err = service.mfaRepository.CountRecoveryCodes(context, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return status, nil
+}
+
+/*
+BeginMFAEnrollment generates a new TOTP secret awaiting confirmation.
+
+Description: The secret is sealed before storage and shown to the user only
+in this response. Calling it again before confirming replaces the secret.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - *MFAEnrollment: Secret and provisioning URI
+  - err: Conflict if already enabled, or storage failures
+*/
+func (service *Service) BeginMFAEnrollment(context context.Context, userID string) (*MFAEnrollment, error) {
+	user, err := service.userRepository.FindByID(context, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := sec.GenerateTOTPSecret()
+	if err != nil {
+		return nil, fmt.Errorf("auth_service_mfa_secret_failed: %w", err)
+	}
+
+	sealed, err := service.secretBox.Seal(secret)
+	if err != nil {
+		return nil, fmt.Errorf("auth_service_mfa_seal_failed: %w", err)
+	}
+
+	if err := service.mfaRepository.SavePending(context, userID, sealed); err != nil {
+		return nil, err
+	}
+
+	return &MFAEnrollment{
+		Secret: secret,
+		URI:    sec.TOTPProvisioningURI(MFAIssuer, user.Email, secret),
+	}, nil
+}
+
+/*
+ConfirmMFAEnrollment enables two-factor once the user proves the app is set up.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - code: string (Current TOTP code)
+
+Returns:
+  - []string: Recovery codes, shown once
+  - err: Unprocessable (no pending enrolment), Unauthorized (wrong code) or storage failures
+*/
+func (service *Service) ConfirmMFAEnrollment(context context.Context, userID, code string) ([]string, error) {
+	mfa, err := service.mfaRepository.Find(context, userID)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, apperr.Unprocessable("Start two-factor enrolment first")
+		}
+		return nil, err
+	}
+	if mfa.IsEnabled {
+		return nil, apperr.Conflict("Two-factor authentication is already enabled")
+	}
+
+	step, err := service.matchTOTP(mfa, code)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := service.mfaRepository.Enable(context, userID, step, hashes); err != nil {
+		return nil, err
+	}
+
+	service.logger.Info("user_mfa_enabled", slog.String("user_id", userID))
+
+	return codes, nil
+}
+
+/*
+DisableMFA removes two-factor after re-checking both factors.
+
+Description: The password is not required for accounts without one.
+Failed checks are throttled per account like logins, so a stolen access
+token cannot be used to guess the code.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - password: string (Ignored for social-login-only accounts)
+  - code: string (TOTP or recovery code)
+
+Returns:
+  - err: Forbidden when mandatory for the role, Unauthorized, RateLimited or storage failures
+*/
+func (service *Service) DisableMFA(context context.Context, userID, password, code string) error {
+	user, err := service.userRepository.FindByID(context, userID)
+	if err != nil {
+		return err
+	}
+
+	if service.requiresMFA(user.Role) {
+		return apperr.Forbidden("Two-factor authentication is mandatory for your role")
+	}
+
+	scope := mfaThrottleScope(userID)
+	if err := service.checkThrottle(context, scope); err != nil {
+		return err
+	}
+
+	// Accounts created by social login have no password; the second factor alone re-authenticates
+	if user.PasswordHash != "" && !sec.CheckPasswordHash(password, user.PasswordHash) {
+		service.recordThrottleFailure(context, scope, AccountThrottle)
+		return apperr.Unauthorized("Current password is incorrect")
+	}
+
+	if err := service.verifySecondFactor(context, userID, code); err != nil {
+		if apperr.IsUnauthorized(err) {
+			service.recordThrottleFailure(context, scope, AccountThrottle)
+		}
+		return err
+	}
+	service.resetThrottle(context, scope)
+
+	if err := service.mfaRepository.Delete(context, userID); err != nil {
+		return err
+	}
+
+	service.logger.Warn("user_mfa_disabled", slog.String("user_id", userID))
+
+	return nil
+}
+
+/*
+RegenerateRecoveryCodes replaces every recovery code after a TOTP check.
+
+Description: Invalid codes share the per-account throttle of [Service.DisableMFA].
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - code: string (Current TOTP code)
+
+Returns:
+  - []string: New recovery codes, shown once
+  - err: Unprocessable (not enabled), Unauthorized, RateLimited or storage failures
+*/
+func (service *Service) RegenerateRecoveryCodes(context context.Context, userID, code string) ([]string, error) {
+	mfa, err := service.enabledMFA(context, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := mfaThrottleScope(userID)
+	if err := service.checkThrottle(context, scope); err != nil {
+		return nil, err
+	}
+
+	step, err := service.matchTOTP(mfa, code)
+	if err == nil {
+		err = service.consumeStep(context, userID, step)
+	}
+	if err != nil {
+		if apperr.IsUnauthorized(err) {
+			service.recordThrottleFailure(context, scope, AccountThrottle)
+		}
+		return nil, err
+	}
+	service.resetThrottle(context, scope)
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := service.mfaRepository.ReplaceRecoveryCodes(context, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	service.logger.Info("user_mfa_recovery_codes_regenerated", slog.String("user_id", userID))
+
+	return codes, nil
+}
+
+// # Internal Helpers
+
+// mfaPurpose decides whether a password-verified login needs a second step.
+func (service *Service) mfaPurpose(context context.Context, user *User) (MFAPurpose, error) {
+	mfa, err := service.mfaRepository.Find(context, user.ID)
+	switch {
+	case err == nil && mfa.IsEnabled:
+		return MFAPurposeLogin, nil
+	case err != nil && !apperr.IsNotFound(err):
+		return "", err
+	case service.requiresMFA(user.Role):
+		return MFAPurposeEnroll, nil
+	default:
+		return "", nil
+	}
+}
+
+// mfaThrottleScope keys the throttle of two-factor management by account.
+func mfaThrottleScope(userID string) string {
+	return "mfa:" + userID
+}
+
+// startMFAChallenge stores a short-lived challenge and returns its token instead of credentials.
+func (service *Service) startMFAChallenge(context context.Context, user *User, purpose MFAPurpose, throttleScope, userAgent, ipAddress string) (*LoginSession, error) {
+	token, err := sec.GenerateSecureToken(MFAChallengeTokenLength)
+	if err != nil {
+		return nil, fmt.Errorf("auth_service_mfa_challenge_token_failed: %w", err)
+	}
+
+	challenge := &MFAChallenge{
+		UserID:        user.ID,
+		Purpose:       purpose,
+		UserAgent:     userAgent,
+		IPAddress:     ipAddress,
+		ThrottleScope: throttleScope,
+	}
+
+	if err := service.challengeRepository.Create(context, token, challenge, MFAChallengeTTL); err != nil {
+		return nil, fmt.Errorf("auth_service_mfa_challenge_failed: %w", err)
+	}
+
+	return &LoginSession{MFAToken: token, MFAPurpose: purpose, User: user}, nil
+}
+
+// verifySecondFactor accepts a fresh TOTP code or an unused recovery code.
+func (service *Service) verifySecondFactor(context context.Context, userID, code string) error {
+	mfa, err := service.enabledMFA(context, userID)
+	if err != nil {
+		return err
+	}
+
+	// Recovery codes are longer than TOTP codes
+	if len(strings.TrimSpace(code)) != sec.TOTPDigits {
+		used, err := service.mfaRepository.UseRecoveryCode(context, userID, hashRecoveryCode(code))
+		if err != nil {
+			return err
+		}
+		if !used {
+			return apperr.Unauthorized("Invalid two-factor code")
+		}
+
+		service.logger.Warn("user_mfa_recovery_code_used", slog.String("user_id", userID))
+		return nil
+	}
+
+	step, err := service.matchTOTP(mfa, code)
+	if err != nil {
+		return err
+	}
+
+	return service.consumeStep(context, userID, step)
+}
+
+// enabledMFA loads an enrolment that is fully enabled.
+func (service *Service) enabledMFA(context context.Context, userID string) (*MFA, error) {
+	mfa, err := service.mfaRepository.Find(context, userID)
+	if err != nil && !apperr.IsNotFound(err) {
+		return nil, err
+	}
+	if err != nil || !mfa.IsEnabled {
+		return nil, apperr.Unprocessable("Two-factor authentication is not enabled")
+	}
+	return mfa, nil
+}
+
+// matchTOTP checks a code against the sealed secret and returns its time step.
+func (service *Service) matchTOTP(mfa *MFA, code string) (int64, error) {
+	secret, err := service.secretBox.Open(mfa.Secret)
+	if err != nil {
+		return 0, fmt.Errorf("auth_service_mfa_open_failed: %w", err)
+	}
+
+	step, ok := sec.ValidateTOTP(secret, strings.TrimSpace(code), time.Now(), MFATOTPSkew)
+	if !ok {
+		return 0, apperr.Unauthorized("Invalid two-factor code")
+	}
+
+	return step, nil
+}
+
+// consumeStep makes a matched TOTP code single-use.
+func (service *Service) consumeStep(context context.Context, userID string, step int64) error {
+	fresh, err := service.mfaRepository.UseStep(context, userID, step)
+	if err != nil {
+		return err
+	}
+	if !fresh {
+		return apperr.Unauthorized("This code was already used. Wait for the next one")
+	}
+	return nil
+}
+
+// requiresMFA reports whether the configured policy makes two-factor mandatory for the role.
+func (service *Service) requiresMFA(role sec.UserRole) bool {
+	return service.mfaRequiredRole != "" && role.AtLeast(service.mfaRequiredRole)
+}
+
+// recoveryEncoding renders recovery codes without ambiguous padding.
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateRecoveryCodes creates display codes ("xxxx-xxxx") and their storage hashes.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, MFARecoveryCodeCount)
+	hashes := make([]string, MFARecoveryCodeCount)
+
+	for index := range codes {
+		bytes := make([]byte, MFARecoveryCodeLength)
+		if _, err := rand.Read(bytes); err != nil {
+			return nil, nil, fmt.Errorf("auth_service_recovery_code_failed: %w", err)
+		}
+
+		raw := strings.ToLower(recoveryEncoding.EncodeToString(bytes))
+		codes[index] = raw[:4] + "-" + raw[4:]
+		hashes[index] = hashRecoveryCode(codes[index])
+	}
+
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode normalises user input (case, dashes, spaces) before hashing.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return sec.HashToken(normalized)
+}
@@ -0,0 +1,495 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/url"
+	"strings"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+	"github.com/taibuivan/yomira/internal/users/auth/oauth"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # Social Login Service
+
+// OAuthService implements sign-in and account linking through external providers.
+//
+// Sessions are issued by the password [Service] so social logins share its
+// MFA gate, token families and logging.
+type OAuthService struct {
+	authService     *Service
+	providers       *oauth.Registry
+	linkRepository  OAuthLinkRepository
+	stateRepository OAuthStateRepository
+	appURL          string // Web app base URL the callback lands on
+	logger          *slog.Logger
+}
+
+// NewOAuthService constructs a new [OAuthService].
+func NewOAuthService(
+	authService *Service,
+	providers *oauth.Registry,
+	linkRepo OAuthLinkRepository,
+	stateRepo OAuthStateRepository,
+	appURL string,
+	logger *slog.Logger,
+) *OAuthService {
+	return &OAuthService{
+		authService:     authService,
+		providers:       providers,
+		linkRepository:  linkRepo,
+		stateRepository: stateRepo,
+		appURL:          strings.TrimSuffix(appURL, "/"),
+		logger:          logger,
+	}
+}
+
+// # Authorization Flow
+
+// OAuthStartInput describes a social login redirect request.
+type OAuthStartInput struct {
+	Provider     string
+	Action       OAuthAction
+	UserID       string // Required for OAuthActionLink
+	RedirectPath string
+}
+
+/*
+StartOAuth prepares a flow and returns the provider consent URL.
+
+Description: A random state (CSRF protection), a PKCE verifier and an OIDC
+nonce are generated and stored server-side for [OAuthStateTTL]. Only the
+state and the derived challenge travel through the browser. The landing
+path must be relative to the frontend so the callback cannot be turned
+into an open redirect.
+
+Parameters:
+  - context: context.Context
+  - input: OAuthStartInput
+
+Returns:
+  - string: Provider URL to redirect to
+  - error: NotFound (unknown provider), Unauthorized (link without a session) or validation errors
+*/
+func (service *OAuthService) StartOAuth(context context.Context, input OAuthStartInput) (string, error) {
+	provider, ok := service.providers.Get(input.Provider)
+	if !ok {
+		return "", apperr.NotFound("OAuth provider")
+	}
+
+	if input.Action == "" {
+		input.Action = OAuthActionLogin
+	}
+
+	validator := &validate.Validator{}
+	validator.OneOf(FieldAction, string(input.Action), string(OAuthActionLogin), string(OAuthActionLink))
+	validator.Custom(FieldRedirect, !isLocalPath(input.RedirectPath), "Must be a path on this site")
+	if err := validator.Err(); err != nil {
+		return "", err
+	}
+
+	if input.Action == OAuthActionLink && input.UserID == "" {
+		return "", apperr.Unauthorized("Authentication required")
+	}
+	if input.Action == OAuthActionLogin {
+		input.UserID = ""
+	}
+
+	// Flow secrets
+	state, err := sec.GenerateSecureToken(OAuthStateLength)
+	if err != nil {
+		return "", fmt.Errorf("auth_service_oauth_state_failed: %w", err)
+	}
+	nonce, err := sec.GenerateSecureToken(OAuthStateLength)
+	if err != nil {
+		return "", fmt.Errorf("auth_service_oauth_nonce_failed: %w", err)
+	}
+	verifier, err := oauth.NewVerifier()
+	if err != nil {
+		return "", fmt.Errorf("auth_service_oauth_verifier_failed: %w", err)
+	}
+
+	authURL, err := provider.AuthCodeURL(context, state, oauth.S256Challenge(verifier), nonce)
+	if err != nil {
+		service.logger.Error("auth_oauth_provider_unavailable", slog.String("provider", input.Provider), slog.Any("error", err))
+		return "", apperr.ServiceUnavailable("Sign-in provider is unavailable")
+	}
+
+	err = service.stateRepository.Create(context, state, &OAuthState{
+		Provider:     input.Provider,
+		Action:       input.Action,
+		UserID:       input.UserID,
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+		RedirectPath: input.RedirectPath,
+	}, OAuthStateTTL)
+	if err != nil {
+		return "", fmt.Errorf("auth_service_oauth_state_save_failed: %w", err)
+	}
+
+	return authURL, nil
+}
+
+// OAuthCallbackInput carries the provider redirect back to the API.
+type OAuthCallbackInput struct {
+	Provider      string
+	State         string
+	Code          string
+	ProviderError string // 'error' parameter, e.g. access_denied
+	UserAgent     string
+	IPAddress     string
+}
+
+// OAuthResult is the outcome of a callback.
+type OAuthResult struct {
+	Session      *LoginSession // Nil for OAuthActionLink
+	Action       OAuthAction
+	RedirectPath string
+}
+
+/*
+CompleteOAuth validates the callback and signs the user in or links the provider.
+
+Description: The state is consumed before anything else so a callback URL
+can never be replayed. For a login the provider account resolves to:
+
+ 1. The account it is already linked to.
+ 2. An existing account with the same email, when both the provider and
+    the account have verified that email. It is linked on the way.
+ 3. A new passwordless account otherwise.
+
+An unverified email on either side never links automatically: whoever
+registered first could otherwise take over the other identity.
+
+Parameters:
+  - context: context.Context
+  - input: OAuthCallbackInput
+
+Returns:
+  - *OAuthResult: Session or link outcome and the landing path
+  - error: Unauthorized (state or exchange), Conflict (unsafe email match) or storage failures
+*/
+func (service *OAuthService) CompleteOAuth(context context.Context, input OAuthCallbackInput) (*OAuthResult, error) {
+	state, err := service.stateRepository.Consume(context, input.State)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, apperr.Unauthorized("Sign-in request is invalid or expired")
+		}
+		return nil, err
+	}
+
+	result := &OAuthResult{Action: state.Action, RedirectPath: state.RedirectPath}
+
+	if state.Provider != input.Provider {
+		return result, apperr.Unauthorized("Sign-in request is invalid or expired")
+	}
+	if input.ProviderError != "" || input.Code == "" {
+		return result, apperr.Unauthorized("Sign-in was cancelled at the provider")
+	}
+
+	provider, ok := service.providers.Get(input.Provider)
+	if !ok {
+		return result, apperr.NotFound("OAuth provider")
+	}
+
+	// Code exchange with the PKCE verifier and ID token nonce
+	identity, err := provider.Exchange(context, input.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		service.logger.Warn("auth_oauth_exchange_failed",
+			slog.String("provider", input.Provider),
+			slog.String("ip_address", input.IPAddress),
+			slog.Any("error", err),
+		)
+		return result, apperr.Unauthorized("Sign-in with the provider failed")
+	}
+
+	sealedToken, err := service.authService.secretBox.Seal(identity.AccessToken)
+	if err != nil {
+		return result, fmt.Errorf("auth_service_oauth_seal_failed: %w", err)
+	}
+
+	if state.Action == OAuthActionLink {
+		return result, service.linkIdentity(context, state.UserID, identity, sealedToken)
+	}
+
+	user, err := service.resolveUser(context, identity, sealedToken)
+	if err != nil {
+		return result, err
+	}
+
+	// Same second factor gate as a password login
+	purpose, err := service.authService.mfaPurpose(context, user)
+	if err != nil {
+		return result, err
+	}
+	if purpose != "" {
+		// Invalid codes count against the same account scope as a password login
+		scope := accountThrottleScope(user.ID)
+		result.Session, err = service.authService.startMFAChallenge(context, user, purpose, scope, input.UserAgent, input.IPAddress)
+		return result, err
+	}
+
+	result.Session, err = service.authService.issueSession(context, user, input.UserAgent, input.IPAddress)
+	return result, err
+}
+
+// # Linked Providers
+
+/*
+ListLinkedProviders returns the providers linked to an account.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - []*OAuthLink: Linked providers, oldest first
+  - error: Retrieval failures
+*/
+func (service *OAuthService) ListLinkedProviders(context context.Context, userID string) ([]*OAuthLink, error) {
+	return service.linkRepository.ListByUser(context, userID)
+}
+
+/*
+UnlinkProvider removes a provider from an account.
+
+Description: Refused when the provider is the only remaining way to sign
+in, i.e. the account has no password and no other linked provider.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - provider: string
+
+Returns:
+  - error: NotFound (not linked), Conflict (last sign-in method) or storage failures
+*/
+func (service *OAuthService) UnlinkProvider(context context.Context, userID, provider string) error {
+	if err := service.linkRepository.Delete(context, userID, provider); err != nil {
+		return err
+	}
+
+	service.logger.Info("user_oauth_unlinked", slog.String("user_id", userID), slog.String("provider", provider))
+
+	return nil
+}
+
+/*
+LandingURL builds the web app URL a callback redirects the browser to.
+
+Description: Results travel in the fragment, which browsers never send to
+a server or leak through the Referer header.
+
+Parameters:
+  - redirectPath: string (Validated path from the flow state; empty = "/")
+  - fragment: url.Values
+
+Returns:
+  - string: Absolute URL on the web app
+*/
+func (service *OAuthService) LandingURL(redirectPath string, fragment url.Values) string {
+	if !isLocalPath(redirectPath) || redirectPath == "" {
+		redirectPath = "/"
+	}
+
+	landing := service.appURL + redirectPath
+	if len(fragment) > 0 {
+		landing += "#" + fragment.Encode()
+	}
+	return landing
+}
+
+// # Internal Helpers
+
+/*
+linkIdentity attaches a provider account to the signed-in user.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - identity: *oauth.Identity
+  - sealedToken: string
+
+Returns:
+  - error: Conflict if the provider account belongs to another user or the provider is already linked
+*/
+func (service *OAuthService) linkIdentity(context context.Context, userID string, identity *oauth.Identity, sealedToken string) error {
+	existing, err := service.linkRepository.FindByProviderID(context, identity.Provider, identity.Subject)
+	if err == nil {
+		if existing.UserID == userID {
+			return service.linkRepository.UpdateAccessToken(context, identity.Provider, identity.Subject, sealedToken)
+		}
+		return apperr.Conflict("This provider account is linked to another user")
+	}
+	if !apperr.IsNotFound(err) {
+		return err
+	}
+
+	if err := service.linkRepository.Create(context, newOAuthLink(userID, identity, sealedToken)); err != nil {
+		return err
+	}
+
+	service.logger.Info("user_oauth_linked", slog.String("user_id", userID), slog.String("provider", identity.Provider))
+
+	return nil
+}
+
+// resolveUser maps a provider identity to an account for a login, linking or creating it when needed.
+func (service *OAuthService) resolveUser(context context.Context, identity *oauth.Identity, sealedToken string) (*User, error) {
+	userRepository := service.authService.userRepository
+
+	// 1. Known provider account
+	link, err := service.linkRepository.FindByProviderID(context, identity.Provider, identity.Subject)
+	if err == nil {
+		if err := service.linkRepository.UpdateAccessToken(context, identity.Provider, identity.Subject, sealedToken); err != nil {
+			service.logger.Warn("auth_oauth_token_update_failed", slog.Any("error", err))
+		}
+
+		user, err := userRepository.FindByID(context, link.UserID)
+		if err != nil {
+			return nil, apperr.Unauthorized("User not found or suspended")
+		}
+		return user, nil
+	}
+	if !apperr.IsNotFound(err) {
+		return nil, err
+	}
+
+	if identity.Email == "" {
+		return nil, apperr.Unprocessable("The provider did not share an email address")
+	}
+
+	// 2. Existing account with the same email
+	user, err := userRepository.FindByEmail(context, identity.Email)
+	if err == nil {
+		if !identity.EmailVerified || !user.IsVerified {
+			return nil, apperr.Conflict("An account with this email already exists. Sign in with your password and link the provider from your settings.")
+		}
+
+		if err := service.linkRepository.Create(context, newOAuthLink(user.ID, identity, sealedToken)); err != nil {
+			return nil, err
+		}
+
+		service.logger.Info("user_oauth_linked", slog.String("user_id", user.ID), slog.String("provider", identity.Provider))
+		return user, nil
+	}
+	if !apperr.IsNotFound(err) {
+		return nil, err
+	}
+
+	// 3. New passwordless account
+	return service.createAccount(context, identity, sealedToken)
+}
+
+// createAccount registers a passwordless account, retrying with a suffix while the username is taken.
+func (service *OAuthService) createAccount(context context.Context, identity *oauth.Identity, sealedToken string) (*User, error) {
+	base := usernameFromIdentity(identity)
+
+	for attempt := 0; attempt < OAuthUsernameAttempts; attempt++ {
+		username := base
+		if attempt > 0 {
+			suffix, err := rand.Int(rand.Reader, big.NewInt(10000))
+			if err != nil {
+				return nil, fmt.Errorf("auth_service_oauth_username_failed: %w", err)
+			}
+			username = fmt.Sprintf("%s_%04d", base, suffix.Int64())
+		}
+
+		if _, err := service.authService.userRepository.FindByUsername(context, username); err == nil {
+			continue
+		}
+
+		user := &User{
+			ID:          uuid.New(),
+			Username:    username,
+			Email:       identity.Email,
+			DisplayName: firstNonEmpty(identity.Name, username),
+			AvatarURL:   identity.AvatarURL,
+			Role:        sec.RoleMember,
+			IsVerified:  identity.EmailVerified,
+		}
+
+		err := service.linkRepository.CreateWithUser(context, user, newOAuthLink(user.ID, identity, sealedToken))
+		if err == nil {
+			service.logger.Info("user_registered",
+				slog.String("user_id", user.ID),
+				slog.String("provider", identity.Provider),
+			)
+			return user, nil
+		}
+
+		// A concurrent registration took the name; anything else is final
+		if appErr := apperr.As(err); appErr == nil || appErr.Code != "CONFLICT" {
+			return nil, err
+		}
+	}
+
+	return nil, apperr.Conflict("Could not allocate a username, please try again")
+}
+
+// newOAuthLink builds the link row of a provider identity.
+func newOAuthLink(userID string, identity *oauth.Identity, sealedToken string) *OAuthLink {
+	return &OAuthLink{
+		UserID:      userID,
+		Provider:    identity.Provider,
+		ProviderID:  identity.Subject,
+		Email:       identity.Email,
+		AccessToken: sealedToken,
+	}
+}
+
+// usernameFromIdentity derives a username candidate from the provider name or email.
+func usernameFromIdentity(identity *oauth.Identity) string {
+	source := identity.Name
+	if local, _, ok := strings.Cut(identity.Email, "@"); ok && local != "" {
+		source = local
+	}
+
+	var builder strings.Builder
+	for _, char := range strings.ToLower(source) {
+		switch {
+		case char >= 'a' && char <= 'z', char >= '0' && char <= '9', char == '_':
+			builder.WriteRune(char)
+		case char == '.', char == '-', char == ' ':
+			builder.WriteRune('_')
+		}
+		if builder.Len() >= OAuthUsernameMaxLength {
+			break
+		}
+	}
+
+	username := strings.Trim(builder.String(), "_")
+	if len(username) < 3 {
+		username = "reader"
+	}
+	return username
+}
+
+// isLocalPath reports whether path is empty or a same-origin absolute path.
+func isLocalPath(path string) bool {
+	if path == "" {
+		return true
+	}
+	return strings.HasPrefix(path, "/") &&
+		!strings.HasPrefix(path, "//") &&
+		!strings.ContainsAny(path, "\\\r\n")
+}
+
+// firstNonEmpty returns the first non-empty string.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
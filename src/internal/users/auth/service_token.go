@@ -0,0 +1,229 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+	"github.com/taibuivan/yomira/internal/platform/validate"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # Personal Access Token Service
+
+// PersonalTokenService implements the lifecycle of personal access tokens.
+//
+// Tokens authenticate scripts and bots without a refresh cookie. They act
+// with the owner's current role, narrowed to the scopes granted at creation.
+type PersonalTokenService struct {
+	tokenRepository PersonalTokenRepository
+	logger          *slog.Logger
+}
+
+// NewPersonalTokenService constructs a new [PersonalTokenService].
+func NewPersonalTokenService(tokenRepo PersonalTokenRepository, logger *slog.Logger) *PersonalTokenService {
+	return &PersonalTokenService{
+		tokenRepository: tokenRepo,
+		logger:          logger,
+	}
+}
+
+// CreatePersonalTokenInput describes a new token.
+type CreatePersonalTokenInput struct {
+	Name          string
+	Scopes        []string
+	ExpiresInDays *int // Nil applies the default; 0 never expires
+}
+
+// CreatedPersonalToken pairs a new token with its secret, which is shown exactly once.
+type CreatedPersonalToken struct {
+	*PersonalAccessToken
+	Token string `json:"token"`
+}
+
+/*
+CreateToken issues a personal access token.
+
+Description: Only the SHA-256 of the secret is stored; the caller must copy
+the returned secret now. Scopes are deduplicated and must all be known.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - input: CreatePersonalTokenInput
+
+Returns:
+  - *CreatedPersonalToken: Token metadata and its secret
+  - error: Validation, Conflict (too many tokens) or storage errors
+*/
+func (service *PersonalTokenService) CreateToken(context context.Context, userID string, input CreatePersonalTokenInput) (*CreatedPersonalToken, error) {
+	input.Name = strings.TrimSpace(input.Name)
+
+	expiresInDays := PersonalTokenDefaultExpiryDays
+	if input.ExpiresInDays != nil {
+		expiresInDays = *input.ExpiresInDays
+	}
+
+	scopes := make([]sec.Scope, 0, len(input.Scopes))
+	unknownScope := false
+	for _, value := range input.Scopes {
+		scope := sec.Scope(value)
+		if !scope.IsValid() {
+			unknownScope = true
+			continue
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	validator := &validate.Validator{}
+	validator.Required(FieldName, input.Name).MaxLen(FieldName, input.Name, PersonalTokenNameMaxLength)
+	validator.Custom(FieldScopes, len(input.Scopes) == 0, "At least one scope is required")
+	validator.Custom(FieldScopes, unknownScope, "Contains an unknown scope")
+	validator.Range(FieldExpiresInDays, expiresInDays, 0, PersonalTokenMaxExpiryDays)
+	if err := validator.Err(); err != nil {
+		return nil, err
+	}
+
+	active, err := service.tokenRepository.CountActive(context, userID)
+	if err != nil {
+		return nil, fmt.Errorf("auth_service_token_count_failed: %w", err)
+	}
+	if active >= PersonalTokenMaxPerUser {
+		return nil, apperr.Conflict(fmt.Sprintf("You can have at most %d active tokens. Revoke one first.", PersonalTokenMaxPerUser))
+	}
+
+	random, err := sec.GenerateSecureToken(PersonalTokenLength)
+	if err != nil {
+		return nil, fmt.Errorf("auth_service_token_generate_failed: %w", err)
+	}
+	secret := sec.PersonalTokenPrefix + random
+
+	token := &PersonalAccessToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      input.Name,
+		Prefix:    secret[:PersonalTokenPrefixLength],
+		TokenHash: sec.HashToken(secret),
+		Scopes:    scopes,
+		CreatedAt: time.Now(),
+	}
+	if expiresInDays > 0 {
+		expiresAt := token.CreatedAt.AddDate(0, 0, expiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := service.tokenRepository.Create(context, token); err != nil {
+		return nil, fmt.Errorf("auth_service_token_create_failed: %w", err)
+	}
+
+	service.logger.Info("personal_token_created",
+		slog.String("user_id", userID),
+		slog.String("token_id", token.ID),
+		slog.Any("scopes", token.Scopes),
+	)
+
+	return &CreatedPersonalToken{PersonalAccessToken: token, Token: secret}, nil
+}
+
+/*
+ListTokens returns the unrevoked tokens of an account.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - []*PersonalAccessToken: Tokens (never their secrets)
+  - error: Retrieval failures
+*/
+func (service *PersonalTokenService) ListTokens(context context.Context, userID string) ([]*PersonalAccessToken, error) {
+	tokens, err := service.tokenRepository.ListByUser(context, userID)
+	if err != nil {
+		return nil, fmt.Errorf("auth_service_token_list_failed: %w", err)
+	}
+	return tokens, nil
+}
+
+/*
+RevokeToken disables a token immediately.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - tokenID: string
+
+Returns:
+  - error: NotFound or storage errors
+*/
+func (service *PersonalTokenService) RevokeToken(context context.Context, userID, tokenID string) error {
+	if err := service.tokenRepository.Revoke(context, userID, tokenID); err != nil {
+		return fmt.Errorf("auth_service_token_revoke_failed: %w", err)
+	}
+
+	service.logger.Info("personal_token_revoked", slog.String("user_id", userID), slog.String("token_id", tokenID))
+	return nil
+}
+
+/*
+VerifyPersonalToken authenticates a request made with a personal access token.
+
+Description: Implements [middleware.PersonalTokenVerifier]. The last-used
+time is written at most once per [PersonalTokenTouchInterval] so busy
+scripts do not turn every request into a database write; a failed write
+never fails the request.
+
+Parameters:
+  - context: context.Context
+  - token: string
+  - ipAddress: string
+
+Returns:
+  - *sec.AuthClaims: Owner identity narrowed to the token scopes
+  - error: Unauthorized for unknown, revoked or expired tokens; storage errors otherwise
+*/
+func (service *PersonalTokenService) VerifyPersonalToken(context context.Context, token, ipAddress string) (*sec.AuthClaims, error) {
+	stored, owner, err := service.tokenRepository.FindByHash(context, sec.HashToken(token))
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, apperr.Unauthorized("Invalid or expired token")
+		}
+		return nil, fmt.Errorf("auth_service_token_lookup_failed: %w", err)
+	}
+
+	now := time.Now()
+	if stored.IsExpired(now) {
+		return nil, apperr.Unauthorized("Invalid or expired token")
+	}
+
+	if stored.LastUsedAt == nil || now.Sub(*stored.LastUsedAt) >= PersonalTokenTouchInterval {
+		if err := service.tokenRepository.TouchLastUsed(context, stored.ID, now, ipAddress); err != nil {
+			service.logger.Warn("personal_token_touch_failed", slog.String("token_id", stored.ID), slog.Any("error", err))
+		}
+	}
+
+	claims := &sec.AuthClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: stored.UserID},
+		UserID:           stored.UserID,
+		Username:         owner.Username,
+		Role:             string(owner.Role),
+		TokenID:          stored.ID,
+		Scopes:           stored.Scopes,
+	}
+	if stored.ExpiresAt != nil {
+		claims.ExpiresAt = jwt.NewNumericDate(*stored.ExpiresAt)
+	}
+
+	return claims, nil
+}
@@ -144,6 +144,47 @@ type SessionRepository interface {
 	*/
 	FindByTokenHash(context context.Context, tokenHash string) (*Session, error)
 
+	/*
+		FindRotatedByTokenHash returns the session matching the token hash only if it has
+		already been rotated into a child, regardless of its revocation or expiry.
+
+		Parameters:
+		  - context: context.Context
+		  - tokenHash: string
+
+		Returns:
+		  - *Session: Hydrated entity
+		  - error: NotFound when the token was never rotated
+	*/
+	FindRotatedByTokenHash(context context.Context, tokenHash string) (*Session, error)
+
+	/*
+		Rotate atomically revokes the parent session and persists its child.
+
+		Parameters:
+		  - context: context.Context
+		  - parentID: string
+		  - child: *Session
+
+		Returns:
+		  - error: Unauthorized if the parent is no longer active, or persistence failures
+	*/
+	Rotate(context context.Context, parentID string, child *Session) error
+
+	/*
+		RevokeFamily revokes every session of a token family and records a security event.
+
+		Parameters:
+		  - context: context.Context
+		  - session: *Session (The replayed session)
+		  - ipAddress: string (Origin of the replay)
+
+		Returns:
+		  - int: Number of sessions revoked
+		  - error: Persistence failures
+	*/
+	RevokeFamily(context context.Context, session *Session, ipAddress string) (int, error)
+
 	/*
 		Revoke marks a specific session as permanently invalidated.
 
@@ -182,15 +223,21 @@ type SessionRepository interface {
 	RevokeOthers(context context.Context, userID, currentSessionID string) error
 
 	/*
-		DeleteExpired physically removes sessions whose ExpiresAt is in the past.
+		DeleteStale physically removes up to limit sessions that expired or were revoked before the cutoff.
+
+		Description: Rotated sessions are only removed once expired, so a
+		replayed refresh token is still recognised as reuse until then.
 
 		Parameters:
 		  - context: context.Context
+		  - cutoff: time.Time
+		  - limit: int
 
 		Returns:
+		  - int: Number of deleted sessions
 		  - error: Persistence failures
 	*/
-	DeleteExpired(context context.Context) error
+	DeleteStale(context context.Context, cutoff time.Time, limit int) (int, error)
 }
 
 // # Volatile Data Access
@@ -280,3 +327,506 @@ type VerificationTokenRepository interface {
 	*/
 	Delete(context context.Context, token string) error
 }
+
+// LoginThrottleRepository tracks failed logins per scope (account or IP) for brute-force protection.
+type LoginThrottleRepository interface {
+
+	/*
+		BlockedFor returns the longest remaining block among the given scopes.
+
+		Parameters:
+		  - context: context.Context
+		  - scopes: ...string
+
+		Returns:
+		  - time.Duration: Zero when no scope is blocked
+		  - error: Retrieval failures
+	*/
+	BlockedFor(context context.Context, scopes ...string) (time.Duration, error)
+
+	/*
+		RecordFailure increments the failure counter of a scope.
+
+		Parameters:
+		  - context: context.Context
+		  - scope: string
+		  - window: time.Duration (Counter lifetime, started by the first failure)
+
+		Returns:
+		  - int: Failures in the current window, including this one
+		  - error: Persistence failures
+	*/
+	RecordFailure(context context.Context, scope string, window time.Duration) (int, error)
+
+	/*
+		Block rejects every attempt for the scope during the given duration.
+
+		Parameters:
+		  - context: context.Context
+		  - scope: string
+		  - duration: time.Duration
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	Block(context context.Context, scope string, duration time.Duration) error
+
+	/*
+		Reset clears the failure counter and block of a scope.
+
+		Parameters:
+		  - context: context.Context
+		  - scope: string
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	Reset(context context.Context, scope string) error
+}
+
+// SuspensionRepository mirrors account suspensions into a store fast enough to
+// check on every authenticated request. The database stays the source of truth.
+type SuspensionRepository interface {
+
+	/*
+		Mark flags a user as suspended.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - ttl: time.Duration (Zero keeps the flag until cleared)
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	Mark(context context.Context, userID string, ttl time.Duration) error
+
+	/*
+		Clear lifts the flag of a user.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	Clear(context context.Context, userID string) error
+}
+
+// RevocationRepository records access tokens that must stop working before
+// they expire. The suspension flags of [SuspensionRepository] are read in the
+// same round trip.
+type RevocationRepository interface {
+
+	/*
+		RevokeToken denies one access token.
+
+		Parameters:
+		  - context: context.Context
+		  - tokenID: string (The jti claim)
+		  - ttl: time.Duration (Remaining lifetime of the token)
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	RevokeToken(context context.Context, tokenID string, ttl time.Duration) error
+
+	/*
+		RevokeBefore denies every access token of a user issued before a cutoff.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - cutoff: time.Time
+		  - ttl: time.Duration (Lifetime of the longest token the cutoff can affect)
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	RevokeBefore(context context.Context, userID string, cutoff time.Time, ttl time.Duration) error
+
+	/*
+		Lookup reads everything that can deny an access token.
+
+		Parameters:
+		  - context: context.Context
+		  - tokenID: string
+		  - userID: string
+
+		Returns:
+		  - AccessState: Revocation and suspension state
+		  - error: Retrieval failures
+	*/
+	Lookup(context context.Context, tokenID, userID string) (AccessState, error)
+}
+
+// # Two-Factor Data Access
+
+// MFARepository defines the data access contract for TOTP enrolments and recovery codes.
+type MFARepository interface {
+
+	/*
+		Find returns the enrolment of a user, pending or enabled.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - *MFA: Hydrated entity
+		  - error: NotFound when the user never started enrolment
+	*/
+	Find(context context.Context, userID string) (*MFA, error)
+
+	/*
+		SavePending stores a new secret awaiting confirmation, replacing any earlier pending one.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - sealedSecret: string
+
+		Returns:
+		  - error: Conflict if two-factor is already enabled, or persistence failures
+	*/
+	SavePending(context context.Context, userID, sealedSecret string) error
+
+	/*
+		Enable activates a pending enrolment and replaces the recovery codes.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - step: int64 (TOTP step of the confirming code)
+		  - codeHashes: []string
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	Enable(context context.Context, userID string, step int64, codeHashes []string) error
+
+	/*
+		UseStep records an accepted TOTP step unless it is not newer than the last one.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - step: int64
+
+		Returns:
+		  - bool: False when the code was already used (replay)
+		  - error: Persistence failures
+	*/
+	UseStep(context context.Context, userID string, step int64) (bool, error)
+
+	/*
+		UseRecoveryCode consumes an unused recovery code.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - codeHash: string
+
+		Returns:
+		  - bool: False when the code is unknown or already used
+		  - error: Persistence failures
+	*/
+	UseRecoveryCode(context context.Context, userID, codeHash string) (bool, error)
+
+	/*
+		ReplaceRecoveryCodes invalidates every recovery code and stores new ones.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - codeHashes: []string
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	ReplaceRecoveryCodes(context context.Context, userID string, codeHashes []string) error
+
+	/*
+		CountRecoveryCodes returns the number of unused recovery codes.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - int: Remaining codes
+		  - error: Retrieval failures
+	*/
+	CountRecoveryCodes(context context.Context, userID string) (int, error)
+
+	/*
+		Delete removes the enrolment and all recovery codes of a user.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	Delete(context context.Context, userID string) error
+}
+
+// MFAChallengeRepository defines the contract for volatile MFA challenges issued after a password check.
+type MFAChallengeRepository interface {
+
+	/*
+		Create stores a challenge under the given token.
+
+		Parameters:
+		  - context: context.Context
+		  - token: string
+		  - challenge: *MFAChallenge
+		  - ttl: time.Duration
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	Create(context context.Context, token string, challenge *MFAChallenge, ttl time.Duration) error
+
+	/*
+		Get retrieves a challenge without counting an attempt.
+
+		Parameters:
+		  - context: context.Context
+		  - token: string
+
+		Returns:
+		  - *MFAChallenge: Stored challenge
+		  - error: NotFound if the token is invalid or expired
+	*/
+	Get(context context.Context, token string) (*MFAChallenge, error)
+
+	/*
+		Attempt counts a code submission and retrieves the challenge.
+
+		Parameters:
+		  - context: context.Context
+		  - token: string
+
+		Returns:
+		  - *MFAChallenge: Stored challenge
+		  - int: Attempts so far, including this one
+		  - error: NotFound if the token is invalid or expired
+	*/
+	Attempt(context context.Context, token string) (*MFAChallenge, int, error)
+
+	/*
+		Delete removes a challenge once it is completed or exhausted.
+
+		Parameters:
+		  - context: context.Context
+		  - token: string
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	Delete(context context.Context, token string) error
+}
+
+// # Social Login Data Access
+
+// OAuthLinkRepository defines the data access contract for accounts linked to social login providers.
+type OAuthLinkRepository interface {
+
+	/*
+		FindByProviderID returns the link of a provider account.
+
+		Parameters:
+		  - context: context.Context
+		  - provider: string
+		  - providerID: string
+
+		Returns:
+		  - *OAuthLink: Hydrated link
+		  - error: NotFound if the provider account is not linked
+	*/
+	FindByProviderID(context context.Context, provider, providerID string) (*OAuthLink, error)
+
+	/*
+		ListByUser returns every provider linked to an account, oldest first.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - []*OAuthLink: Linked providers
+		  - error: Retrieval failures
+	*/
+	ListByUser(context context.Context, userID string) ([]*OAuthLink, error)
+
+	/*
+		Create links a provider account to an existing user.
+
+		Parameters:
+		  - context: context.Context
+		  - link: *OAuthLink
+
+		Returns:
+		  - error: Conflict if the provider account or the provider is already linked
+	*/
+	Create(context context.Context, link *OAuthLink) error
+
+	/*
+		CreateWithUser creates an account and its first link atomically.
+
+		Parameters:
+		  - context: context.Context
+		  - user: *User
+		  - link: *OAuthLink
+
+		Returns:
+		  - error: Conflict on a taken username, email or provider account
+	*/
+	CreateWithUser(context context.Context, user *User, link *OAuthLink) error
+
+	/*
+		UpdateAccessToken replaces the stored provider token after a sign-in.
+
+		Parameters:
+		  - context: context.Context
+		  - provider: string
+		  - providerID: string
+		  - sealedToken: string
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	UpdateAccessToken(context context.Context, provider, providerID, sealedToken string) error
+
+	/*
+		Delete unlinks a provider unless it is the account's last sign-in method.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - provider: string
+
+		Returns:
+		  - error: NotFound if not linked, Conflict if no other sign-in method remains
+	*/
+	Delete(context context.Context, userID, provider string) error
+}
+
+// OAuthStateRepository defines the contract for volatile social login flow state.
+type OAuthStateRepository interface {
+
+	/*
+		Create stores the state of a flow under its state parameter.
+
+		Parameters:
+		  - context: context.Context
+		  - state: string
+		  - value: *OAuthState
+		  - ttl: time.Duration
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	Create(context context.Context, state string, value *OAuthState, ttl time.Duration) error
+
+	/*
+		Consume retrieves and deletes the state so a callback can never be replayed.
+
+		Parameters:
+		  - context: context.Context
+		  - state: string
+
+		Returns:
+		  - *OAuthState: Stored state
+		  - error: NotFound if the state is invalid, used or expired
+	*/
+	Consume(context context.Context, state string) (*OAuthState, error)
+}
+
+// # Personal Access Token Data Access
+
+// PersonalTokenRepository defines the data access contract for personal access tokens.
+type PersonalTokenRepository interface {
+
+	/*
+		FindByHash returns an unrevoked token and its owner by the hash of its secret.
+
+		Parameters:
+		  - context: context.Context
+		  - tokenHash: string
+
+		Returns:
+		  - *PersonalAccessToken: Hydrated token (possibly expired)
+		  - *PersonalTokenOwner: Current username and role of the owner
+		  - error: NotFound if unknown, revoked or the owner is deleted
+	*/
+	FindByHash(context context.Context, tokenHash string) (*PersonalAccessToken, *PersonalTokenOwner, error)
+
+	/*
+		ListByUser returns the unrevoked tokens of an account, newest first.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - []*PersonalAccessToken: Tokens, including expired ones
+		  - error: Retrieval failures
+	*/
+	ListByUser(context context.Context, userID string) ([]*PersonalAccessToken, error)
+
+	/*
+		CountActive counts the unrevoked, unexpired tokens of an account.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+
+		Returns:
+		  - int: Number of usable tokens
+		  - error: Retrieval failures
+	*/
+	CountActive(context context.Context, userID string) (int, error)
+
+	/*
+		Create persists a new token.
+
+		Parameters:
+		  - context: context.Context
+		  - token: *PersonalAccessToken
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	Create(context context.Context, token *PersonalAccessToken) error
+
+	/*
+		Revoke disables a token of the given account.
+
+		Parameters:
+		  - context: context.Context
+		  - userID: string
+		  - tokenID: string
+
+		Returns:
+		  - error: NotFound if the token does not exist or is already revoked
+	*/
+	Revoke(context context.Context, userID, tokenID string) error
+
+	/*
+		TouchLastUsed records when and from where a token was last used.
+
+		Parameters:
+		  - context: context.Context
+		  - tokenID: string
+		  - usedAt: time.Time
+		  - ipAddress: string
+
+		Returns:
+		  - error: Persistence failures
+	*/
+	TouchLastUsed(context context.Context, tokenID string, usedAt time.Time, ipAddress string) error
+}
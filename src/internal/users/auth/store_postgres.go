@@ -28,6 +28,7 @@ import (
 
 	"github.com/taibuivan/yomira/internal/platform/apperr"
 	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/pkg/uuid"
 )
 
 // # User Repository
@@ -37,6 +38,12 @@ type PostgresUserRepository struct {
 	pool *pgxpool.Pool
 }
 
+// passwordColumn reads the password hash of accounts created through social login as "".
+var passwordColumn = fmt.Sprintf("COALESCE(%s, '')", schema.UserAccount.Password)
+
+// suspendedColumn reads isactive as the [User.Suspended] flag.
+var suspendedColumn = fmt.Sprintf("NOT %s", schema.UserAccount.IsActive)
+
 // NewUserRepository creates a new PostgreSQL implementation of the UserRepository.
 func NewUserRepository(pool *pgxpool.Pool) *PostgresUserRepository {
 	return &PostgresUserRepository{pool: pool}
@@ -46,7 +53,7 @@ func NewUserRepository(pool *pgxpool.Pool) *PostgresUserRepository {
 Create persists a new user record into the users.account table.
 
 Description: Deep-persists account metadata, ensuring timestamps are initialized
-if not provided.
+if not provided. An empty PasswordHash is stored as NULL (social login only).
 
 Parameters:
   - context: context.Context
@@ -56,38 +63,7 @@ Returns:
   - error: Database constraint violations or connectivity errors
 */
 func (repository *PostgresUserRepository) Create(context context.Context, user *User) error {
-	query := fmt.Sprintf(`
-		INSERT INTO %s (
-			%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
-		schema.UserAccount.Table,
-		schema.UserAccount.ID, schema.UserAccount.Username, schema.UserAccount.Email,
-		schema.UserAccount.Password, schema.UserAccount.DisplayName, schema.UserAccount.AvatarURL,
-		schema.UserAccount.Bio, schema.UserAccount.Website, schema.UserAccount.Role,
-		schema.UserAccount.IsVerified, schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
-	)
-
-	now := time.Now()
-	if user.CreatedAt.IsZero() {
-		user.CreatedAt = now
-	}
-	user.UpdatedAt = now
-
-	_, err := repository.pool.Exec(context, query,
-		user.ID,
-		user.Username,
-		user.Email,
-		user.PasswordHash,
-		user.DisplayName,
-		user.AvatarURL,
-		user.Bio,
-		user.Website,
-		user.Role,
-		user.IsVerified,
-		user.CreatedAt,
-		user.UpdatedAt,
-	)
-
+	_, err := repository.pool.Exec(context, userInsertQuery(), userInsertArgs(user)...)
 	if err != nil {
 		return fmt.Errorf("postgres_user_repo_create_failed: %w", err)
 	}
@@ -110,13 +86,14 @@ Returns:
 */
 func (repository *PostgresUserRepository) FindByEmail(context context.Context, email string) (*User, error) {
 	query := fmt.Sprintf(`
-		SELECT %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s
+		SELECT %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s
 		FROM %s
 		WHERE %s = $1 AND %s IS NULL`,
 		schema.UserAccount.ID, schema.UserAccount.Username, schema.UserAccount.Email,
-		schema.UserAccount.Password, schema.UserAccount.DisplayName, schema.UserAccount.AvatarURL,
+		passwordColumn, schema.UserAccount.DisplayName, schema.UserAccount.AvatarURL,
 		schema.UserAccount.Bio, schema.UserAccount.Website, schema.UserAccount.Role,
-		schema.UserAccount.IsVerified, schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
+		schema.UserAccount.IsVerified, suspendedColumn, schema.UserAccount.SuspendedUntil,
+		schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
 		schema.UserAccount.Table, schema.UserAccount.Email, schema.UserAccount.DeletedAt,
 	)
 
@@ -132,6 +109,8 @@ func (repository *PostgresUserRepository) FindByEmail(context context.Context, e
 		&user.Website,
 		&user.Role,
 		&user.IsVerified,
+		&user.Suspended,
+		&user.SuspendedUntil,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -161,13 +140,14 @@ Returns:
 */
 func (repository *PostgresUserRepository) FindByUsername(context context.Context, username string) (*User, error) {
 	query := fmt.Sprintf(`
-		SELECT %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s
+		SELECT %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s
 		FROM %s
 		WHERE %s = $1 AND %s IS NULL`,
 		schema.UserAccount.ID, schema.UserAccount.Username, schema.UserAccount.Email,
-		schema.UserAccount.Password, schema.UserAccount.DisplayName, schema.UserAccount.AvatarURL,
+		passwordColumn, schema.UserAccount.DisplayName, schema.UserAccount.AvatarURL,
 		schema.UserAccount.Bio, schema.UserAccount.Website, schema.UserAccount.Role,
-		schema.UserAccount.IsVerified, schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
+		schema.UserAccount.IsVerified, suspendedColumn, schema.UserAccount.SuspendedUntil,
+		schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
 		schema.UserAccount.Table, schema.UserAccount.Username, schema.UserAccount.DeletedAt,
 	)
 
@@ -183,6 +163,8 @@ func (repository *PostgresUserRepository) FindByUsername(context context.Context
 		&user.Website,
 		&user.Role,
 		&user.IsVerified,
+		&user.Suspended,
+		&user.SuspendedUntil,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -212,13 +194,14 @@ Returns:
 */
 func (repository *PostgresUserRepository) FindByID(context context.Context, id string) (*User, error) {
 	query := fmt.Sprintf(`
-		SELECT %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s
+		SELECT %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s
 		FROM %s
 		WHERE %s = $1 AND %s IS NULL`,
 		schema.UserAccount.ID, schema.UserAccount.Username, schema.UserAccount.Email,
-		schema.UserAccount.Password, schema.UserAccount.DisplayName, schema.UserAccount.AvatarURL,
+		passwordColumn, schema.UserAccount.DisplayName, schema.UserAccount.AvatarURL,
 		schema.UserAccount.Bio, schema.UserAccount.Website, schema.UserAccount.Role,
-		schema.UserAccount.IsVerified, schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
+		schema.UserAccount.IsVerified, suspendedColumn, schema.UserAccount.SuspendedUntil,
+		schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
 		schema.UserAccount.Table, schema.UserAccount.ID, schema.UserAccount.DeletedAt,
 	)
 
@@ -234,6 +217,8 @@ func (repository *PostgresUserRepository) FindByID(context context.Context, id s
 		&user.Website,
 		&user.Role,
 		&user.IsVerified,
+		&user.Suspended,
+		&user.SuspendedUntil,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -386,31 +371,16 @@ Returns:
   - error: Storage failures
 */
 func (repository *PostgresSessionRepository) Create(context context.Context, session *Session) error {
-	query := fmt.Sprintf(`
-		INSERT INTO %s (
-			%s, %s, %s, %s, %s, %s, %s, %s
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
-		schema.UserSession.Table,
-		schema.UserSession.ID, schema.UserSession.UserID, schema.UserSession.TokenHash,
-		schema.UserSession.UserAgent, schema.UserSession.IPAddress, schema.UserSession.ExpiresAt,
-		schema.UserSession.IsRevoked, schema.UserSession.CreatedAt,
-	)
-
 	if session.CreatedAt.IsZero() {
 		session.CreatedAt = time.Now()
 	}
 
-	_, err := repository.pool.Exec(context, query,
-		session.ID,
-		session.UserID,
-		session.TokenHash,
-		session.UserAgent,
-		session.IPAddress,
-		session.ExpiresAt,
-		session.IsRevoked,
-		session.CreatedAt,
-	)
+	// A session without a parent is the root of its own family
+	if session.FamilyID == "" {
+		session.FamilyID = session.ID
+	}
 
+	_, err := repository.pool.Exec(context, sessionInsertQuery(), sessionInsertArgs(session)...)
 	if err != nil {
 		return fmt.Errorf("postgres_session_repo_create_failed: %w", err)
 	}
@@ -433,27 +403,16 @@ Returns:
 */
 func (repository *PostgresSessionRepository) FindByTokenHash(context context.Context, tokenHash string) (*Session, error) {
 	query := fmt.Sprintf(`
-		SELECT %s, %s, %s, %s, %s, %s, %s, %s
+		SELECT %s
 		FROM %s
 		WHERE %s = $1 AND %s = FALSE AND %s > NOW()`,
-		schema.UserSession.ID, schema.UserSession.UserID, schema.UserSession.TokenHash,
-		schema.UserSession.UserAgent, schema.UserSession.IPAddress, schema.UserSession.ExpiresAt,
-		schema.UserSession.IsRevoked, schema.UserSession.CreatedAt,
+		sessionProjection(),
 		schema.UserSession.Table,
 		schema.UserSession.TokenHash, schema.UserSession.IsRevoked, schema.UserSession.ExpiresAt,
 	)
 
 	session := &Session{}
-	err := repository.pool.QueryRow(context, query, tokenHash).Scan(
-		&session.ID,
-		&session.UserID,
-		&session.TokenHash,
-		&session.UserAgent,
-		&session.IPAddress,
-		&session.ExpiresAt,
-		&session.IsRevoked,
-		&session.CreatedAt,
-	)
+	err := repository.pool.QueryRow(context, query, tokenHash).Scan(sessionScanTargets(session)...)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -465,6 +424,164 @@ func (repository *PostgresSessionRepository) FindByTokenHash(context context.Con
 	return session, nil
 }
 
+/*
+FindRotatedByTokenHash retrieves a session that has already been rotated.
+
+Description: Used after an active lookup failed to tell a replayed refresh
+token apart from an unknown, expired or logged-out one. A session counts as
+rotated once a child session references it as its parent.
+
+Parameters:
+  - context: context.Context
+  - tokenHash: string
+
+Returns:
+  - *Session: Hydrated session metadata
+  - error: apperr.NotFound or execution errors
+*/
+func (repository *PostgresSessionRepository) FindRotatedByTokenHash(context context.Context, tokenHash string) (*Session, error) {
+	query := fmt.Sprintf(`
+		SELECT %[1]s
+		FROM %[2]s parent
+		WHERE parent.%[3]s = $1
+		  AND EXISTS (SELECT 1 FROM %[2]s child WHERE child.%[4]s = parent.%[5]s)`,
+		sessionProjection(),          // 1
+		schema.UserSession.Table,     // 2
+		schema.UserSession.TokenHash, // 3
+		schema.UserSession.ParentID,  // 4
+		schema.UserSession.ID,        // 5
+	)
+
+	session := &Session{}
+	err := repository.pool.QueryRow(context, query, tokenHash).Scan(sessionScanTargets(session)...)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("Session")
+		}
+		return nil, fmt.Errorf("postgres_session_repo_find_rotated_failed: %w", err)
+	}
+
+	return session, nil
+}
+
+/*
+Rotate replaces a session with its child inside a single transaction.
+
+Description: The parent is revoked only if it is still active, so two
+concurrent refreshes with the same token cannot both succeed.
+
+Parameters:
+  - context: context.Context
+  - parentID: string
+  - child: *Session
+
+Returns:
+  - error: Unauthorized when the parent was already rotated, or storage failures
+*/
+func (repository *PostgresSessionRepository) Rotate(context context.Context, parentID string, child *Session) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres_session_repo_rotate_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Conditional revocation of the parent
+	revokeQuery := fmt.Sprintf(`
+		UPDATE %s SET %s = TRUE, %s = NOW()
+		WHERE %s = $1 AND %s = FALSE AND %s > NOW()`,
+		schema.UserSession.Table, schema.UserSession.IsRevoked, schema.UserSession.RevokedAt,
+		schema.UserSession.ID, schema.UserSession.IsRevoked, schema.UserSession.ExpiresAt,
+	)
+
+	tag, err := transaction.Exec(context, revokeQuery, parentID)
+	if err != nil {
+		return fmt.Errorf("postgres_session_repo_rotate_revoke_failed: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.Unauthorized("Invalid or expired refresh token")
+	}
+
+	// Child session
+	if child.CreatedAt.IsZero() {
+		child.CreatedAt = time.Now()
+	}
+
+	if _, err := transaction.Exec(context, sessionInsertQuery(), sessionInsertArgs(child)...); err != nil {
+		return fmt.Errorf("postgres_session_repo_rotate_create_failed: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres_session_repo_rotate_commit_failed: %w", err)
+	}
+
+	return nil
+}
+
+/*
+RevokeFamily revokes every session descending from the same login.
+
+Description: Response to refresh token reuse. Both the attacker and the
+legitimate user lose the family and must sign in again. The event is
+recorded in the audit log within the same transaction.
+
+Parameters:
+  - context: context.Context
+  - session: *Session (The replayed session)
+  - ipAddress: string (Origin of the replay)
+
+Returns:
+  - int: Number of sessions revoked
+  - error: Storage failures
+*/
+func (repository *PostgresSessionRepository) RevokeFamily(context context.Context, session *Session, ipAddress string) (int, error) {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return 0, fmt.Errorf("postgres_session_repo_revoke_family_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Family revocation (root sessions carry their own ID, so familyid is always set)
+	revokeQuery := fmt.Sprintf(`
+		UPDATE %[1]s SET %[2]s = TRUE, %[3]s = COALESCE(%[3]s, NOW())
+		WHERE %[4]s = $1 AND %[2]s = FALSE`,
+		schema.UserSession.Table,     // 1
+		schema.UserSession.IsRevoked, // 2
+		schema.UserSession.RevokedAt, // 3
+		schema.UserSession.FamilyID,  // 4
+	)
+
+	tag, err := transaction.Exec(context, revokeQuery, session.FamilyID)
+	if err != nil {
+		return 0, fmt.Errorf("postgres_session_repo_revoke_family_failed: %w", err)
+	}
+
+	// Security event
+	auditQuery := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES ($1, $2, 'auth.refresh_token_reuse', 'session_family', $3, $4, NULLIF($5, '')::inet, NOW())`,
+		schema.SystemAuditLog.Table,
+		schema.SystemAuditLog.ID, schema.SystemAuditLog.ActorID, schema.SystemAuditLog.Action,
+		schema.SystemAuditLog.EntityType, schema.SystemAuditLog.EntityID,
+		schema.SystemAuditLog.After, schema.SystemAuditLog.IPAddress, schema.SystemAuditLog.CreatedAt,
+	)
+
+	after := map[string]any{
+		"replayed_session_id": session.ID,
+		"sessions_revoked":    tag.RowsAffected(),
+	}
+
+	if _, err := transaction.Exec(context, auditQuery, uuid.New(), session.UserID, session.FamilyID, after, ipAddress); err != nil {
+		return 0, fmt.Errorf("postgres_session_repo_audit_failed: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return 0, fmt.Errorf("postgres_session_repo_revoke_family_commit_failed: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
 /*
 Revoke marks a specific session as revoked.
 
@@ -476,8 +593,9 @@ Returns:
   - error: Revocation failures
 */
 func (repository *PostgresSessionRepository) Revoke(context context.Context, sessionID string) error {
-	query := fmt.Sprintf("UPDATE %s SET %s = TRUE WHERE %s = $1",
-		schema.UserSession.Table, schema.UserSession.IsRevoked, schema.UserSession.ID)
+	query := fmt.Sprintf("UPDATE %s SET %s = TRUE, %s = COALESCE(%s, NOW()) WHERE %s = $1",
+		schema.UserSession.Table, schema.UserSession.IsRevoked, schema.UserSession.RevokedAt, schema.UserSession.RevokedAt,
+		schema.UserSession.ID)
 	_, err := repository.pool.Exec(context, query, sessionID)
 	if err != nil {
 		return fmt.Errorf("postgres_session_repo_revoke_failed: %w", err)
@@ -498,8 +616,9 @@ Returns:
   - error: Batch revocation failures
 */
 func (repository *PostgresSessionRepository) RevokeAll(context context.Context, userID string) error {
-	query := fmt.Sprintf("UPDATE %s SET %s = TRUE WHERE %s = $1 AND %s = FALSE",
-		schema.UserSession.Table, schema.UserSession.IsRevoked, schema.UserSession.UserID, schema.UserSession.IsRevoked)
+	query := fmt.Sprintf("UPDATE %s SET %s = TRUE, %s = NOW() WHERE %s = $1 AND %s = FALSE",
+		schema.UserSession.Table, schema.UserSession.IsRevoked, schema.UserSession.RevokedAt,
+		schema.UserSession.UserID, schema.UserSession.IsRevoked)
 	_, err := repository.pool.Exec(context, query, userID)
 	if err != nil {
 		return fmt.Errorf("postgres_session_repo_revoke_all_failed: %w", err)
@@ -519,8 +638,8 @@ Returns:
   - error: Filtered revocation failures
 */
 func (repository *PostgresSessionRepository) RevokeOthers(context context.Context, userID, currentSessionID string) error {
-	query := fmt.Sprintf("UPDATE %s SET %s = TRUE WHERE %s = $1 AND %s != $2 AND %s = FALSE",
-		schema.UserSession.Table, schema.UserSession.IsRevoked, schema.UserSession.UserID,
+	query := fmt.Sprintf("UPDATE %s SET %s = TRUE, %s = NOW() WHERE %s = $1 AND %s != $2 AND %s = FALSE",
+		schema.UserSession.Table, schema.UserSession.IsRevoked, schema.UserSession.RevokedAt, schema.UserSession.UserID,
 		schema.UserSession.ID, schema.UserSession.IsRevoked)
 	_, err := repository.pool.Exec(context, query, userID, currentSessionID)
 	if err != nil {
@@ -530,21 +649,144 @@ func (repository *PostgresSessionRepository) RevokeOthers(context context.Contex
 }
 
 /*
-DeleteExpired permanently removes all sessions that have passed their expiration.
+DeleteStale permanently removes one batch of dead sessions.
 
-Description: Cleanup task to reclaim storage from stale sessions.
+Description: A session is dead once it expired or was revoked before the
+cutoff. Legacy revocations without a timestamp fall back to their creation
+time. Rotated sessions, those referenced by a child, are kept until they
+expire: their token may still be replayed until then, and reuse detection
+needs the row to recognise it. Batching keeps each DELETE short so logins
+are never blocked.
 
 Parameters:
   - context: context.Context
+  - cutoff: time.Time (End of the retention window)
+  - limit: int (Maximum rows per statement)
 
 Returns:
+  - int: Number of deleted sessions
   - error: Cleanup failures
 */
-func (repository *PostgresSessionRepository) DeleteExpired(context context.Context) error {
-	query := fmt.Sprintf("DELETE FROM %s WHERE %s <= NOW()", schema.UserSession.Table, schema.UserSession.ExpiresAt)
-	_, err := repository.pool.Exec(context, query)
+func (repository *PostgresSessionRepository) DeleteStale(context context.Context, cutoff time.Time, limit int) (int, error) {
+	query := fmt.Sprintf(`
+		DELETE FROM %[1]s
+		WHERE %[2]s IN (
+			SELECT s.%[2]s FROM %[1]s s
+			WHERE s.%[3]s < $1
+			   OR (
+				(s.%[4]s < $1 OR (s.%[5]s = TRUE AND s.%[4]s IS NULL AND s.%[6]s < $1))
+				AND NOT EXISTS (SELECT 1 FROM %[1]s child WHERE child.%[7]s = s.%[2]s)
+			   )
+			LIMIT $2
+		)`,
+		schema.UserSession.Table,     // 1
+		schema.UserSession.ID,        // 2
+		schema.UserSession.ExpiresAt, // 3
+		schema.UserSession.RevokedAt, // 4
+		schema.UserSession.IsRevoked, // 5
+		schema.UserSession.CreatedAt, // 6
+		schema.UserSession.ParentID,  // 7
+	)
+
+	tag, err := repository.pool.Exec(context, query, cutoff, limit)
 	if err != nil {
-		return fmt.Errorf("postgres_session_repo_delete_expired_failed: %w", err)
+		return 0, fmt.Errorf("postgres_session_repo_delete_stale_failed: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// # Internal Helpers
+
+// sessionProjection returns the shared SELECT list for session queries.
+func sessionProjection() string {
+	return fmt.Sprintf("%s, %s, %s, %s, COALESCE(%s, %s), %s, %s, %s, %s, %s",
+		schema.UserSession.ID, schema.UserSession.UserID, schema.UserSession.TokenHash,
+		schema.UserSession.ParentID, schema.UserSession.FamilyID, schema.UserSession.ID,
+		schema.UserSession.UserAgent, schema.UserSession.IPAddress, schema.UserSession.ExpiresAt,
+		schema.UserSession.IsRevoked, schema.UserSession.CreatedAt,
+	)
+}
+
+// sessionScanTargets returns the scan destinations matching [sessionProjection].
+func sessionScanTargets(session *Session) []any {
+	return []any{
+		&session.ID,
+		&session.UserID,
+		&session.TokenHash,
+		&session.ParentID,
+		&session.FamilyID,
+		&session.UserAgent,
+		&session.IPAddress,
+		&session.ExpiresAt,
+		&session.IsRevoked,
+		&session.CreatedAt,
+	}
+}
+
+// sessionInsertQuery returns the INSERT statement matching [sessionInsertArgs].
+func sessionInsertQuery() string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (
+			%s, %s, %s, %s, %s, %s, %s, %s, %s, %s
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		schema.UserSession.Table,
+		schema.UserSession.ID, schema.UserSession.UserID, schema.UserSession.TokenHash,
+		schema.UserSession.ParentID, schema.UserSession.FamilyID,
+		schema.UserSession.UserAgent, schema.UserSession.IPAddress, schema.UserSession.ExpiresAt,
+		schema.UserSession.IsRevoked, schema.UserSession.CreatedAt,
+	)
+}
+
+// sessionInsertArgs returns the positional arguments for [sessionInsertQuery].
+func sessionInsertArgs(session *Session) []any {
+	return []any{
+		session.ID,
+		session.UserID,
+		session.TokenHash,
+		session.ParentID,
+		session.FamilyID,
+		session.UserAgent,
+		session.IPAddress,
+		session.ExpiresAt,
+		session.IsRevoked,
+		session.CreatedAt,
+	}
+}
+
+// userInsertQuery builds the INSERT statement shared by account creation paths.
+func userInsertQuery() string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (
+			%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s
+		) VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7, $8, $9, $10, $11, $12)`,
+		schema.UserAccount.Table,
+		schema.UserAccount.ID, schema.UserAccount.Username, schema.UserAccount.Email,
+		schema.UserAccount.Password, schema.UserAccount.DisplayName, schema.UserAccount.AvatarURL,
+		schema.UserAccount.Bio, schema.UserAccount.Website, schema.UserAccount.Role,
+		schema.UserAccount.IsVerified, schema.UserAccount.CreatedAt, schema.UserAccount.UpdatedAt,
+	)
+}
+
+// userInsertArgs initialises the timestamps and returns the arguments for [userInsertQuery].
+func userInsertArgs(user *User) []any {
+	now := time.Now()
+	if user.CreatedAt.IsZero() {
+		user.CreatedAt = now
+	}
+	user.UpdatedAt = now
+
+	return []any{
+		user.ID,
+		user.Username,
+		user.Email,
+		user.PasswordHash,
+		user.DisplayName,
+		user.AvatarURL,
+		user.Bio,
+		user.Website,
+		user.Role,
+		user.IsVerified,
+		user.CreatedAt,
+		user.UpdatedAt,
 	}
-	return nil
 }
@@ -0,0 +1,338 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/pkg/uuid"
+)
+
+// # MFA Repository
+
+// PostgresMFARepository implements the MFARepository interface using pgx.
+type PostgresMFARepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMFARepository creates a new PostgreSQL implementation of MFARepository.
+func NewMFARepository(pool *pgxpool.Pool) *PostgresMFARepository {
+	return &PostgresMFARepository{pool: pool}
+}
+
+/*
+Find retrieves the enrolment of a user.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - *MFA: Hydrated enrolment
+  - error: apperr.NotFound or execution errors
+*/
+func (repository *PostgresMFARepository) Find(context context.Context, userID string) (*MFA, error) {
+	query := fmt.Sprintf(`
+		SELECT %s, %s, %s, %s, %s, %s, %s
+		FROM %s
+		WHERE %s = $1`,
+		schema.UserMFA.UserID, schema.UserMFA.Secret, schema.UserMFA.IsEnabled,
+		schema.UserMFA.LastUsedStep, schema.UserMFA.EnabledAt, schema.UserMFA.CreatedAt,
+		schema.UserMFA.UpdatedAt,
+		schema.UserMFA.Table,
+		schema.UserMFA.UserID,
+	)
+
+	mfa := &MFA{}
+	err := repository.pool.QueryRow(context, query, userID).Scan(
+		&mfa.UserID,
+		&mfa.Secret,
+		&mfa.IsEnabled,
+		&mfa.LastUsedStep,
+		&mfa.EnabledAt,
+		&mfa.CreatedAt,
+		&mfa.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("Two-factor enrolment")
+		}
+		return nil, fmt.Errorf("postgres_mfa_repo_find_failed: %w", err)
+	}
+
+	return mfa, nil
+}
+
+/*
+SavePending stores a secret awaiting its first code.
+
+Description: Restarting enrolment replaces the pending secret. An enabled
+enrolment is never overwritten; it must be disabled first.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - sealedSecret: string
+
+Returns:
+  - error: apperr.Conflict or execution errors
+*/
+func (repository *PostgresMFARepository) SavePending(context context.Context, userID, sealedSecret string) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s, %[6]s, %[7]s)
+		VALUES ($1, $2, FALSE, NULL, NOW(), NOW())
+		ON CONFLICT (%[2]s) DO UPDATE
+		SET %[3]s = EXCLUDED.%[3]s, %[5]s = NULL, %[7]s = NOW()
+		WHERE %[1]s.%[4]s = FALSE`,
+		schema.UserMFA.Table,        // 1
+		schema.UserMFA.UserID,       // 2
+		schema.UserMFA.Secret,       // 3
+		schema.UserMFA.IsEnabled,    // 4
+		schema.UserMFA.LastUsedStep, // 5
+		schema.UserMFA.CreatedAt,    // 6
+		schema.UserMFA.UpdatedAt,    // 7
+	)
+
+	tag, err := repository.pool.Exec(context, query, userID, sealedSecret)
+	if err != nil {
+		return fmt.Errorf("postgres_mfa_repo_save_pending_failed: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.Conflict("Two-factor authentication is already enabled")
+	}
+
+	return nil
+}
+
+/*
+Enable activates a pending enrolment and stores its first recovery codes.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - step: int64
+  - codeHashes: []string
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *PostgresMFARepository) Enable(context context.Context, userID string, step int64, codeHashes []string) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres_mfa_repo_enable_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET %s = TRUE, %s = $2, %s = NOW(), %s = NOW()
+		WHERE %s = $1 AND %s = FALSE`,
+		schema.UserMFA.Table,
+		schema.UserMFA.IsEnabled, schema.UserMFA.LastUsedStep, schema.UserMFA.EnabledAt, schema.UserMFA.UpdatedAt,
+		schema.UserMFA.UserID, schema.UserMFA.IsEnabled,
+	)
+
+	tag, err := transaction.Exec(context, query, userID, step)
+	if err != nil {
+		return fmt.Errorf("postgres_mfa_repo_enable_failed: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.Conflict("Two-factor authentication is already enabled")
+	}
+
+	if err := replaceRecoveryCodes(context, transaction, userID, codeHashes); err != nil {
+		return err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres_mfa_repo_enable_commit_failed: %w", err)
+	}
+
+	return nil
+}
+
+/*
+UseStep advances the last accepted TOTP step.
+
+Description: The conditional update makes a code single-use even when two
+requests race with the same value.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - step: int64
+
+Returns:
+  - bool: False on replay
+  - error: Execution errors
+*/
+func (repository *PostgresMFARepository) UseStep(context context.Context, userID string, step int64) (bool, error) {
+	query := fmt.Sprintf(`
+		UPDATE %[1]s SET %[2]s = $2, %[3]s = NOW()
+		WHERE %[4]s = $1 AND %[5]s = TRUE AND (%[2]s IS NULL OR %[2]s < $2)`,
+		schema.UserMFA.Table,        // 1
+		schema.UserMFA.LastUsedStep, // 2
+		schema.UserMFA.UpdatedAt,    // 3
+		schema.UserMFA.UserID,       // 4
+		schema.UserMFA.IsEnabled,    // 5
+	)
+
+	tag, err := repository.pool.Exec(context, query, userID, step)
+	if err != nil {
+		return false, fmt.Errorf("postgres_mfa_repo_use_step_failed: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+/*
+UseRecoveryCode marks a recovery code as used.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - codeHash: string
+
+Returns:
+  - bool: False when the code is unknown or already used
+  - error: Execution errors
+*/
+func (repository *PostgresMFARepository) UseRecoveryCode(context context.Context, userID, codeHash string) (bool, error) {
+	query := fmt.Sprintf(`
+		UPDATE %s SET %s = NOW()
+		WHERE %s = $1 AND %s = $2 AND %s IS NULL`,
+		schema.UserMFARecoveryCode.Table, schema.UserMFARecoveryCode.UsedAt,
+		schema.UserMFARecoveryCode.UserID, schema.UserMFARecoveryCode.CodeHash, schema.UserMFARecoveryCode.UsedAt,
+	)
+
+	tag, err := repository.pool.Exec(context, query, userID, codeHash)
+	if err != nil {
+		return false, fmt.Errorf("postgres_mfa_repo_use_recovery_code_failed: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+/*
+ReplaceRecoveryCodes swaps the whole recovery code set in one transaction.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - codeHashes: []string
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *PostgresMFARepository) ReplaceRecoveryCodes(context context.Context, userID string, codeHashes []string) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres_mfa_repo_replace_codes_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	if err := replaceRecoveryCodes(context, transaction, userID, codeHashes); err != nil {
+		return err
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres_mfa_repo_replace_codes_commit_failed: %w", err)
+	}
+
+	return nil
+}
+
+/*
+CountRecoveryCodes counts the unused recovery codes of a user.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - int: Remaining codes
+  - error: Execution errors
+*/
+func (repository *PostgresMFARepository) CountRecoveryCodes(context context.Context, userID string) (int, error) {
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s = $1 AND %s IS NULL`,
+		schema.UserMFARecoveryCode.Table, schema.UserMFARecoveryCode.UserID, schema.UserMFARecoveryCode.UsedAt)
+
+	var count int
+	if err := repository.pool.QueryRow(context, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("postgres_mfa_repo_count_codes_failed: %w", err)
+	}
+
+	return count, nil
+}
+
+/*
+Delete removes the enrolment and its recovery codes.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *PostgresMFARepository) Delete(context context.Context, userID string) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres_mfa_repo_delete_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	codesQuery := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1`,
+		schema.UserMFARecoveryCode.Table, schema.UserMFARecoveryCode.UserID)
+	if _, err := transaction.Exec(context, codesQuery, userID); err != nil {
+		return fmt.Errorf("postgres_mfa_repo_delete_codes_failed: %w", err)
+	}
+
+	mfaQuery := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1`, schema.UserMFA.Table, schema.UserMFA.UserID)
+	if _, err := transaction.Exec(context, mfaQuery, userID); err != nil {
+		return fmt.Errorf("postgres_mfa_repo_delete_failed: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres_mfa_repo_delete_commit_failed: %w", err)
+	}
+
+	return nil
+}
+
+// # Internal Helpers
+
+// replaceRecoveryCodes deletes every recovery code of a user and inserts the new hashes.
+func replaceRecoveryCodes(context context.Context, transaction pgx.Tx, userID string, codeHashes []string) error {
+	deleteQuery := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1`,
+		schema.UserMFARecoveryCode.Table, schema.UserMFARecoveryCode.UserID)
+	if _, err := transaction.Exec(context, deleteQuery, userID); err != nil {
+		return fmt.Errorf("postgres_mfa_repo_clear_codes_failed: %w", err)
+	}
+
+	insertQuery := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s) VALUES ($1, $2, $3, NOW())`,
+		schema.UserMFARecoveryCode.Table,
+		schema.UserMFARecoveryCode.ID, schema.UserMFARecoveryCode.UserID,
+		schema.UserMFARecoveryCode.CodeHash, schema.UserMFARecoveryCode.CreatedAt,
+	)
+
+	batch := &pgx.Batch{}
+	for _, codeHash := range codeHashes {
+		batch.Queue(insertQuery, uuid.New(), userID, codeHash)
+	}
+
+	if err := transaction.SendBatch(context, batch).Close(); err != nil {
+		return fmt.Errorf("postgres_mfa_repo_insert_codes_failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,312 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+)
+
+// uniqueViolation is the PostgreSQL SQLSTATE of a unique constraint violation.
+const uniqueViolation = "23505"
+
+// # OAuth Link Repository
+
+// PostgresOAuthLinkRepository implements the OAuthLinkRepository interface using pgx.
+type PostgresOAuthLinkRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewOAuthLinkRepository creates a new PostgreSQL implementation of OAuthLinkRepository.
+func NewOAuthLinkRepository(pool *pgxpool.Pool) *PostgresOAuthLinkRepository {
+	return &PostgresOAuthLinkRepository{pool: pool}
+}
+
+/*
+FindByProviderID retrieves the link of a provider account.
+
+Parameters:
+  - context: context.Context
+  - provider: string
+  - providerID: string
+
+Returns:
+  - *OAuthLink: Hydrated link
+  - error: apperr.NotFound or execution errors
+*/
+func (repository *PostgresOAuthLinkRepository) FindByProviderID(context context.Context, provider, providerID string) (*OAuthLink, error) {
+	query := fmt.Sprintf(`
+		SELECT %s, %s, %s, COALESCE(%s, ''), COALESCE(%s, ''), %s
+		FROM %s
+		WHERE %s = $1 AND %s = $2`,
+		schema.UserOAuthProvider.UserID, schema.UserOAuthProvider.Provider, schema.UserOAuthProvider.ProviderID,
+		schema.UserOAuthProvider.Email, schema.UserOAuthProvider.AccessToken, schema.UserOAuthProvider.CreatedAt,
+		schema.UserOAuthProvider.Table,
+		schema.UserOAuthProvider.Provider, schema.UserOAuthProvider.ProviderID,
+	)
+
+	link := &OAuthLink{}
+	err := repository.pool.QueryRow(context, query, provider, providerID).Scan(
+		&link.UserID,
+		&link.Provider,
+		&link.ProviderID,
+		&link.Email,
+		&link.AccessToken,
+		&link.LinkedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NotFound("OAuth link")
+		}
+		return nil, fmt.Errorf("postgres_oauth_repo_find_failed: %w", err)
+	}
+
+	return link, nil
+}
+
+/*
+ListByUser retrieves every provider linked to an account.
+
+Description: The sealed access token is not selected; listings never need it.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - []*OAuthLink: Links, oldest first
+  - error: Execution errors
+*/
+func (repository *PostgresOAuthLinkRepository) ListByUser(context context.Context, userID string) ([]*OAuthLink, error) {
+	query := fmt.Sprintf(`
+		SELECT %s, %s, %s, COALESCE(%s, ''), %s
+		FROM %s
+		WHERE %s = $1
+		ORDER BY %s ASC`,
+		schema.UserOAuthProvider.UserID, schema.UserOAuthProvider.Provider, schema.UserOAuthProvider.ProviderID,
+		schema.UserOAuthProvider.Email, schema.UserOAuthProvider.CreatedAt,
+		schema.UserOAuthProvider.Table,
+		schema.UserOAuthProvider.UserID,
+		schema.UserOAuthProvider.CreatedAt,
+	)
+
+	rows, err := repository.pool.Query(context, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("postgres_oauth_repo_list_failed: %w", err)
+	}
+	defer rows.Close()
+
+	links := make([]*OAuthLink, 0)
+	for rows.Next() {
+		link := &OAuthLink{}
+		if err := rows.Scan(&link.UserID, &link.Provider, &link.ProviderID, &link.Email, &link.LinkedAt); err != nil {
+			return nil, fmt.Errorf("postgres_oauth_repo_scan_failed: %w", err)
+		}
+		links = append(links, link)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres_oauth_repo_rows_failed: %w", err)
+	}
+
+	return links, nil
+}
+
+/*
+Create links a provider account to an existing user.
+
+Parameters:
+  - context: context.Context
+  - link: *OAuthLink
+
+Returns:
+  - error: apperr.Conflict or execution errors
+*/
+func (repository *PostgresOAuthLinkRepository) Create(context context.Context, link *OAuthLink) error {
+	if _, err := repository.pool.Exec(context, linkInsertQuery(), linkInsertArgs(link)...); err != nil {
+		if isUniqueViolation(err) {
+			return apperr.Conflict("This provider account is already linked")
+		}
+		return fmt.Errorf("postgres_oauth_repo_create_failed: %w", err)
+	}
+
+	return nil
+}
+
+/*
+CreateWithUser creates an account and its first link in one transaction.
+
+Description: An account created by social login has no password; it must
+never exist without the link that signs it in.
+
+Parameters:
+  - context: context.Context
+  - user: *User
+  - link: *OAuthLink
+
+Returns:
+  - error: apperr.Conflict or execution errors
+*/
+func (repository *PostgresOAuthLinkRepository) CreateWithUser(context context.Context, user *User, link *OAuthLink) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres_oauth_repo_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	if _, err := transaction.Exec(context, userInsertQuery(), userInsertArgs(user)...); err != nil {
+		if isUniqueViolation(err) {
+			return apperr.Conflict("Username or email is already taken")
+		}
+		return fmt.Errorf("postgres_oauth_repo_create_user_failed: %w", err)
+	}
+
+	link.UserID = user.ID
+	if _, err := transaction.Exec(context, linkInsertQuery(), linkInsertArgs(link)...); err != nil {
+		if isUniqueViolation(err) {
+			return apperr.Conflict("This provider account is already linked")
+		}
+		return fmt.Errorf("postgres_oauth_repo_create_failed: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres_oauth_repo_commit_failed: %w", err)
+	}
+
+	return nil
+}
+
+/*
+UpdateAccessToken replaces the sealed provider token of a link.
+
+Parameters:
+  - context: context.Context
+  - provider: string
+  - providerID: string
+  - sealedToken: string
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *PostgresOAuthLinkRepository) UpdateAccessToken(context context.Context, provider, providerID, sealedToken string) error {
+	query := fmt.Sprintf("UPDATE %s SET %s = $3 WHERE %s = $1 AND %s = $2",
+		schema.UserOAuthProvider.Table, schema.UserOAuthProvider.AccessToken,
+		schema.UserOAuthProvider.Provider, schema.UserOAuthProvider.ProviderID,
+	)
+
+	if _, err := repository.pool.Exec(context, query, provider, providerID, sealedToken); err != nil {
+		return fmt.Errorf("postgres_oauth_repo_update_token_failed: %w", err)
+	}
+	return nil
+}
+
+/*
+Delete hard-deletes a link unless it is the last way to sign in.
+
+Description: The account row is locked first so two concurrent unlinks of
+different providers cannot both pass the check and lock the user out.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - provider: string
+
+Returns:
+  - error: apperr.NotFound, apperr.Conflict or execution errors
+*/
+func (repository *PostgresOAuthLinkRepository) Delete(context context.Context, userID, provider string) error {
+	transaction, err := repository.pool.Begin(context)
+	if err != nil {
+		return fmt.Errorf("postgres_oauth_repo_begin_failed: %w", err)
+	}
+	defer transaction.Rollback(context)
+
+	// Remaining sign-in methods, under the account lock
+	methodsQuery := fmt.Sprintf(`
+		SELECT a.%[1]s IS NOT NULL,
+		       (SELECT COUNT(*) FROM %[2]s p WHERE p.%[3]s = a.%[4]s),
+		       EXISTS (SELECT 1 FROM %[2]s p WHERE p.%[3]s = a.%[4]s AND p.%[5]s = $2)
+		FROM %[6]s a
+		WHERE a.%[4]s = $1
+		FOR UPDATE OF a`,
+		schema.UserAccount.Password,       // 1
+		schema.UserOAuthProvider.Table,    // 2
+		schema.UserOAuthProvider.UserID,   // 3
+		schema.UserAccount.ID,             // 4
+		schema.UserOAuthProvider.Provider, // 5
+		schema.UserAccount.Table,          // 6
+	)
+
+	var hasPassword, isLinked bool
+	var linkCount int
+	err = transaction.QueryRow(context, methodsQuery, userID, provider).Scan(&hasPassword, &linkCount, &isLinked)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("postgres_oauth_repo_methods_failed: %w", err)
+	}
+
+	if !isLinked {
+		return apperr.NotFound("Provider not linked to this account")
+	}
+	if !hasPassword && linkCount <= 1 {
+		return apperr.Conflict("Cannot unlink: this is your only sign-in method. Set a password first.")
+	}
+
+	deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE %s = $1 AND %s = $2",
+		schema.UserOAuthProvider.Table, schema.UserOAuthProvider.UserID, schema.UserOAuthProvider.Provider)
+
+	if _, err := transaction.Exec(context, deleteQuery, userID, provider); err != nil {
+		return fmt.Errorf("postgres_oauth_repo_delete_failed: %w", err)
+	}
+
+	if err := transaction.Commit(context); err != nil {
+		return fmt.Errorf("postgres_oauth_repo_commit_failed: %w", err)
+	}
+
+	return nil
+}
+
+// # Internal Helpers
+
+// linkInsertQuery builds the INSERT statement for a provider link.
+func linkInsertQuery() string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s, %s, %s)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6)`,
+		schema.UserOAuthProvider.Table,
+		schema.UserOAuthProvider.UserID, schema.UserOAuthProvider.Provider, schema.UserOAuthProvider.ProviderID,
+		schema.UserOAuthProvider.Email, schema.UserOAuthProvider.AccessToken, schema.UserOAuthProvider.CreatedAt,
+	)
+}
+
+// linkInsertArgs initialises the link timestamp and returns the arguments for [linkInsertQuery].
+func linkInsertArgs(link *OAuthLink) []any {
+	if link.LinkedAt.IsZero() {
+		link.LinkedAt = time.Now()
+	}
+
+	return []any{
+		link.UserID,
+		link.Provider,
+		link.ProviderID,
+		link.Email,
+		link.AccessToken,
+		link.LinkedAt,
+	}
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique constraint violation.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/database/schema"
+	"github.com/taibuivan/yomira/internal/platform/sec"
+)
+
+// # Personal Access Token Repository
+
+// PostgresPersonalTokenRepository implements the PersonalTokenRepository interface using pgx.
+type PostgresPersonalTokenRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPersonalTokenRepository creates a new PostgreSQL implementation of PersonalTokenRepository.
+func NewPersonalTokenRepository(pool *pgxpool.Pool) *PostgresPersonalTokenRepository {
+	return &PostgresPersonalTokenRepository{pool: pool}
+}
+
+/*
+FindByHash retrieves an unrevoked token together with its owner.
+
+Description: Runs on every request authenticated by a token, so the owner's
+current username and role come from the same query. Tokens of suspended
+owners are not found.
+
+Parameters:
+  - context: context.Context
+  - tokenHash: string
+
+Returns:
+  - *PersonalAccessToken: Hydrated token
+  - *PersonalTokenOwner: Owner identity
+  - error: apperr.NotFound or execution errors
+*/
+func (repository *PostgresPersonalTokenRepository) FindByHash(context context.Context, tokenHash string) (*PersonalAccessToken, *PersonalTokenOwner, error) {
+	query := fmt.Sprintf(`
+		SELECT %[1]s, a.%[2]s, a.%[3]s
+		FROM %[4]s t
+		JOIN %[5]s a ON a.%[6]s = t.%[7]s
+		WHERE t.%[8]s = $1 AND t.%[9]s IS NULL AND a.%[10]s IS NULL
+		  AND (a.%[11]s OR a.%[12]s <= NOW())`,
+		tokenProjection("t."),             // 1
+		schema.UserAccount.Username,       // 2
+		schema.UserAccount.Role,           // 3
+		schema.UserAccessToken.Table,      // 4
+		schema.UserAccount.Table,          // 5
+		schema.UserAccount.ID,             // 6
+		schema.UserAccessToken.UserID,     // 7
+		schema.UserAccessToken.TokenHash,  // 8
+		schema.UserAccessToken.RevokedAt,  // 9
+		schema.UserAccount.DeletedAt,      // 10
+		schema.UserAccount.IsActive,       // 11
+		schema.UserAccount.SuspendedUntil, // 12
+	)
+
+	token := &PersonalAccessToken{}
+	owner := &PersonalTokenOwner{}
+	var scopes []string
+
+	targets := append(tokenScanTargets(token, &scopes), &owner.Username, &owner.Role)
+	if err := repository.pool.QueryRow(context, query, tokenHash).Scan(targets...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, apperr.NotFound("Personal access token")
+		}
+		return nil, nil, fmt.Errorf("postgres_token_repo_find_failed: %w", err)
+	}
+
+	token.Scopes = toScopes(scopes)
+	return token, owner, nil
+}
+
+/*
+ListByUser retrieves the unrevoked tokens of an account.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - []*PersonalAccessToken: Tokens, newest first
+  - error: Execution errors
+*/
+func (repository *PostgresPersonalTokenRepository) ListByUser(context context.Context, userID string) ([]*PersonalAccessToken, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE %s = $1 AND %s IS NULL
+		ORDER BY %s DESC`,
+		tokenProjection(""),
+		schema.UserAccessToken.Table,
+		schema.UserAccessToken.UserID, schema.UserAccessToken.RevokedAt,
+		schema.UserAccessToken.CreatedAt,
+	)
+
+	rows, err := repository.pool.Query(context, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("postgres_token_repo_list_failed: %w", err)
+	}
+	defer rows.Close()
+
+	tokens := make([]*PersonalAccessToken, 0)
+	for rows.Next() {
+		token := &PersonalAccessToken{}
+		var scopes []string
+		if err := rows.Scan(tokenScanTargets(token, &scopes)...); err != nil {
+			return nil, fmt.Errorf("postgres_token_repo_scan_failed: %w", err)
+		}
+		token.Scopes = toScopes(scopes)
+		tokens = append(tokens, token)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres_token_repo_rows_failed: %w", err)
+	}
+
+	return tokens, nil
+}
+
+/*
+CountActive counts the usable tokens of an account.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - int: Unrevoked, unexpired tokens
+  - error: Execution errors
+*/
+func (repository *PostgresPersonalTokenRepository) CountActive(context context.Context, userID string) (int, error) {
+	query := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM %s
+		WHERE %s = $1 AND %s IS NULL AND (%s IS NULL OR %s > NOW())`,
+		schema.UserAccessToken.Table,
+		schema.UserAccessToken.UserID, schema.UserAccessToken.RevokedAt,
+		schema.UserAccessToken.ExpiresAt, schema.UserAccessToken.ExpiresAt,
+	)
+
+	var count int
+	if err := repository.pool.QueryRow(context, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("postgres_token_repo_count_failed: %w", err)
+	}
+	return count, nil
+}
+
+/*
+Create persists a new token.
+
+Parameters:
+  - context: context.Context
+  - token: *PersonalAccessToken
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *PostgresPersonalTokenRepository) Create(context context.Context, token *PersonalAccessToken) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		schema.UserAccessToken.Table,
+		schema.UserAccessToken.ID, schema.UserAccessToken.UserID, schema.UserAccessToken.Name,
+		schema.UserAccessToken.TokenPrefix, schema.UserAccessToken.TokenHash, schema.UserAccessToken.Scopes,
+		schema.UserAccessToken.ExpiresAt, schema.UserAccessToken.CreatedAt,
+	)
+
+	if token.CreatedAt.IsZero() {
+		token.CreatedAt = time.Now()
+	}
+
+	_, err := repository.pool.Exec(context, query,
+		token.ID,
+		token.UserID,
+		token.Name,
+		token.Prefix,
+		token.TokenHash,
+		fromScopes(token.Scopes),
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres_token_repo_create_failed: %w", err)
+	}
+
+	return nil
+}
+
+/*
+Revoke marks a token of the given account as revoked.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - tokenID: string
+
+Returns:
+  - error: apperr.NotFound or execution errors
+*/
+func (repository *PostgresPersonalTokenRepository) Revoke(context context.Context, userID, tokenID string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s SET %s = NOW()
+		WHERE %s = $1 AND %s = $2 AND %s IS NULL`,
+		schema.UserAccessToken.Table, schema.UserAccessToken.RevokedAt,
+		schema.UserAccessToken.ID, schema.UserAccessToken.UserID, schema.UserAccessToken.RevokedAt,
+	)
+
+	tag, err := repository.pool.Exec(context, query, tokenID, userID)
+	if err != nil {
+		return fmt.Errorf("postgres_token_repo_revoke_failed: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return apperr.NotFound("Personal access token")
+	}
+
+	return nil
+}
+
+/*
+TouchLastUsed records the last use of a token.
+
+Parameters:
+  - context: context.Context
+  - tokenID: string
+  - usedAt: time.Time
+  - ipAddress: string
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *PostgresPersonalTokenRepository) TouchLastUsed(context context.Context, tokenID string, usedAt time.Time, ipAddress string) error {
+	query := fmt.Sprintf("UPDATE %s SET %s = $2, %s = NULLIF($3, '') WHERE %s = $1",
+		schema.UserAccessToken.Table,
+		schema.UserAccessToken.LastUsedAt, schema.UserAccessToken.LastUsedIP,
+		schema.UserAccessToken.ID,
+	)
+
+	if _, err := repository.pool.Exec(context, query, tokenID, usedAt, ipAddress); err != nil {
+		return fmt.Errorf("postgres_token_repo_touch_failed: %w", err)
+	}
+	return nil
+}
+
+// # Internal Helpers
+
+// tokenProjection returns the shared SELECT list for token queries, with an optional table alias.
+func tokenProjection(alias string) string {
+	return fmt.Sprintf("%[1]s%[2]s, %[1]s%[3]s, %[1]s%[4]s, %[1]s%[5]s, %[1]s%[6]s, %[1]s%[7]s, %[1]s%[8]s, %[1]s%[9]s, COALESCE(%[1]s%[10]s, ''), %[1]s%[11]s",
+		alias,
+		schema.UserAccessToken.ID, schema.UserAccessToken.UserID, schema.UserAccessToken.Name,
+		schema.UserAccessToken.TokenPrefix, schema.UserAccessToken.TokenHash, schema.UserAccessToken.Scopes,
+		schema.UserAccessToken.ExpiresAt, schema.UserAccessToken.LastUsedAt, schema.UserAccessToken.LastUsedIP,
+		schema.UserAccessToken.CreatedAt,
+	)
+}
+
+// tokenScanTargets returns the scan destinations matching [tokenProjection].
+func tokenScanTargets(token *PersonalAccessToken, scopes *[]string) []any {
+	return []any{
+		&token.ID,
+		&token.UserID,
+		&token.Name,
+		&token.Prefix,
+		&token.TokenHash,
+		scopes,
+		&token.ExpiresAt,
+		&token.LastUsedAt,
+		&token.LastUsedIP,
+		&token.CreatedAt,
+	}
+}
+
+// toScopes converts a text[] column into scopes.
+func toScopes(values []string) []sec.Scope {
+	scopes := make([]sec.Scope, len(values))
+	for i, value := range values {
+		scopes[i] = sec.Scope(value)
+	}
+	return scopes
+}
+
+// fromScopes converts scopes into a text[] parameter.
+func fromScopes(scopes []sec.Scope) []string {
+	values := make([]string, len(scopes))
+	for i, scope := range scopes {
+		values[i] = string(scope)
+	}
+	return values
+}
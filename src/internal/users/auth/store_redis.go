@@ -7,10 +7,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/taibuivan/yomira/internal/platform/apperr"
+	"github.com/taibuivan/yomira/internal/platform/constants"
+	"github.com/taibuivan/yomira/internal/platform/sec"
 )
 
 // RedisResetTokenRepository implements ResetTokenRepository using Redis.
@@ -200,3 +203,512 @@ func (repository *RedisVerificationTokenRepository) Delete(context context.Conte
 	// Return nil on success
 	return nil
 }
+
+// # Login Throttle Repository
+
+// RedisLoginThrottleRepository implements LoginThrottleRepository using Redis.
+type RedisLoginThrottleRepository struct {
+	client *redis.Client
+}
+
+// NewLoginThrottleRepository creates a new Redis-backed LoginThrottleRepository.
+func NewLoginThrottleRepository(client *redis.Client) *RedisLoginThrottleRepository {
+	return &RedisLoginThrottleRepository{client: client}
+}
+
+/*
+BlockedFor returns the longest remaining block among the given scopes.
+
+Description: Reads every block key in a single pipeline round trip.
+
+Parameters:
+  - context: context.Context
+  - scopes: ...string
+
+Returns:
+  - time.Duration: Zero when no scope is blocked
+  - error: Connectivity errors
+*/
+func (repository *RedisLoginThrottleRepository) BlockedFor(context context.Context, scopes ...string) (time.Duration, error) {
+	pipeline := repository.client.Pipeline()
+
+	commands := make([]*redis.DurationCmd, len(scopes))
+	for index, scope := range scopes {
+		commands[index] = pipeline.PTTL(context, constants.RedisPrefixLoginBlock+scope)
+	}
+
+	if _, err := pipeline.Exec(context); err != nil && !errors.Is(err, redis.Nil) {
+		return 0, fmt.Errorf("redis_login_block_get_failed: %w", err)
+	}
+
+	// Missing keys report a negative TTL
+	var longest time.Duration
+	for _, command := range commands {
+		if remaining := command.Val(); remaining > longest {
+			longest = remaining
+		}
+	}
+
+	return longest, nil
+}
+
+// recordFailureScript increments KEYS[1] and starts its ARGV[1] ms window when
+// the key has no expiry, so a counter can never outlive its window.
+var recordFailureScript = redis.NewScript(`
+local failures = redis.call("INCR", KEYS[1])
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return failures
+`)
+
+/*
+RecordFailure increments the failure counter of a scope.
+
+Description: The first failure starts the window; later failures do not
+extend it, so counters always expire even under constant attack. The
+increment and the expiry run as one script, and a counter found without
+expiry gets one, so the scope is never throttled forever.
+
+Parameters:
+  - context: context.Context
+  - scope: string
+  - window: time.Duration
+
+Returns:
+  - int: Failures in the current window
+  - error: Execution errors
+*/
+func (repository *RedisLoginThrottleRepository) RecordFailure(context context.Context, scope string, window time.Duration) (int, error) {
+	key := constants.RedisPrefixLoginFail + scope
+
+	failures, err := recordFailureScript.Run(context, repository.client, []string{key}, window.Milliseconds()).Int()
+	if err != nil {
+		return 0, fmt.Errorf("redis_login_fail_incr_failed: %w", err)
+	}
+
+	return failures, nil
+}
+
+/*
+Block rejects every attempt for the scope during the given duration.
+
+Parameters:
+  - context: context.Context
+  - scope: string
+  - duration: time.Duration
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *RedisLoginThrottleRepository) Block(context context.Context, scope string, duration time.Duration) error {
+	if err := repository.client.Set(context, constants.RedisPrefixLoginBlock+scope, 1, duration).Err(); err != nil {
+		return fmt.Errorf("redis_login_block_set_failed: %w", err)
+	}
+	return nil
+}
+
+/*
+Reset clears the failure counter and block of a scope.
+
+Parameters:
+  - context: context.Context
+  - scope: string
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *RedisLoginThrottleRepository) Reset(context context.Context, scope string) error {
+	err := repository.client.Del(context, constants.RedisPrefixLoginFail+scope, constants.RedisPrefixLoginBlock+scope).Err()
+	if err != nil {
+		return fmt.Errorf("redis_login_throttle_reset_failed: %w", err)
+	}
+	return nil
+}
+
+// # Suspension Repository
+
+// RedisSuspensionRepository implements SuspensionRepository using Redis.
+type RedisSuspensionRepository struct {
+	client *redis.Client
+}
+
+// NewSuspensionRepository creates a new Redis-backed SuspensionRepository.
+func NewSuspensionRepository(client *redis.Client) *RedisSuspensionRepository {
+	return &RedisSuspensionRepository{client: client}
+}
+
+/*
+Mark flags a user as suspended; a zero ttl never expires.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - ttl: time.Duration
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *RedisSuspensionRepository) Mark(context context.Context, userID string, ttl time.Duration) error {
+	if err := repository.client.Set(context, constants.RedisPrefixSuspended+userID, 1, ttl).Err(); err != nil {
+		return fmt.Errorf("redis_suspension_set_failed: %w", err)
+	}
+	return nil
+}
+
+/*
+Clear lifts the flag of a user.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *RedisSuspensionRepository) Clear(context context.Context, userID string) error {
+	if err := repository.client.Del(context, constants.RedisPrefixSuspended+userID).Err(); err != nil {
+		return fmt.Errorf("redis_suspension_delete_failed: %w", err)
+	}
+	return nil
+}
+
+// # Revocation Repository
+
+// RedisRevocationRepository implements RevocationRepository using Redis.
+type RedisRevocationRepository struct {
+	client *redis.Client
+}
+
+// NewRevocationRepository creates a new Redis-backed RevocationRepository.
+func NewRevocationRepository(client *redis.Client) *RedisRevocationRepository {
+	return &RedisRevocationRepository{client: client}
+}
+
+/*
+RevokeToken denies one access token until it would have expired anyway.
+
+Parameters:
+  - context: context.Context
+  - tokenID: string
+  - ttl: time.Duration
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *RedisRevocationRepository) RevokeToken(context context.Context, tokenID string, ttl time.Duration) error {
+	if err := repository.client.Set(context, constants.RedisPrefixRevokedToken+tokenID, 1, ttl).Err(); err != nil {
+		return fmt.Errorf("redis_revocation_token_set_failed: %w", err)
+	}
+	return nil
+}
+
+/*
+RevokeBefore stores the cutoff of a user as Unix seconds, the precision of the iat claim.
+
+Parameters:
+  - context: context.Context
+  - userID: string
+  - cutoff: time.Time
+  - ttl: time.Duration
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *RedisRevocationRepository) RevokeBefore(context context.Context, userID string, cutoff time.Time, ttl time.Duration) error {
+	if err := repository.client.Set(context, constants.RedisPrefixRevokedBefore+userID, cutoff.Unix(), ttl).Err(); err != nil {
+		return fmt.Errorf("redis_revocation_cutoff_set_failed: %w", err)
+	}
+	return nil
+}
+
+/*
+Lookup reads the token, cutoff and suspension keys with a single MGET.
+
+Parameters:
+  - context: context.Context
+  - tokenID: string
+  - userID: string
+
+Returns:
+  - AccessState: Revocation and suspension state
+  - error: Connectivity errors
+*/
+func (repository *RedisRevocationRepository) Lookup(context context.Context, tokenID, userID string) (AccessState, error) {
+	values, err := repository.client.MGet(context,
+		constants.RedisPrefixRevokedToken+tokenID,
+		constants.RedisPrefixRevokedBefore+userID,
+		constants.RedisPrefixSuspended+userID,
+	).Result()
+	if err != nil {
+		return AccessState{}, fmt.Errorf("redis_revocation_lookup_failed: %w", err)
+	}
+
+	state := AccessState{
+		TokenRevoked: tokenID != "" && values[0] != nil,
+		Suspended:    values[2] != nil,
+	}
+
+	if raw, ok := values[1].(string); ok {
+		seconds, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return AccessState{}, fmt.Errorf("redis_revocation_cutoff_parse_failed: %w", err)
+		}
+		state.RevokedBefore = time.Unix(seconds, 0)
+	}
+
+	return state, nil
+}
+
+// # MFA Challenge Repository
+
+// Hash fields of a stored MFA challenge.
+const (
+	challengeFieldUserID        = "user_id"
+	challengeFieldPurpose       = "purpose"
+	challengeFieldUserAgent     = "user_agent"
+	challengeFieldIPAddress     = "ip_address"
+	challengeFieldThrottleScope = "throttle_scope"
+	challengeFieldAttempts      = "attempts"
+)
+
+// RedisMFAChallengeRepository implements MFAChallengeRepository using Redis hashes.
+type RedisMFAChallengeRepository struct {
+	client *redis.Client
+}
+
+// NewMFAChallengeRepository creates a new Redis-backed MFAChallengeRepository.
+func NewMFAChallengeRepository(client *redis.Client) *RedisMFAChallengeRepository {
+	return &RedisMFAChallengeRepository{client: client}
+}
+
+/*
+Create stores a challenge under the hash of its token.
+
+Parameters:
+  - context: context.Context
+  - token: string
+  - challenge: *MFAChallenge
+  - ttl: time.Duration
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *RedisMFAChallengeRepository) Create(context context.Context, token string, challenge *MFAChallenge, ttl time.Duration) error {
+	key := challengeKey(token)
+
+	pipeline := repository.client.TxPipeline()
+	pipeline.HSet(context, key,
+		challengeFieldUserID, challenge.UserID,
+		challengeFieldPurpose, string(challenge.Purpose),
+		challengeFieldUserAgent, challenge.UserAgent,
+		challengeFieldIPAddress, challenge.IPAddress,
+		challengeFieldThrottleScope, challenge.ThrottleScope,
+		challengeFieldAttempts, 0,
+	)
+	pipeline.Expire(context, key, ttl)
+
+	if _, err := pipeline.Exec(context); err != nil {
+		return fmt.Errorf("redis_mfa_challenge_set_failed: %w", err)
+	}
+	return nil
+}
+
+/*
+Get retrieves a challenge without counting an attempt.
+
+Parameters:
+  - context: context.Context
+  - token: string
+
+Returns:
+  - *MFAChallenge: Stored challenge
+  - error: apperr.NotFound or connectivity errors
+*/
+func (repository *RedisMFAChallengeRepository) Get(context context.Context, token string) (*MFAChallenge, error) {
+	fields, err := repository.client.HGetAll(context, challengeKey(token)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis_mfa_challenge_get_failed: %w", err)
+	}
+
+	return parseChallenge(fields)
+}
+
+/*
+Attempt counts a code submission and retrieves the challenge.
+
+Description: HINCRBY only runs when the key exists so an expired token can
+never be resurrected as an empty hash.
+
+Parameters:
+  - context: context.Context
+  - token: string
+
+Returns:
+  - *MFAChallenge: Stored challenge
+  - int: Attempts so far
+  - error: apperr.NotFound or connectivity errors
+*/
+func (repository *RedisMFAChallengeRepository) Attempt(context context.Context, token string) (*MFAChallenge, int, error) {
+	key := challengeKey(token)
+
+	attempts, err := attemptScript.Run(context, repository.client, []string{key}, challengeFieldAttempts).Int()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, 0, apperr.NotFound("MFA challenge is invalid or expired")
+		}
+		return nil, 0, fmt.Errorf("redis_mfa_challenge_attempt_failed: %w", err)
+	}
+
+	challenge, err := repository.Get(context, token)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return challenge, attempts, nil
+}
+
+/*
+Delete removes a challenge.
+
+Parameters:
+  - context: context.Context
+  - token: string
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *RedisMFAChallengeRepository) Delete(context context.Context, token string) error {
+	if err := repository.client.Del(context, challengeKey(token)).Err(); err != nil {
+		return fmt.Errorf("redis_mfa_challenge_delete_failed: %w", err)
+	}
+	return nil
+}
+
+// attemptScript increments the attempt counter only for live challenges.
+var attemptScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return false
+end
+return redis.call("HINCRBY", KEYS[1], ARGV[1], 1)
+`)
+
+// challengeKey stores challenges under the token hash so Redis never holds a usable token.
+func challengeKey(token string) string {
+	return constants.RedisPrefixMFAChallenge + sec.HashToken(token)
+}
+
+// parseChallenge maps stored hash fields onto an [MFAChallenge].
+func parseChallenge(fields map[string]string) (*MFAChallenge, error) {
+	userID, ok := fields[challengeFieldUserID]
+	if !ok || userID == "" {
+		return nil, apperr.NotFound("MFA challenge is invalid or expired")
+	}
+
+	return &MFAChallenge{
+		UserID:        userID,
+		Purpose:       MFAPurpose(fields[challengeFieldPurpose]),
+		UserAgent:     fields[challengeFieldUserAgent],
+		IPAddress:     fields[challengeFieldIPAddress],
+		ThrottleScope: fields[challengeFieldThrottleScope],
+	}, nil
+}
+
+// # OAuth State
+
+// Hash field names of a stored [OAuthState].
+const (
+	stateFieldProvider     = "provider"
+	stateFieldAction       = "action"
+	stateFieldUserID       = "user_id"
+	stateFieldCodeVerifier = "code_verifier"
+	stateFieldNonce        = "nonce"
+	stateFieldRedirectPath = "redirect_path"
+)
+
+// RedisOAuthStateRepository implements OAuthStateRepository using Redis hashes.
+type RedisOAuthStateRepository struct {
+	client *redis.Client
+}
+
+// NewOAuthStateRepository creates a new Redis-backed OAuthStateRepository.
+func NewOAuthStateRepository(client *redis.Client) *RedisOAuthStateRepository {
+	return &RedisOAuthStateRepository{client: client}
+}
+
+/*
+Create stores the state of a flow under the hash of its state parameter.
+
+Parameters:
+  - context: context.Context
+  - state: string
+  - value: *OAuthState
+  - ttl: time.Duration
+
+Returns:
+  - error: Execution errors
+*/
+func (repository *RedisOAuthStateRepository) Create(context context.Context, state string, value *OAuthState, ttl time.Duration) error {
+	key := oauthStateKey(state)
+
+	pipeline := repository.client.TxPipeline()
+	pipeline.HSet(context, key,
+		stateFieldProvider, value.Provider,
+		stateFieldAction, string(value.Action),
+		stateFieldUserID, value.UserID,
+		stateFieldCodeVerifier, value.CodeVerifier,
+		stateFieldNonce, value.Nonce,
+		stateFieldRedirectPath, value.RedirectPath,
+	)
+	pipeline.Expire(context, key, ttl)
+
+	if _, err := pipeline.Exec(context); err != nil {
+		return fmt.Errorf("redis_oauth_state_set_failed: %w", err)
+	}
+	return nil
+}
+
+/*
+Consume reads and deletes a state in one MULTI block.
+
+Description: Two callbacks racing with the same state cannot both read it.
+
+Parameters:
+  - context: context.Context
+  - state: string
+
+Returns:
+  - *OAuthState: Stored state
+  - error: apperr.NotFound or connectivity errors
+*/
+func (repository *RedisOAuthStateRepository) Consume(context context.Context, state string) (*OAuthState, error) {
+	key := oauthStateKey(state)
+
+	pipeline := repository.client.TxPipeline()
+	read := pipeline.HGetAll(context, key)
+	pipeline.Del(context, key)
+
+	if _, err := pipeline.Exec(context); err != nil {
+		return nil, fmt.Errorf("redis_oauth_state_consume_failed: %w", err)
+	}
+
+	fields := read.Val()
+	provider, ok := fields[stateFieldProvider]
+	if !ok || provider == "" {
+		return nil, apperr.NotFound("OAuth state is invalid or expired")
+	}
+
+	return &OAuthState{
+		Provider:     provider,
+		Action:       OAuthAction(fields[stateFieldAction]),
+		UserID:       fields[stateFieldUserID],
+		CodeVerifier: fields[stateFieldCodeVerifier],
+		Nonce:        fields[stateFieldNonce],
+		RedirectPath: fields[stateFieldRedirectPath],
+	}, nil
+}
+
+// oauthStateKey stores flows under the state hash so Redis never holds a usable state value.
+func oauthStateKey(state string) string {
+	return constants.RedisPrefixOAuthState + sec.HashToken(state)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth
+
+import (
+	"math"
+	"time"
+)
+
+// # Login Throttling
+
+/*
+ThrottlePolicy describes how login failures of one scope are penalised.
+
+Description: The first FreeAttempts failures are free. Each further failure
+doubles the wait (BaseDelay, 2x BaseDelay, ...) up to MaxDelay. Reaching
+LockAfter failures locks the scope for LockDuration. Counters expire Window
+after the first failure, and a successful login resets the account scope.
+*/
+type ThrottlePolicy struct {
+	FreeAttempts int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	LockAfter    int
+	LockDuration time.Duration
+	Window       time.Duration
+}
+
+// Throttle scopes tracked for every login attempt.
+var (
+	// AccountThrottle targets guessing against a single account.
+	AccountThrottle = ThrottlePolicy{
+		FreeAttempts: 3,
+		BaseDelay:    2 * time.Second,
+		MaxDelay:     5 * time.Minute,
+		LockAfter:    10,
+		LockDuration: 30 * time.Minute,
+		Window:       time.Hour,
+	}
+
+	// IPThrottle targets credential stuffing spread across many accounts.
+	IPThrottle = ThrottlePolicy{
+		FreeAttempts: 20,
+		BaseDelay:    time.Second,
+		MaxDelay:     5 * time.Minute,
+		LockAfter:    100,
+		LockDuration: time.Hour,
+		Window:       time.Hour,
+	}
+)
+
+/*
+Delay returns how long the scope stays blocked after the given failure count.
+
+Parameters:
+  - failures: int (Failures in the current window, including the latest)
+
+Returns:
+  - time.Duration: Zero when the next attempt is allowed immediately
+*/
+func (policy ThrottlePolicy) Delay(failures int) time.Duration {
+	switch {
+	case failures >= policy.LockAfter:
+		return policy.LockDuration
+	case failures <= policy.FreeAttempts:
+		return 0
+	}
+
+	// Exponential backoff, guarded against shift overflow
+	exponent := failures - policy.FreeAttempts - 1
+	if exponent >= 62 {
+		return policy.MaxDelay
+	}
+
+	delay := policy.BaseDelay * time.Duration(int64(1)<<exponent)
+	if delay <= 0 || delay > policy.MaxDelay {
+		return policy.MaxDelay
+	}
+	return delay
+}
+
+// IsLock reports whether the failure count triggers the temporary lock.
+func (policy ThrottlePolicy) IsLock(failures int) bool {
+	return failures >= policy.LockAfter
+}
+
+// retryAfterSeconds rounds a wait up to whole seconds for the Retry-After header.
+func retryAfterSeconds(wait time.Duration) int {
+	return int(math.Ceil(wait.Seconds()))
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taibuivan/yomira/internal/users/auth"
+)
+
+/*
+TestThrottlePolicy_Delay verifies the free attempts, exponential backoff,
+the delay cap and the temporary lock.
+*/
+func TestThrottlePolicy_Delay(t *testing.T) {
+	policy := auth.ThrottlePolicy{
+		FreeAttempts: 3,
+		BaseDelay:    2 * time.Second,
+		MaxDelay:     time.Minute,
+		LockAfter:    10,
+		LockDuration: 30 * time.Minute,
+		Window:       time.Hour,
+	}
+
+	tests := []struct {
+		name     string
+		failures int
+		expected time.Duration
+	}{
+		{"first_failure_is_free", 1, 0},
+		{"last_free_attempt", 3, 0},
+		{"first_backoff", 4, 2 * time.Second},
+		{"doubles", 5, 4 * time.Second},
+		{"doubles_again", 6, 8 * time.Second},
+		{"capped", 9, time.Minute},
+		{"locked", 10, 30 * time.Minute},
+		{"stays_locked", 25, 30 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, policy.Delay(tt.failures))
+			assert.Equal(t, tt.failures >= policy.LockAfter, policy.IsLock(tt.failures))
+		})
+	}
+}
+
+/*
+TestThrottlePolicy_Delay_Overflow ensures huge counters never wrap to a
+negative or zero delay.
+*/
+func TestThrottlePolicy_Delay_Overflow(t *testing.T) {
+	policy := auth.ThrottlePolicy{
+		FreeAttempts: 0,
+		BaseDelay:    time.Second,
+		MaxDelay:     time.Hour,
+		LockAfter:    1_000_000,
+		LockDuration: 24 * time.Hour,
+	}
+
+	for _, failures := range []int{40, 63, 64, 200} {
+		assert.Equal(t, time.Hour, policy.Delay(failures))
+	}
+}
@@ -24,25 +24,39 @@ import (
 
 // User represents a registered member of the Yomira platform.
 type User struct {
-	ID           string       `json:"id"`
-	Username     string       `json:"username"`
-	Email        string       `json:"email"`
-	PasswordHash string       `json:"-"` // Explicitly omitted from JSON for security.
-	DisplayName  string       `json:"display_name"`
-	AvatarURL    string       `json:"avatar_url,omitempty"`
-	Bio          string       `json:"bio,omitempty"`
-	Website      string       `json:"website,omitempty"`
-	Role         sec.UserRole `json:"role"`
-	IsVerified   bool         `json:"is_verified"`
-	CreatedAt    time.Time    `json:"created_at"`
-	UpdatedAt    time.Time    `json:"updated_at"`
+	ID             string       `json:"id"`
+	Username       string       `json:"username"`
+	Email          string       `json:"email"`
+	PasswordHash   string       `json:"-"` // Explicitly omitted from JSON for security.
+	DisplayName    string       `json:"display_name"`
+	AvatarURL      string       `json:"avatar_url,omitempty"`
+	Bio            string       `json:"bio,omitempty"`
+	Website        string       `json:"website,omitempty"`
+	Role           sec.UserRole `json:"role"`
+	IsVerified     bool         `json:"is_verified"`
+	Suspended      bool         `json:"is_suspended"`              // Set by an administrator (isactive = FALSE)
+	SuspendedUntil *time.Time   `json:"suspended_until,omitempty"` // nil while Suspended means indefinitely
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+// IsSuspended reports whether the account is barred from signing in at now.
+// A suspension with an end date lifts by itself once that date passes.
+func (user *User) IsSuspended(now time.Time) bool {
+	return user.Suspended && (user.SuspendedUntil == nil || now.Before(*user.SuspendedUntil))
 }
 
 // Session represents an active refresh-token session.
+//
+// Every refresh rotates the session into a child that shares the same
+// FamilyID (the ID of the session created at login). Presenting the token of
+// a session that already has a child is a replay and revokes the family.
 type Session struct {
 	ID        string    `json:"id"`
 	UserID    string    `json:"user_id"`
-	TokenHash string    `json:"-"` // Hashed value of the refresh token. Omitted for security.
+	TokenHash string    `json:"-"`                   // Hashed value of the refresh token. Omitted for security.
+	ParentID  *string   `json:"parent_id,omitempty"` // Session rotated into this one; nil for a login
+	FamilyID  string    `json:"family_id"`
 	UserAgent string    `json:"user_agent"`
 	IPAddress string    `json:"ip_address"`
 	ExpiresAt time.Time `json:"expires_at"`
@@ -50,6 +64,113 @@ type Session struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// # Two-Factor Entities
+
+// MFAPurpose distinguishes what a pending MFA challenge unlocks.
+type MFAPurpose string
+
+const (
+	// MFAPurposeLogin completes a login with a TOTP or recovery code.
+	MFAPurposeLogin MFAPurpose = "login"
+
+	// MFAPurposeEnroll forces enrolment before a privileged account may log in.
+	MFAPurposeEnroll MFAPurpose = "enroll"
+)
+
+// MFA represents the TOTP enrolment of an account.
+type MFA struct {
+	UserID       string
+	Secret       string // Sealed with [sec.SecretBox]; never leaves the service
+	IsEnabled    bool   // False while enrolment awaits its first code
+	LastUsedStep *int64 // Latest accepted TOTP step, for replay protection
+	EnabledAt    *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// MFAStatus summarises the two-factor state shown in account settings.
+type MFAStatus struct {
+	IsEnabled              bool       `json:"is_enabled"`
+	IsRequired             bool       `json:"is_required"`
+	EnabledAt              *time.Time `json:"enabled_at,omitempty"`
+	RecoveryCodesRemaining int        `json:"recovery_codes_remaining"`
+}
+
+// MFAEnrollment carries the shared secret displayed once during enrolment.
+type MFAEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"` // Rendered as a QR code by the client
+}
+
+// MFAChallenge is the server-side state behind a short-lived MFA token.
+type MFAChallenge struct {
+	UserID        string
+	Purpose       MFAPurpose
+	UserAgent     string
+	IPAddress     string
+	ThrottleScope string // Login throttle scope charged for invalid codes
+}
+
+// # Social Login Entities
+
+// OAuthAction distinguishes what a social login flow was started for.
+type OAuthAction string
+
+const (
+	// OAuthActionLogin signs in, creating or linking an account by verified email.
+	OAuthActionLogin OAuthAction = "login"
+
+	// OAuthActionLink attaches the provider account to the signed-in user.
+	OAuthActionLink OAuthAction = "link"
+)
+
+// OAuthLink ties an account to its identity at a social login provider.
+type OAuthLink struct {
+	UserID      string    `json:"-"`
+	Provider    string    `json:"provider"`
+	ProviderID  string    `json:"-"` // Subject at the provider
+	Email       string    `json:"email"`
+	AccessToken string    `json:"-"` // Sealed with [sec.SecretBox]; never returned
+	LinkedAt    time.Time `json:"linked_at"`
+}
+
+// OAuthState is the server-side state of a flow between the redirect and the callback.
+type OAuthState struct {
+	Provider     string
+	Action       OAuthAction
+	UserID       string // Set for OAuthActionLink only
+	CodeVerifier string // PKCE verifier; only its challenge leaves the server
+	Nonce        string
+	RedirectPath string // Frontend path to land on after the callback
+}
+
+// # Personal Access Token Entities
+
+// PersonalAccessToken is a long-lived credential a user creates for scripts and bots.
+type PersonalAccessToken struct {
+	ID         string      `json:"id"`
+	UserID     string      `json:"-"`
+	Name       string      `json:"name"`
+	Prefix     string      `json:"prefix"` // Leading characters of the secret, to tell tokens apart
+	TokenHash  string      `json:"-"`      // SHA-256 of the secret; the secret itself is never stored
+	Scopes     []sec.Scope `json:"scopes"`
+	ExpiresAt  *time.Time  `json:"expires_at"` // Nil for a token that never expires
+	LastUsedAt *time.Time  `json:"last_used_at"`
+	LastUsedIP string      `json:"last_used_ip,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// IsExpired reports whether the token can no longer authenticate.
+func (token *PersonalAccessToken) IsExpired(now time.Time) bool {
+	return token.ExpiresAt != nil && !now.Before(*token.ExpiresAt)
+}
+
+// PersonalTokenOwner is the account a personal access token acts for.
+type PersonalTokenOwner struct {
+	Username string
+	Role     sec.UserRole
+}
+
 // # Field Identifiers
 
 // Global field names for validation and identity mapping in the authentication domain.
@@ -67,4 +188,16 @@ const (
 	FieldExpiresIn       = "expires_in"
 	FieldUser            = "user"
 	FieldMessage         = "message"
+	FieldCode            = "code"
+	FieldMFAToken        = "mfa_token"
+	FieldMFARequired     = "mfa_required"
+	FieldMFAPurpose      = "mfa_purpose"
+	FieldRecoveryCodes   = "recovery_codes"
+	FieldProvider        = "provider"
+	FieldAction          = "action"
+	FieldRedirect        = "redirect"
+	FieldURL             = "url"
+	FieldName            = "name"
+	FieldScopes          = "scopes"
+	FieldExpiresInDays   = "expires_in_days"
 )
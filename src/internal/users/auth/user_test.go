@@ -0,0 +1,40 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taibuivan/yomira/internal/users/auth"
+)
+
+/*
+TestUser_IsSuspended treats a suspension past its end date as lifted.
+*/
+func TestUser_IsSuspended(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		user     auth.User
+		expected bool
+	}{
+		{"active", auth.User{}, false},
+		{"indefinite", auth.User{Suspended: true}, true},
+		{"until_future", auth.User{Suspended: true, SuspendedUntil: &future}, true},
+		{"until_past", auth.User{Suspended: true, SuspendedUntil: &past}, false},
+		{"until_now", auth.User{Suspended: true, SuspendedUntil: &now}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.user.IsSuspended(now))
+		})
+	}
+}
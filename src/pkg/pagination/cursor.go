@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCursor reports a cursor that was not issued by [Cursor.Encode].
+var ErrInvalidCursor = errors.New("pagination: invalid cursor")
+
+// # Cursor Parameters
+
+// CursorParams holds the parsed position and limit of a cursor-paginated request.
+type CursorParams struct {
+	Before string // Opaque cursor of the last item already seen; empty starts at the newest
+	Limit  int
+}
+
+// CursorFromRequest parses "before" and "limit" query parameters from an HTTP request.
+func CursorFromRequest(request *http.Request) CursorParams {
+	limit := parseIntParam(request, "limit", DefaultLimit)
+
+	// Clamp the limit to prevent resource exhaustion
+	if limit < 1 || limit > MaxLimit {
+		limit = DefaultLimit
+	}
+
+	return CursorParams{Before: request.URL.Query().Get("before"), Limit: limit}
+}
+
+// CursorMeta is the pagination metadata of cursor-paginated responses.
+type CursorMeta struct {
+	Limit      int     `json:"limit"`
+	NextBefore *string `json:"next_before"` // nil on the last page
+}
+
+// NewCursorMeta constructs cursor metadata; an empty next cursor marks the last page.
+func NewCursorMeta(limit int, next string) CursorMeta {
+	meta := CursorMeta{Limit: limit}
+	if next != "" {
+		meta.NextBefore = &next
+	}
+	return meta
+}
+
+// # Cursor Encoding
+
+/*
+Cursor marks an item in a list ordered newest first.
+
+Description: The timestamp orders the list and the ID breaks ties between
+items created in the same instant, so a page boundary never skips or
+repeats a row. Clients only see the encoded form.
+*/
+type Cursor struct {
+	At time.Time
+	ID string
+}
+
+// Encode returns the opaque, URL-safe form of the cursor.
+func (cursor Cursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor.At.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID))
+}
+
+// DecodeCursor parses an encoded cursor; an empty string yields nil.
+func DecodeCursor(raw string) (*Cursor, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	at, id, ok := strings.Cut(string(decoded), "|")
+	if !ok || id == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	parsed, err := time.Parse(time.RFC3339Nano, at)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{At: parsed, ID: id}, nil
+}
+
+/*
+RankedCursor marks an item in a list ordered by score, highest first.
+
+Description: Items with the same score fall back to the [Cursor] order.
+The encoded form never decodes as a plain [Cursor] and vice versa, so a
+cursor issued for one sort order is rejected by the other.
+*/
+type RankedCursor struct {
+	Score int64
+	Cursor
+}
+
+// Encode returns the opaque, URL-safe form of the cursor.
+func (cursor RankedCursor) Encode() string {
+	raw := strconv.FormatInt(cursor.Score, 10) + "|" + cursor.At.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeRankedCursor parses an encoded ranked cursor; an empty string yields nil.
+func DecodeRankedCursor(raw string) (*RankedCursor, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	score, rest, ok := strings.Cut(string(decoded), "|")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	parsedScore, err := strconv.ParseInt(score, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	// The rest is laid out like a plain cursor
+	at, id, ok := strings.Cut(rest, "|")
+	if !ok || id == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	parsedAt, err := time.Parse(time.RFC3339Nano, at)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &RankedCursor{Score: parsedScore, Cursor: Cursor{At: parsedAt, ID: id}}, nil
+}
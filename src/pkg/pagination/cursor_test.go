@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Yomira. All rights reserved.
+// Author: tai.buivan.jp@gmail.com
+
+package pagination_test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taibuivan/yomira/pkg/pagination"
+)
+
+/*
+TestCursor_RoundTrip keeps sub-second precision and rejects foreign values.
+*/
+func TestCursor_RoundTrip(t *testing.T) {
+	at := time.Date(2026, 2, 21, 22, 57, 8, 123456000, time.FixedZone("ICT", 7*60*60))
+	cursor := pagination.Cursor{At: at, ID: "01952fa3-3f1e-7abc-b12e-1234567890ab"}
+
+	decoded, err := pagination.DecodeCursor(cursor.Encode())
+	require.NoError(t, err)
+	assert.True(t, at.Equal(decoded.At))
+	assert.Equal(t, cursor.ID, decoded.ID)
+
+	empty, err := pagination.DecodeCursor("")
+	require.NoError(t, err)
+	assert.Nil(t, empty)
+
+	for _, raw := range []string{"not base64!", "bm8tc2VwYXJhdG9y", "eWVzdGVyZGF5fGlk"} {
+		_, err := pagination.DecodeCursor(raw)
+		assert.ErrorIs(t, err, pagination.ErrInvalidCursor, raw)
+	}
+}
+
+/*
+TestRankedCursor_RoundTrip keeps negative scores and never accepts a plain cursor.
+*/
+func TestRankedCursor_RoundTrip(t *testing.T) {
+	at := time.Date(2026, 10, 16, 9, 30, 0, 500000000, time.UTC)
+	cursor := pagination.RankedCursor{Score: -3, Cursor: pagination.Cursor{At: at, ID: "01952fd0-0000-7000-8000-000000000001"}}
+
+	decoded, err := pagination.DecodeRankedCursor(cursor.Encode())
+	require.NoError(t, err)
+	assert.Equal(t, int64(-3), decoded.Score)
+	assert.True(t, at.Equal(decoded.At))
+	assert.Equal(t, cursor.ID, decoded.ID)
+
+	empty, err := pagination.DecodeRankedCursor("")
+	require.NoError(t, err)
+	assert.Nil(t, empty)
+
+	_, err = pagination.DecodeRankedCursor(cursor.Cursor.Encode())
+	assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+
+	_, err = pagination.DecodeCursor(cursor.Encode())
+	assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+}
+
+/*
+TestCursorFromRequest clamps the limit like offset pagination.
+*/
+func TestCursorFromRequest(t *testing.T) {
+	params := pagination.CursorFromRequest(httptest.NewRequest("GET", "/?before=abc&limit=500", nil))
+	assert.Equal(t, "abc", params.Before)
+	assert.Equal(t, pagination.DefaultLimit, params.Limit)
+
+	assert.Nil(t, pagination.NewCursorMeta(20, "").NextBefore)
+	assert.Equal(t, "abc", *pagination.NewCursorMeta(20, "abc").NextBefore)
+}